@@ -11,6 +11,7 @@ val services = mapOf(
     "apigatewayv2" to ServiceSpec("API Gateway V2"),
     "appautoscaling" to ServiceSpec("Application Auto Scaling", vpcLock = true),
     "appconfig" to ServiceSpec("AppConfig"),
+    "appfabric" to ServiceSpec("AppFabric"),
     "appflow" to ServiceSpec("AppFlow"),
     "appintegrations" to ServiceSpec("AppIntegrations"),
     "applicationinsights" to ServiceSpec("CloudWatch Application Insights"),
@@ -18,12 +19,16 @@ val services = mapOf(
     "apprunner" to ServiceSpec("App Runner"),
     "appstream" to ServiceSpec("AppStream 2.0", vpcLock = true, parallelismOverride = 10),
     "appsync" to ServiceSpec("AppSync"),
+    "artifact" to ServiceSpec("Artifact"),
     "athena" to ServiceSpec("Athena"),
     "auditmanager" to ServiceSpec("Audit Manager"),
     "autoscaling" to ServiceSpec("Auto Scaling", vpcLock = true),
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
+    "b2bi" to ServiceSpec("B2B Data Interchange"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
+    "bedrock" to ServiceSpec("Bedrock"),
+    "bedrockagent" to ServiceSpec("Agents for Amazon Bedrock"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chime" to ServiceSpec("Chime"),
@@ -58,6 +63,7 @@ val services = mapOf(
     "datapipeline" to ServiceSpec("Data Pipeline"),
     "datasync" to ServiceSpec("DataSync", vpcLock = true),
     "dax" to ServiceSpec("DynamoDB Accelerator (DAX)"),
+    "deadline" to ServiceSpec("Deadline Cloud"),
     "deploy" to ServiceSpec("CodeDeploy"),
     "detective" to ServiceSpec("Detective"),
     "devicefarm" to ServiceSpec("Device Farm"),
@@ -83,6 +89,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
@@ -96,6 +103,7 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
     "healthlake" to ServiceSpec("HealthLake"),
     "iam" to ServiceSpec("IAM (Identity & Access Management)"),
@@ -117,6 +125,7 @@ val services = mapOf(
     "kms" to ServiceSpec("KMS (Key Management)"),
     "lakeformation" to ServiceSpec("Lake Formation"),
     "lambda" to ServiceSpec("Lambda", vpcLock = true),
+    "launchwizard" to ServiceSpec("Launch Wizard"),
     "lexmodels" to ServiceSpec("Lex Model Building"),
     "lexv2models" to ServiceSpec("Lex V2 Models"),
     "licensemanager" to ServiceSpec("License Manager"),
@@ -124,6 +133,7 @@ val services = mapOf(
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
     "macie2" to ServiceSpec("Macie"),
+    "managedblockchain" to ServiceSpec("Managed Blockchain"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -136,14 +146,19 @@ val services = mapOf(
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager"),
     "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
+    "omics" to ServiceSpec("HealthOmics"),
     "opensearch" to ServiceSpec("OpenSearch"),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
     "outposts" to ServiceSpec("Outposts"),
+    "paymentcryptography" to ServiceSpec("Payment Cryptography Control Plane"),
+    "pcaconnectorad" to ServiceSpec("Private CA Connector for Active Directory"),
+    "pcaconnectorscep" to ServiceSpec("Private CA Connector for SCEP"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
     "pricing" to ServiceSpec("Pricing Calculator", regionOverride = "us-east-1"),
+    "qbusiness" to ServiceSpec("Amazon Q Business"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
     "quicksight" to ServiceSpec("QuickSight"),
     "ram" to ServiceSpec("RAM (Resource Access Manager)"),
@@ -152,6 +167,7 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "repostspace" to ServiceSpec("re:Post Private"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -186,14 +202,19 @@ val services = mapOf(
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
     "ssmcontacts" to ServiceSpec("SSM Contacts"),
     "ssmincidents" to ServiceSpec("SSM Incident Manager Incidents"),
+    "ssmsap" to ServiceSpec("SSM for SAP"),
     "ssoadmin" to ServiceSpec("SSO Admin"),
     "storagegateway" to ServiceSpec("Storage Gateway", vpcLock = true),
     "sts" to ServiceSpec("STS (Security Token)"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "taxsettings" to ServiceSpec("Tax Settings"),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
+    "tnb" to ServiceSpec("Telco Network Builder"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
+    "trustedadvisor" to ServiceSpec("Trusted Advisor"),
     "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "vpclattice" to ServiceSpec("VPC Lattice"),
     "waf" to ServiceSpec("WAF Classic", regionOverride = "us-east-1"),
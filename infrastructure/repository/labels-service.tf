@@ -16,6 +16,7 @@ variable "service_labels" {
     "appautoscaling",
     "appconfig",
     "appconfigdata",
+    "appfabric",
     "appflow",
     "appintegrations",
     "applicationcostprofiler",
@@ -24,13 +25,17 @@ variable "service_labels" {
     "apprunner",
     "appstream",
     "appsync",
+    "artifact",
     "athena",
     "auditmanager",
     "autoscaling",
     "autoscalingplans",
+    "b2bi",
     "backup",
     "backupgateway",
     "batch",
+    "bedrock",
+    "bedrockagent",
     "billingconductor",
     "braket",
     "budgets",
@@ -80,6 +85,7 @@ variable "service_labels" {
     "datapipeline",
     "datasync",
     "dax",
+    "deadline",
     "deploy",
     "detective",
     "devicefarm",
@@ -114,6 +120,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -175,6 +182,7 @@ variable "service_labels" {
     "kms",
     "lakeformation",
     "lambda",
+    "launchwizard",
     "lexmodels",
     "lexruntime",
     "lexruntimev2",
@@ -218,6 +226,7 @@ variable "service_labels" {
     "networkmanager",
     "nimble",
     "oam",
+    "omics",
     "opensearch",
     "opensearchserverless",
     "opsworks",
@@ -225,6 +234,9 @@ variable "service_labels" {
     "organizations",
     "outposts",
     "panorama",
+    "paymentcryptography",
+    "pcaconnectorad",
+    "pcaconnectorscep",
     "personalize",
     "personalizeevents",
     "personalizeruntime",
@@ -236,6 +248,7 @@ variable "service_labels" {
     "polly",
     "pricing",
     "proton",
+    "qbusiness",
     "qldb",
     "qldbsession",
     "quicksight",
@@ -247,6 +260,7 @@ variable "service_labels" {
     "redshiftdata",
     "redshiftserverless",
     "rekognition",
+    "repostspace",
     "resiliencehub",
     "resourceexplorer2",
     "resourcegroups",
@@ -293,6 +307,7 @@ variable "service_labels" {
     "ssm",
     "ssmcontacts",
     "ssmincidents",
+    "ssmsap",
     "sso",
     "ssoadmin",
     "ssooidc",
@@ -301,14 +316,18 @@ variable "service_labels" {
     "support",
     "swf",
     "synthetics",
+    "taxsettings",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
+    "tnb",
     "transcribe",
     "transcribestreaming",
     "transfer",
     "transitgateway",
     "translate",
+    "trustedadvisor",
     "verifiedaccess",
     "verifiedpermissions",
     "voiceid",
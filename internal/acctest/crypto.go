@@ -26,6 +26,7 @@ const (
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
 	PEMBlockTypePublicKey          = `PUBLIC KEY`
+	PEMBlockTypeX509CRL            = `X509 CRL`
 )
 
 var (
@@ -329,6 +330,52 @@ func TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t *testing.
 	return string(pem.EncodeToMemory(certificateBlock))
 }
 
+// TLSRSAX509RevocationListPEM generates an empty x509 certificate revocation list (CRL) PEM string signed by the specified CA.
+// Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
+// configurations such as: crl_data = "%[1]s"
+func TLSRSAX509RevocationListPEM(t *testing.T, caKeyPem, caCertificatePem string) string {
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, tlsX509CertificateSerialNumberLimit)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationList := &x509.RevocationList{
+		NextUpdate: time.Now().Add(24 * time.Hour), //nolint:gomnd
+		Number:     serialNumber,
+		ThisUpdate: time.Now(),
+	}
+
+	revocationListBytes, err := x509.CreateRevocationList(rand.Reader, revocationList, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationListBlock := &pem.Block{
+		Bytes: revocationListBytes,
+		Type:  PEMBlockTypeX509CRL,
+	}
+
+	return string(pem.EncodeToMemory(revocationListBlock))
+}
+
 // TLSRSAX509SelfSignedCertificatePEM generates a x509 certificate PEM string.
 // Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
 // configurations such as: private_key_pem = "%[1]s"
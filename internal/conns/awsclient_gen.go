@@ -64,11 +64,13 @@ import (
 	vpclattice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	workspaces_sdkv2 "github.com/aws/aws-sdk-go-v2/service/workspaces"
 	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
+	accessanalyzer_sdkv1 "github.com/aws/aws-sdk-go/service/accessanalyzer"
 	acmpca_sdkv1 "github.com/aws/aws-sdk-go/service/acmpca"
 	amplify_sdkv1 "github.com/aws/aws-sdk-go/service/amplify"
 	apigateway_sdkv1 "github.com/aws/aws-sdk-go/service/apigateway"
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	appconfig_sdkv1 "github.com/aws/aws-sdk-go/service/appconfig"
+	appfabric_sdkv1 "github.com/aws/aws-sdk-go/service/appfabric"
 	appflow_sdkv1 "github.com/aws/aws-sdk-go/service/appflow"
 	appintegrationsservice_sdkv1 "github.com/aws/aws-sdk-go/service/appintegrationsservice"
 	applicationautoscaling_sdkv1 "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -77,11 +79,15 @@ import (
 	apprunner_sdkv1 "github.com/aws/aws-sdk-go/service/apprunner"
 	appstream_sdkv1 "github.com/aws/aws-sdk-go/service/appstream"
 	appsync_sdkv1 "github.com/aws/aws-sdk-go/service/appsync"
+	artifact_sdkv1 "github.com/aws/aws-sdk-go/service/artifact"
 	athena_sdkv1 "github.com/aws/aws-sdk-go/service/athena"
 	autoscaling_sdkv1 "github.com/aws/aws-sdk-go/service/autoscaling"
 	autoscalingplans_sdkv1 "github.com/aws/aws-sdk-go/service/autoscalingplans"
+	b2bi_sdkv1 "github.com/aws/aws-sdk-go/service/b2bi"
 	backup_sdkv1 "github.com/aws/aws-sdk-go/service/backup"
 	batch_sdkv1 "github.com/aws/aws-sdk-go/service/batch"
+	bedrock_sdkv1 "github.com/aws/aws-sdk-go/service/bedrock"
+	bedrockagent_sdkv1 "github.com/aws/aws-sdk-go/service/bedrockagent"
 	budgets_sdkv1 "github.com/aws/aws-sdk-go/service/budgets"
 	chime_sdkv1 "github.com/aws/aws-sdk-go/service/chime"
 	chimesdkmediapipelines_sdkv1 "github.com/aws/aws-sdk-go/service/chimesdkmediapipelines"
@@ -114,12 +120,14 @@ import (
 	datapipeline_sdkv1 "github.com/aws/aws-sdk-go/service/datapipeline"
 	datasync_sdkv1 "github.com/aws/aws-sdk-go/service/datasync"
 	dax_sdkv1 "github.com/aws/aws-sdk-go/service/dax"
+	deadline_sdkv1 "github.com/aws/aws-sdk-go/service/deadline"
 	detective_sdkv1 "github.com/aws/aws-sdk-go/service/detective"
 	devicefarm_sdkv1 "github.com/aws/aws-sdk-go/service/devicefarm"
 	directconnect_sdkv1 "github.com/aws/aws-sdk-go/service/directconnect"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	dlm_sdkv1 "github.com/aws/aws-sdk-go/service/dlm"
 	docdb_sdkv1 "github.com/aws/aws-sdk-go/service/docdb"
+	docdbelastic_sdkv1 "github.com/aws/aws-sdk-go/service/docdbelastic"
 	dynamodb_sdkv1 "github.com/aws/aws-sdk-go/service/dynamodb"
 	ec2_sdkv1 "github.com/aws/aws-sdk-go/service/ec2"
 	ecr_sdkv1 "github.com/aws/aws-sdk-go/service/ecr"
@@ -135,6 +143,7 @@ import (
 	elbv2_sdkv1 "github.com/aws/aws-sdk-go/service/elbv2"
 	emr_sdkv1 "github.com/aws/aws-sdk-go/service/emr"
 	emrcontainers_sdkv1 "github.com/aws/aws-sdk-go/service/emrcontainers"
+	entityresolution_sdkv1 "github.com/aws/aws-sdk-go/service/entityresolution"
 	eventbridge_sdkv1 "github.com/aws/aws-sdk-go/service/eventbridge"
 	firehose_sdkv1 "github.com/aws/aws-sdk-go/service/firehose"
 	fms_sdkv1 "github.com/aws/aws-sdk-go/service/fms"
@@ -143,10 +152,12 @@ import (
 	globalaccelerator_sdkv1 "github.com/aws/aws-sdk-go/service/globalaccelerator"
 	glue_sdkv1 "github.com/aws/aws-sdk-go/service/glue"
 	greengrass_sdkv1 "github.com/aws/aws-sdk-go/service/greengrass"
+	groundstation_sdkv1 "github.com/aws/aws-sdk-go/service/groundstation"
 	guardduty_sdkv1 "github.com/aws/aws-sdk-go/service/guardduty"
 	iam_sdkv1 "github.com/aws/aws-sdk-go/service/iam"
 	imagebuilder_sdkv1 "github.com/aws/aws-sdk-go/service/imagebuilder"
 	inspector_sdkv1 "github.com/aws/aws-sdk-go/service/inspector"
+	inspector2_sdkv1 "github.com/aws/aws-sdk-go/service/inspector2"
 	iot_sdkv1 "github.com/aws/aws-sdk-go/service/iot"
 	iotanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/iotanalytics"
 	iotevents_sdkv1 "github.com/aws/aws-sdk-go/service/iotevents"
@@ -160,10 +171,12 @@ import (
 	kms_sdkv1 "github.com/aws/aws-sdk-go/service/kms"
 	lakeformation_sdkv1 "github.com/aws/aws-sdk-go/service/lakeformation"
 	lambda_sdkv1 "github.com/aws/aws-sdk-go/service/lambda"
+	launchwizard_sdkv1 "github.com/aws/aws-sdk-go/service/launchwizard"
 	lexmodelbuildingservice_sdkv1 "github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	licensemanager_sdkv1 "github.com/aws/aws-sdk-go/service/licensemanager"
 	locationservice_sdkv1 "github.com/aws/aws-sdk-go/service/locationservice"
 	macie2_sdkv1 "github.com/aws/aws-sdk-go/service/macie2"
+	managedblockchain_sdkv1 "github.com/aws/aws-sdk-go/service/managedblockchain"
 	managedgrafana_sdkv1 "github.com/aws/aws-sdk-go/service/managedgrafana"
 	mediaconnect_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconnect"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
@@ -174,17 +187,24 @@ import (
 	neptune_sdkv1 "github.com/aws/aws-sdk-go/service/neptune"
 	networkfirewall_sdkv1 "github.com/aws/aws-sdk-go/service/networkfirewall"
 	networkmanager_sdkv1 "github.com/aws/aws-sdk-go/service/networkmanager"
+	omics_sdkv1 "github.com/aws/aws-sdk-go/service/omics"
+	opensearchserverless_sdkv1 "github.com/aws/aws-sdk-go/service/opensearchserverless"
 	opensearchservice_sdkv1 "github.com/aws/aws-sdk-go/service/opensearchservice"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
 	outposts_sdkv1 "github.com/aws/aws-sdk-go/service/outposts"
+	paymentcryptography_sdkv1 "github.com/aws/aws-sdk-go/service/paymentcryptography"
+	pcaconnectorad_sdkv1 "github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	pcaconnectorscep_sdkv1 "github.com/aws/aws-sdk-go/service/pcaconnectorscep"
 	pinpoint_sdkv1 "github.com/aws/aws-sdk-go/service/pinpoint"
 	prometheusservice_sdkv1 "github.com/aws/aws-sdk-go/service/prometheusservice"
+	qbusiness_sdkv1 "github.com/aws/aws-sdk-go/service/qbusiness"
 	quicksight_sdkv1 "github.com/aws/aws-sdk-go/service/quicksight"
 	ram_sdkv1 "github.com/aws/aws-sdk-go/service/ram"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	redshift_sdkv1 "github.com/aws/aws-sdk-go/service/redshift"
 	redshiftserverless_sdkv1 "github.com/aws/aws-sdk-go/service/redshiftserverless"
+	repostspace_sdkv1 "github.com/aws/aws-sdk-go/service/repostspace"
 	resourcegroups_sdkv1 "github.com/aws/aws-sdk-go/service/resourcegroups"
 	resourcegroupstaggingapi_sdkv1 "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	route53_sdkv1 "github.com/aws/aws-sdk-go/service/route53"
@@ -209,11 +229,16 @@ import (
 	sns_sdkv1 "github.com/aws/aws-sdk-go/service/sns"
 	sqs_sdkv1 "github.com/aws/aws-sdk-go/service/sqs"
 	ssm_sdkv1 "github.com/aws/aws-sdk-go/service/ssm"
+	ssmsap_sdkv1 "github.com/aws/aws-sdk-go/service/ssmsap"
 	ssoadmin_sdkv1 "github.com/aws/aws-sdk-go/service/ssoadmin"
 	storagegateway_sdkv1 "github.com/aws/aws-sdk-go/service/storagegateway"
 	sts_sdkv1 "github.com/aws/aws-sdk-go/service/sts"
 	synthetics_sdkv1 "github.com/aws/aws-sdk-go/service/synthetics"
+	taxsettings_sdkv1 "github.com/aws/aws-sdk-go/service/taxsettings"
+	timestreaminfluxdb_sdkv1 "github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	tnb_sdkv1 "github.com/aws/aws-sdk-go/service/tnb"
 	transfer_sdkv1 "github.com/aws/aws-sdk-go/service/transfer"
+	trustedadvisor_sdkv1 "github.com/aws/aws-sdk-go/service/trustedadvisor"
 	waf_sdkv1 "github.com/aws/aws-sdk-go/service/waf"
 	wafregional_sdkv1 "github.com/aws/aws-sdk-go/service/wafregional"
 	wafv2_sdkv1 "github.com/aws/aws-sdk-go/service/wafv2"
@@ -242,6 +267,10 @@ func (c *AWSClient) APIGatewayV2Conn(ctx context.Context) *apigatewayv2_sdkv1.Ap
 	return errs.Must(conn[*apigatewayv2_sdkv1.ApiGatewayV2](ctx, c, names.APIGatewayV2))
 }
 
+func (c *AWSClient) AccessAnalyzerConn(ctx context.Context) *accessanalyzer_sdkv1.AccessAnalyzer {
+	return errs.Must(conn[*accessanalyzer_sdkv1.AccessAnalyzer](ctx, c, names.AccessAnalyzer))
+}
+
 func (c *AWSClient) AccessAnalyzerClient(ctx context.Context) *accessanalyzer_sdkv2.Client {
 	return errs.Must(client[*accessanalyzer_sdkv2.Client](ctx, c, names.AccessAnalyzer))
 }
@@ -266,6 +295,10 @@ func (c *AWSClient) AppConfigClient(ctx context.Context) *appconfig_sdkv2.Client
 	return errs.Must(client[*appconfig_sdkv2.Client](ctx, c, names.AppConfig))
 }
 
+func (c *AWSClient) AppFabricConn(ctx context.Context) *appfabric_sdkv1.AppFabric {
+	return errs.Must(conn[*appfabric_sdkv1.AppFabric](ctx, c, names.AppFabric))
+}
+
 func (c *AWSClient) AppFlowConn(ctx context.Context) *appflow_sdkv1.Appflow {
 	return errs.Must(conn[*appflow_sdkv1.Appflow](ctx, c, names.AppFlow))
 }
@@ -294,6 +327,10 @@ func (c *AWSClient) ApplicationInsightsConn(ctx context.Context) *applicationins
 	return errs.Must(conn[*applicationinsights_sdkv1.ApplicationInsights](ctx, c, names.ApplicationInsights))
 }
 
+func (c *AWSClient) ArtifactConn(ctx context.Context) *artifact_sdkv1.Artifact {
+	return errs.Must(conn[*artifact_sdkv1.Artifact](ctx, c, names.Artifact))
+}
+
 func (c *AWSClient) AthenaConn(ctx context.Context) *athena_sdkv1.Athena {
 	return errs.Must(conn[*athena_sdkv1.Athena](ctx, c, names.Athena))
 }
@@ -310,6 +347,10 @@ func (c *AWSClient) AutoScalingPlansConn(ctx context.Context) *autoscalingplans_
 	return errs.Must(conn[*autoscalingplans_sdkv1.AutoScalingPlans](ctx, c, names.AutoScalingPlans))
 }
 
+func (c *AWSClient) B2BIConn(ctx context.Context) *b2bi_sdkv1.B2bi {
+	return errs.Must(conn[*b2bi_sdkv1.B2bi](ctx, c, names.B2BI))
+}
+
 func (c *AWSClient) BackupConn(ctx context.Context) *backup_sdkv1.Backup {
 	return errs.Must(conn[*backup_sdkv1.Backup](ctx, c, names.Backup))
 }
@@ -318,6 +359,14 @@ func (c *AWSClient) BatchConn(ctx context.Context) *batch_sdkv1.Batch {
 	return errs.Must(conn[*batch_sdkv1.Batch](ctx, c, names.Batch))
 }
 
+func (c *AWSClient) BedrockConn(ctx context.Context) *bedrock_sdkv1.Bedrock {
+	return errs.Must(conn[*bedrock_sdkv1.Bedrock](ctx, c, names.Bedrock))
+}
+
+func (c *AWSClient) BedrockAgentConn(ctx context.Context) *bedrockagent_sdkv1.BedrockAgent {
+	return errs.Must(conn[*bedrockagent_sdkv1.BedrockAgent](ctx, c, names.BedrockAgent))
+}
+
 func (c *AWSClient) BudgetsConn(ctx context.Context) *budgets_sdkv1.Budgets {
 	return errs.Must(conn[*budgets_sdkv1.Budgets](ctx, c, names.Budgets))
 }
@@ -470,6 +519,10 @@ func (c *AWSClient) DataSyncConn(ctx context.Context) *datasync_sdkv1.DataSync {
 	return errs.Must(conn[*datasync_sdkv1.DataSync](ctx, c, names.DataSync))
 }
 
+func (c *AWSClient) DeadlineConn(ctx context.Context) *deadline_sdkv1.Deadline {
+	return errs.Must(conn[*deadline_sdkv1.Deadline](ctx, c, names.Deadline))
+}
+
 func (c *AWSClient) DeployConn(ctx context.Context) *codedeploy_sdkv1.CodeDeploy {
 	return errs.Must(conn[*codedeploy_sdkv1.CodeDeploy](ctx, c, names.Deploy))
 }
@@ -490,6 +543,10 @@ func (c *AWSClient) DocDBConn(ctx context.Context) *docdb_sdkv1.DocDB {
 	return errs.Must(conn[*docdb_sdkv1.DocDB](ctx, c, names.DocDB))
 }
 
+func (c *AWSClient) DocDBElasticConn(ctx context.Context) *docdbelastic_sdkv1.DocDBElastic {
+	return errs.Must(conn[*docdbelastic_sdkv1.DocDBElastic](ctx, c, names.DocDBElastic))
+}
+
 func (c *AWSClient) DocDBElasticClient(ctx context.Context) *docdbelastic_sdkv2.Client {
 	return errs.Must(client[*docdbelastic_sdkv2.Client](ctx, c, names.DocDBElastic))
 }
@@ -562,6 +619,10 @@ func (c *AWSClient) ElasticsearchConn(ctx context.Context) *elasticsearchservice
 	return errs.Must(conn[*elasticsearchservice_sdkv1.ElasticsearchService](ctx, c, names.Elasticsearch))
 }
 
+func (c *AWSClient) EntityResolutionConn(ctx context.Context) *entityresolution_sdkv1.EntityResolution {
+	return errs.Must(conn[*entityresolution_sdkv1.EntityResolution](ctx, c, names.EntityResolution))
+}
+
 func (c *AWSClient) EventsConn(ctx context.Context) *eventbridge_sdkv1.EventBridge {
 	return errs.Must(conn[*eventbridge_sdkv1.EventBridge](ctx, c, names.Events))
 }
@@ -614,6 +675,10 @@ func (c *AWSClient) GreengrassConn(ctx context.Context) *greengrass_sdkv1.Greeng
 	return errs.Must(conn[*greengrass_sdkv1.Greengrass](ctx, c, names.Greengrass))
 }
 
+func (c *AWSClient) GroundStationConn(ctx context.Context) *groundstation_sdkv1.GroundStation {
+	return errs.Must(conn[*groundstation_sdkv1.GroundStation](ctx, c, names.GroundStation))
+}
+
 func (c *AWSClient) GuardDutyConn(ctx context.Context) *guardduty_sdkv1.GuardDuty {
 	return errs.Must(conn[*guardduty_sdkv1.GuardDuty](ctx, c, names.GuardDuty))
 }
@@ -646,6 +711,10 @@ func (c *AWSClient) InspectorConn(ctx context.Context) *inspector_sdkv1.Inspecto
 	return errs.Must(conn[*inspector_sdkv1.Inspector](ctx, c, names.Inspector))
 }
 
+func (c *AWSClient) Inspector2Conn(ctx context.Context) *inspector2_sdkv1.Inspector2 {
+	return errs.Must(conn[*inspector2_sdkv1.Inspector2](ctx, c, names.Inspector2))
+}
+
 func (c *AWSClient) Inspector2Client(ctx context.Context) *inspector2_sdkv2.Client {
 	return errs.Must(client[*inspector2_sdkv2.Client](ctx, c, names.Inspector2))
 }
@@ -718,6 +787,10 @@ func (c *AWSClient) LambdaClient(ctx context.Context) *lambda_sdkv2.Client {
 	return errs.Must(client[*lambda_sdkv2.Client](ctx, c, names.Lambda))
 }
 
+func (c *AWSClient) LaunchWizardConn(ctx context.Context) *launchwizard_sdkv1.LaunchWizard {
+	return errs.Must(conn[*launchwizard_sdkv1.LaunchWizard](ctx, c, names.LaunchWizard))
+}
+
 func (c *AWSClient) LexModelsConn(ctx context.Context) *lexmodelbuildingservice_sdkv1.LexModelBuildingService {
 	return errs.Must(conn[*lexmodelbuildingservice_sdkv1.LexModelBuildingService](ctx, c, names.LexModels))
 }
@@ -758,6 +831,10 @@ func (c *AWSClient) Macie2Conn(ctx context.Context) *macie2_sdkv1.Macie2 {
 	return errs.Must(conn[*macie2_sdkv1.Macie2](ctx, c, names.Macie2))
 }
 
+func (c *AWSClient) ManagedBlockchainConn(ctx context.Context) *managedblockchain_sdkv1.ManagedBlockchain {
+	return errs.Must(conn[*managedblockchain_sdkv1.ManagedBlockchain](ctx, c, names.ManagedBlockchain))
+}
+
 func (c *AWSClient) MediaConnectConn(ctx context.Context) *mediaconnect_sdkv1.MediaConnect {
 	return errs.Must(conn[*mediaconnect_sdkv1.MediaConnect](ctx, c, names.MediaConnect))
 }
@@ -798,10 +875,18 @@ func (c *AWSClient) ObservabilityAccessManagerClient(ctx context.Context) *oam_s
 	return errs.Must(client[*oam_sdkv2.Client](ctx, c, names.ObservabilityAccessManager))
 }
 
+func (c *AWSClient) OmicsConn(ctx context.Context) *omics_sdkv1.Omics {
+	return errs.Must(conn[*omics_sdkv1.Omics](ctx, c, names.Omics))
+}
+
 func (c *AWSClient) OpenSearchConn(ctx context.Context) *opensearchservice_sdkv1.OpenSearchService {
 	return errs.Must(conn[*opensearchservice_sdkv1.OpenSearchService](ctx, c, names.OpenSearch))
 }
 
+func (c *AWSClient) OpenSearchServerlessConn(ctx context.Context) *opensearchserverless_sdkv1.OpenSearchServerless {
+	return errs.Must(conn[*opensearchserverless_sdkv1.OpenSearchServerless](ctx, c, names.OpenSearchServerless))
+}
+
 func (c *AWSClient) OpenSearchServerlessClient(ctx context.Context) *opensearchserverless_sdkv2.Client {
 	return errs.Must(client[*opensearchserverless_sdkv2.Client](ctx, c, names.OpenSearchServerless))
 }
@@ -818,6 +903,18 @@ func (c *AWSClient) OutpostsConn(ctx context.Context) *outposts_sdkv1.Outposts {
 	return errs.Must(conn[*outposts_sdkv1.Outposts](ctx, c, names.Outposts))
 }
 
+func (c *AWSClient) PCAConnectorADConn(ctx context.Context) *pcaconnectorad_sdkv1.PcaConnectorAd {
+	return errs.Must(conn[*pcaconnectorad_sdkv1.PcaConnectorAd](ctx, c, names.PCAConnectorAD))
+}
+
+func (c *AWSClient) PCAConnectorSCEPConn(ctx context.Context) *pcaconnectorscep_sdkv1.PcaConnectorScep {
+	return errs.Must(conn[*pcaconnectorscep_sdkv1.PcaConnectorScep](ctx, c, names.PCAConnectorSCEP))
+}
+
+func (c *AWSClient) PaymentCryptographyConn(ctx context.Context) *paymentcryptography_sdkv1.PaymentCryptography {
+	return errs.Must(conn[*paymentcryptography_sdkv1.PaymentCryptography](ctx, c, names.PaymentCryptography))
+}
+
 func (c *AWSClient) PinpointConn(ctx context.Context) *pinpoint_sdkv1.Pinpoint {
 	return errs.Must(conn[*pinpoint_sdkv1.Pinpoint](ctx, c, names.Pinpoint))
 }
@@ -830,6 +927,10 @@ func (c *AWSClient) PricingClient(ctx context.Context) *pricing_sdkv2.Client {
 	return errs.Must(client[*pricing_sdkv2.Client](ctx, c, names.Pricing))
 }
 
+func (c *AWSClient) QBusinessConn(ctx context.Context) *qbusiness_sdkv1.QBusiness {
+	return errs.Must(conn[*qbusiness_sdkv1.QBusiness](ctx, c, names.QBusiness))
+}
+
 func (c *AWSClient) QLDBClient(ctx context.Context) *qldb_sdkv2.Client {
 	return errs.Must(client[*qldb_sdkv2.Client](ctx, c, names.QLDB))
 }
@@ -858,6 +959,10 @@ func (c *AWSClient) RUMConn(ctx context.Context) *cloudwatchrum_sdkv1.CloudWatch
 	return errs.Must(conn[*cloudwatchrum_sdkv1.CloudWatchRUM](ctx, c, names.RUM))
 }
 
+func (c *AWSClient) RePostSpaceConn(ctx context.Context) *repostspace_sdkv1.Repostspace {
+	return errs.Must(conn[*repostspace_sdkv1.Repostspace](ctx, c, names.RePostSpace))
+}
+
 func (c *AWSClient) RedshiftConn(ctx context.Context) *redshift_sdkv1.Redshift {
 	return errs.Must(conn[*redshift_sdkv1.Redshift](ctx, c, names.Redshift))
 }
@@ -962,6 +1067,10 @@ func (c *AWSClient) SSMIncidentsClient(ctx context.Context) *ssmincidents_sdkv2.
 	return errs.Must(client[*ssmincidents_sdkv2.Client](ctx, c, names.SSMIncidents))
 }
 
+func (c *AWSClient) SSMSAPConn(ctx context.Context) *ssmsap_sdkv1.SsmSap {
+	return errs.Must(conn[*ssmsap_sdkv1.SsmSap](ctx, c, names.SSMSAP))
+}
+
 func (c *AWSClient) SSOAdminConn(ctx context.Context) *ssoadmin_sdkv1.SSOAdmin {
 	return errs.Must(conn[*ssoadmin_sdkv1.SSOAdmin](ctx, c, names.SSOAdmin))
 }
@@ -1034,6 +1143,18 @@ func (c *AWSClient) SyntheticsConn(ctx context.Context) *synthetics_sdkv1.Synthe
 	return errs.Must(conn[*synthetics_sdkv1.Synthetics](ctx, c, names.Synthetics))
 }
 
+func (c *AWSClient) TNBConn(ctx context.Context) *tnb_sdkv1.Tnb {
+	return errs.Must(conn[*tnb_sdkv1.Tnb](ctx, c, names.TNB))
+}
+
+func (c *AWSClient) TaxSettingsConn(ctx context.Context) *taxsettings_sdkv1.TaxSettings {
+	return errs.Must(conn[*taxsettings_sdkv1.TaxSettings](ctx, c, names.TaxSettings))
+}
+
+func (c *AWSClient) TimestreamInfluxDBConn(ctx context.Context) *timestreaminfluxdb_sdkv1.TimestreamInfluxDB {
+	return errs.Must(conn[*timestreaminfluxdb_sdkv1.TimestreamInfluxDB](ctx, c, names.TimestreamInfluxDB))
+}
+
 func (c *AWSClient) TimestreamWriteClient(ctx context.Context) *timestreamwrite_sdkv2.Client {
 	return errs.Must(client[*timestreamwrite_sdkv2.Client](ctx, c, names.TimestreamWrite))
 }
@@ -1046,6 +1167,10 @@ func (c *AWSClient) TransferConn(ctx context.Context) *transfer_sdkv1.Transfer {
 	return errs.Must(conn[*transfer_sdkv1.Transfer](ctx, c, names.Transfer))
 }
 
+func (c *AWSClient) TrustedAdvisorConn(ctx context.Context) *trustedadvisor_sdkv1.TrustedAdvisor {
+	return errs.Must(conn[*trustedadvisor_sdkv1.TrustedAdvisor](ctx, c, names.TrustedAdvisor))
+}
+
 func (c *AWSClient) VPCLatticeClient(ctx context.Context) *vpclattice_sdkv2.Client {
 	return errs.Must(client[*vpclattice_sdkv2.Client](ctx, c, names.VPCLattice))
 }
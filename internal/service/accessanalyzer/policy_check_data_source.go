@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_accessanalyzer_policy_check", name="Policy Check")
+func dataSourcePolicyCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"access", "existing_policy_document"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resources": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"enforce": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"existing_policy_document": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"access", "existing_policy_document"},
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.AccessCheckPolicyType_Values(), false),
+			},
+			"reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy_document (%s) is invalid JSON: %s", d.Get("policy_document").(string), err)
+	}
+
+	policyType := d.Get("policy_type").(string)
+
+	var checkName, message, result string
+	var reasons []*accessanalyzer.ReasonSummary
+
+	if v, ok := d.GetOk("existing_policy_document"); ok {
+		checkName = "no new access"
+
+		existingPolicy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "existing_policy_document (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input := &accessanalyzer.CheckNoNewAccessInput{
+			ExistingPolicyDocument: aws.String(existingPolicy),
+			NewPolicyDocument:      aws.String(policy),
+			PolicyType:             aws.String(policyType),
+		}
+
+		output, err := conn.CheckNoNewAccessWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer %s: %s", checkName, err)
+		}
+
+		message, reasons, result = aws.StringValue(output.Message), output.Reasons, aws.StringValue(output.Result)
+	} else {
+		checkName = "access not granted"
+
+		access := expandAccesses(d.Get("access").([]interface{}))
+
+		if len(access) == 0 {
+			return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer %s: each access block must specify actions or resources", checkName)
+		}
+
+		input := &accessanalyzer.CheckAccessNotGrantedInput{
+			Access:         access,
+			PolicyDocument: aws.String(policy),
+			PolicyType:     aws.String(policyType),
+		}
+
+		output, err := conn.CheckAccessNotGrantedWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer %s: %s", checkName, err)
+		}
+
+		message, reasons, result = aws.StringValue(output.Message), output.Reasons, aws.StringValue(output.Result)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("message", message)
+	if err := d.Set("reasons", flattenReasonSummaries(reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", result)
+
+	if result == accessanalyzer.CheckNoNewAccessResultFail && d.Get("enforce").(bool) {
+		return sdkdiag.AppendErrorf(diags, "IAM Access Analyzer %s check failed: %s%s", checkName, message, formatReasonSummaries(reasons))
+	}
+
+	return diags
+}
+
+func expandAccesses(tfList []interface{}) []*accessanalyzer.Access {
+	var apiObjects []*accessanalyzer.Access
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			return nil
+		}
+
+		apiObject := &accessanalyzer.Access{}
+
+		if v, ok := tfMap["actions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Actions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Resources = flex.ExpandStringSet(v)
+		}
+
+		if apiObject.Actions == nil && apiObject.Resources == nil {
+			return nil
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenReasonSummaries(apiObjects []*accessanalyzer.ReasonSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description":     aws.StringValue(apiObject.Description),
+			"statement_id":    aws.StringValue(apiObject.StatementId),
+			"statement_index": aws.Int64Value(apiObject.StatementIndex),
+		})
+	}
+
+	return tfList
+}
+
+func formatReasonSummaries(apiObjects []*accessanalyzer.ReasonSummary) string {
+	var sb strings.Builder
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		statement := fmt.Sprintf("%d", aws.Int64Value(apiObject.StatementIndex))
+		if v := aws.StringValue(apiObject.StatementId); v != "" {
+			statement = v
+		}
+
+		fmt.Fprintf(&sb, "\n  statement (%s): %s", statement, aws.StringValue(apiObject.Description))
+	}
+
+	return sb.String()
+}
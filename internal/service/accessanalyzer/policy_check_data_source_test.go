@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerPolicyCheckDataSource_noNewAccess(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyCheckDataSourceConfig_noNewAccess(`["s3:GetObject"]`, `["s3:GetObject", "s3:ListBucket"]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+				),
+			},
+			{
+				Config:      testAccPolicyCheckDataSourceConfig_noNewAccess(`["s3:GetObject", "s3:PutObject"]`, `["s3:GetObject"]`, true),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer no new access check failed`),
+			},
+			{
+				Config: testAccPolicyCheckDataSourceConfig_noNewAccess(`["s3:GetObject", "s3:PutObject"]`, `["s3:GetObject"]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "message"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reasons.0.description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyCheckDataSource_accessNotGranted(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyCheckDataSourceConfig_accessNotGranted(`["s3:GetObject"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+				),
+			},
+			{
+				Config:      testAccPolicyCheckDataSourceConfig_accessNotGranted(`["s3:GetObject", "s3:DeleteBucket"]`),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer access not granted check failed`),
+			},
+		},
+	})
+}
+
+func testAccPolicyCheckDataSourceConfig_noNewAccess(newActions, existingActions string, enforce bool) string {
+	return fmt.Sprintf(`
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+  enforce     = %[3]t
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = %[1]s
+      Resource = "*"
+    }]
+  })
+
+  existing_policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = %[2]s
+      Resource = "*"
+    }]
+  })
+}
+`, newActions, existingActions, enforce)
+}
+
+func testAccPolicyCheckDataSourceConfig_accessNotGranted(actions string) string {
+	return fmt.Sprintf(`
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = %[1]s
+      Resource = "*"
+    }]
+  })
+
+  access {
+    actions = ["s3:DeleteBucket"]
+  }
+}
+`, actions)
+}
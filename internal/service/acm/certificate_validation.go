@@ -14,13 +14,18 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/route53"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_acm_certificate_validation")
@@ -41,10 +46,37 @@ func resourceCertificateValidation() *schema.Resource {
 				ForceNew: true,
 			},
 			"validation_record_fqdns": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"validation_zone"},
+			},
+			"validation_zone": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"validation_record_fqdns"},
 			},
 		},
 	}
@@ -94,6 +126,18 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	if v, ok := d.GetOk("validation_zone"); ok && v.(*schema.Set).Len() > 0 {
+		records, err := expandValidationZoneRecords(certificate, v.(*schema.Set).List())
+
+		if err != nil {
+			return diag.Errorf("creating ACM Certificate (%s) validation records: %s", arn, err)
+		}
+
+		if err := createValidationZoneRecords(ctx, meta.(*conns.AWSClient), records, expandValidationZoneRoleARNs(v.(*schema.Set).List())); err != nil {
+			return diag.Errorf("creating ACM Certificate (%s) validation records: %s", arn, err)
+		}
+	}
+
 	if _, err := waitCertificateIssued(ctx, conn, arn, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for ACM Certificate (%s) to be issued: %s", arn, err)
 	}
@@ -124,6 +168,159 @@ func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// expandValidationZoneRecords returns the DNS validation records of the certificate, keyed by Route 53 hosted zone ID.
+// Domains that share a validation record, such as a domain and its wildcard, produce a single record.
+func expandValidationZoneRecords(certificate *types.CertificateDetail, tfList []interface{}) (map[string][]*route53.ResourceRecordSet, error) {
+	zoneIDs := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		zoneIDs[strings.TrimSuffix(tfMap["domain_name"].(string), ".")] = tfMap["zone_id"].(string)
+	}
+
+	records := make(map[string][]*route53.ResourceRecordSet)
+	seen := make(map[string]bool)
+
+	for _, domainValidation := range certificate.DomainValidationOptions {
+		domainName := aws.ToString(domainValidation.DomainName)
+
+		if v := domainValidation.ValidationMethod; v != types.ValidationMethodDns {
+			return nil, fmt.Errorf("validation_zone is not valid for %s validation", v)
+		}
+
+		zoneID, ok := zoneIDs[domainName]
+
+		if !ok {
+			return nil, fmt.Errorf("missing validation_zone for domain: %s", domainName)
+		}
+
+		record := domainValidation.ResourceRecord
+
+		if record == nil {
+			return nil, fmt.Errorf("%s DNS validation record not yet available", domainName)
+		}
+
+		name := aws.ToString(record.Name)
+		key := zoneID + "|" + name
+
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		records[zoneID] = append(records[zoneID], &route53.ResourceRecordSet{
+			Name: aws_sdkv1.String(name),
+			ResourceRecords: []*route53.ResourceRecord{{
+				Value: aws_sdkv1.String(aws.ToString(record.Value)),
+			}},
+			TTL:  aws_sdkv1.Int64(60),
+			Type: aws_sdkv1.String(string(record.Type)),
+		})
+	}
+
+	return records, nil
+}
+
+// expandValidationZoneRoleARNs returns the IAM roles to assume for changing records, keyed by Route 53 hosted zone ID.
+func expandValidationZoneRoleARNs(tfList []interface{}) map[string]string {
+	roleARNs := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			roleARNs[tfMap["zone_id"].(string)] = v
+		}
+	}
+
+	return roleARNs
+}
+
+// validationZoneConn returns a Route 53 connection for a hosted zone.
+// When a role ARN is given, such as for a hosted zone owned by another account, the role is assumed using the provider's credentials.
+func validationZoneConn(ctx context.Context, client *conns.AWSClient, roleARN string) *route53.Route53 {
+	conn := client.Route53Conn(ctx)
+
+	if roleARN == "" {
+		return conn
+	}
+
+	config := conn.Config
+	config.Credentials = stscreds.NewCredentials(client.Session, roleARN)
+
+	return route53.New(client.Session.Copy(&config))
+}
+
+// createValidationZoneRecords creates the DNS validation records that don't yet exist.
+// ACM uses the same validation record for a domain name in every certificate of the account, so an existing record with the
+// expected value is left as is, and the records are not deleted when the resource is destroyed.
+func createValidationZoneRecords(ctx context.Context, client *conns.AWSClient, records map[string][]*route53.ResourceRecordSet, roleARNs map[string]string) error {
+	for zoneID, recordSets := range records {
+		conn := validationZoneConn(ctx, client, roleARNs[zoneID])
+
+		var changes []*route53.Change
+
+		for _, recordSet := range recordSets {
+			name, recordType, value := aws_sdkv1.StringValue(recordSet.Name), aws_sdkv1.StringValue(recordSet.Type), aws_sdkv1.StringValue(recordSet.ResourceRecords[0].Value)
+			existing, _, err := tfroute53.FindResourceRecordSetByFourPartKey(ctx, conn, zoneID, name, recordType, "")
+
+			if tfresource.NotFound(err) {
+				changes = append(changes, &route53.Change{
+					Action:            aws_sdkv1.String(route53.ChangeActionCreate),
+					ResourceRecordSet: recordSet,
+				})
+
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("Route 53 Hosted Zone (%s): reading %s record %s: %w", zoneID, recordType, name, err)
+			}
+
+			if !validationRecordSetHasValue(existing, value) {
+				return fmt.Errorf("Route 53 Hosted Zone (%s): %s record %s already exists with a different value", zoneID, recordType, name)
+			}
+
+			log.Printf("[DEBUG] Route 53 Hosted Zone (%s) %s record %s already exists", zoneID, recordType, name)
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: changes,
+				Comment: aws_sdkv1.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws_sdkv1.String(zoneID),
+		}
+
+		changeInfo, err := tfroute53.ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return fmt.Errorf("Route 53 Hosted Zone (%s): %w", zoneID, err)
+		}
+
+		if err := tfroute53.WaitForRecordSetToSync(ctx, conn, tfroute53.CleanChangeID(aws_sdkv1.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("Route 53 Hosted Zone (%s): waiting for changes: %w", zoneID, err)
+		}
+	}
+
+	return nil
+}
+
+func validationRecordSetHasValue(recordSet *route53.ResourceRecordSet, value string) bool {
+	for _, v := range recordSet.ResourceRecords {
+		if strings.EqualFold(strings.TrimSuffix(aws_sdkv1.StringValue(v.Value), "."), strings.TrimSuffix(value, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func findCertificateValidationByARN(ctx context.Context, conn *acm.Client, arn string) (*types.CertificateDetail, error) {
 	output, err := findCertificateByARN(ctx, conn, arn)
 
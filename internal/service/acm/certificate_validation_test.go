@@ -10,11 +10,13 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfacm "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -233,6 +235,129 @@ func TestAccACMCertificateValidation_validationRecordFQDNSWildcardAndRoot(t *tes
 	})
 }
 
+func TestAccACMCertificateValidation_validationZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	wildcardDomain := fmt.Sprintf("*.%s", domain)
+	certificateResourceName := "aws_acm_certificate.test"
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateValidationConfig_validationZoneMissing(rootDomain, domain, wildcardDomain),
+				ExpectError: regexache.MustCompile("missing validation_zone for domain: .+"),
+			},
+			{
+				Config: testAccCertificateValidationConfig_validationZone(rootDomain, domain, wildcardDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "validation_zone.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificateValidation_validationZoneSharedRecord(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	resourceName := "aws_acm_certificate_validation.test"
+	otherResourceName := "aws_acm_certificate_validation.other"
+	recordResourceName := "aws_route53_record.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_validationZoneSharedRecord(rootDomain, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					testAccCheckCertificateValidationExists(ctx, otherResourceName),
+					testAccCheckCertificateValidationRecordExists(ctx, recordResourceName),
+				),
+			},
+			{
+				// Destroying one validation must leave the record that the other certificate shares.
+				Config: testAccCertificateValidationConfig_validationZoneSharedRecord(rootDomain, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					testAccCheckCertificateValidationRecordExists(ctx, recordResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificateValidation_validationZoneDelegated(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	delegatedZoneDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	delegatedDomain := fmt.Sprintf("www.%s", delegatedZoneDomain)
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_validationZoneDelegated(rootDomain, domain, delegatedZoneDomain, delegatedDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validation_zone.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "validation_zone.*", map[string]string{
+						"domain_name": delegatedDomain,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "validation_zone.*.zone_id", "aws_route53_zone.delegated", "zone_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificateValidation_validationZoneCrossAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	delegatedZoneDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := fmt.Sprintf("www.%s", delegatedZoneDomain)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_validationZoneCrossAccount(rName, rootDomain, delegatedZoneDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validation_zone.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "validation_zone.*.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "validation_zone.*.zone_id", "aws_route53_zone.delegated", "zone_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCertificateValidationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -252,6 +377,21 @@ func testAccCheckCertificateValidationExists(ctx context.Context, n string) reso
 	}
 }
 
+func testAccCheckCertificateValidationRecordExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		_, _, err := tfroute53.FindResourceRecordSetByFourPartKey(ctx, conn, rs.Primary.Attributes["zone_id"], rs.Primary.Attributes["name"], rs.Primary.Attributes["type"], "")
+
+		return err
+	}
+}
+
 func testAccCertificateValidationConfig_basic(rootZoneDomain, domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
@@ -461,3 +601,234 @@ resource "aws_acm_certificate_validation" "test" {
 }
 `, domainName)
 }
+
+func testAccCertificateValidationConfig_validationZone(rootZoneDomain, domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]q]
+  validation_method         = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[3]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+
+  validation_zone {
+    domain_name = %[1]q
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+
+  validation_zone {
+    domain_name = %[2]q
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+}
+`, domainName, subjectAlternativeName, rootZoneDomain)
+}
+
+func testAccCertificateValidationConfig_validationZoneMissing(rootZoneDomain, domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]q]
+  validation_method         = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[3]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+
+  validation_zone {
+    domain_name = %[1]q
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+}
+`, domainName, subjectAlternativeName, rootZoneDomain)
+}
+
+func testAccCertificateValidationConfig_validationZoneSharedRecord(rootZoneDomain, domainName string, other bool) string {
+	config := fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[1]q
+  validation_method = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+# The validation record is the same for every certificate of the domain name in the account.
+resource "aws_route53_record" "test" {
+  name    = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_name
+  records = [tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_value]
+  ttl     = 300
+  type    = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_type
+  zone_id = data.aws_route53_zone.test.zone_id
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+
+  validation_zone {
+    domain_name = %[1]q
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+
+  depends_on = [aws_route53_record.test]
+}
+`, domainName, rootZoneDomain)
+
+	if !other {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "aws_acm_certificate" "other" {
+  domain_name       = %[1]q
+  validation_method = "DNS"
+}
+
+resource "aws_acm_certificate_validation" "other" {
+  certificate_arn = aws_acm_certificate.other.arn
+
+  validation_zone {
+    domain_name = %[1]q
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+
+  depends_on = [aws_route53_record.test]
+}
+`, domainName)
+}
+
+func testAccCertificateValidationConfig_validationZoneDelegated(rootZoneDomain, domainName, delegatedZoneDomain, delegatedDomainName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_route53_zone" "delegated" {
+  name          = %[3]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "delegation" {
+  name    = aws_route53_zone.delegated.name
+  records = aws_route53_zone.delegated.name_servers
+  ttl     = 300
+  type    = "NS"
+  zone_id = data.aws_route53_zone.test.zone_id
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[2]q
+  subject_alternative_names = [%[4]q]
+  validation_method         = "DNS"
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+
+  validation_zone {
+    domain_name = %[2]q
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+
+  validation_zone {
+    domain_name = %[4]q
+    zone_id     = aws_route53_zone.delegated.zone_id
+  }
+
+  depends_on = [aws_route53_record.delegation]
+}
+`, rootZoneDomain, domainName, delegatedZoneDomain, delegatedDomainName)
+}
+
+func testAccCertificateValidationConfig_validationZoneCrossAccount(rName, rootZoneDomain, delegatedZoneDomain, domainName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_route53_zone" "delegated" {
+  provider = "awsalternate"
+
+  name          = %[3]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "delegation" {
+  name    = aws_route53_zone.delegated.name
+  records = aws_route53_zone.delegated.name_servers
+  ttl     = 300
+  type    = "NS"
+  zone_id = data.aws_route53_zone.test.zone_id
+}
+
+resource "aws_iam_role" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["route53:ChangeResourceRecordSets", "route53:GetChange", "route53:GetHostedZone", "route53:ListResourceRecordSets"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[4]q
+  validation_method = "DNS"
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+
+  validation_zone {
+    domain_name = %[4]q
+    role_arn    = aws_iam_role.test.arn
+    zone_id     = aws_route53_zone.delegated.zone_id
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_route53_record.delegation]
+}
+`, rName, rootZoneDomain, delegatedZoneDomain, domainName))
+}
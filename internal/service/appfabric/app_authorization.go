@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appfabric"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	appAuthorizationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_appfabric_app_authorization", name="App Authorization")
+// @Tags(identifierAttribute="arn")
+func ResourceAppAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppAuthorizationCreate,
+		ReadWithoutTimeout:   resourceAppAuthorizationRead,
+		UpdateWithoutTimeout: resourceAppAuthorizationUpdate,
+		DeleteWithoutTimeout: resourceAppAuthorizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"app": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"app_bundle_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appfabric.AuthType_Values(), false),
+			},
+			"auth_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"credential": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key_credential": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"credential.0.api_key_credential", "credential.0.oauth2_credential"},
+						},
+						"oauth2_credential": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									"client_secret": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"credential.0.api_key_credential", "credential.0.oauth2_credential"},
+						},
+					},
+				},
+			},
+			"persona": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tenant": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"tenant_identifier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	appBundleARN := d.Get("app_bundle_arn").(string)
+	input := &appfabric.CreateAppAuthorizationInput{
+		App:                 aws.String(d.Get("app").(string)),
+		AppBundleIdentifier: aws.String(appBundleARN),
+		AuthType:            aws.String(d.Get("auth_type").(string)),
+		Credential:          expandCredential(d.Get("credential").([]interface{})),
+		Tags:                getTagsIn(ctx),
+		Tenant:              expandTenant(d.Get("tenant").([]interface{})),
+	}
+
+	output, err := conn.CreateAppAuthorizationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppFabric App Authorization: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{appBundleARN, aws.StringValue(output.AppAuthorization.AppAuthorizationArn)}, appAuthorizationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceAppAuthorizationRead(ctx, d, meta)...)
+}
+
+func resourceAppAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), appAuthorizationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	authorization, err := FindAppAuthorizationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFabric App Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppFabric App Authorization (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app", authorization.App)
+	d.Set("app_bundle_arn", authorization.AppBundleArn)
+	d.Set("arn", authorization.AppAuthorizationArn)
+	d.Set("auth_type", authorization.AuthType)
+	d.Set("auth_url", authorization.AuthUrl)
+	d.Set("created_at", aws.TimeValue(authorization.CreatedAt).Format(time.RFC3339))
+	d.Set("persona", authorization.Persona)
+	d.Set("status", authorization.Status)
+	if err := d.Set("tenant", flattenTenant(authorization.Tenant)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tenant: %s", err)
+	}
+	d.Set("updated_at", aws.TimeValue(authorization.UpdatedAt).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceAppAuthorizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	if d.HasChanges("credential", "tenant") {
+		parts, err := flex.ExpandResourceId(d.Id(), appAuthorizationResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &appfabric.UpdateAppAuthorizationInput{
+			AppAuthorizationIdentifier: aws.String(parts[1]),
+			AppBundleIdentifier:        aws.String(parts[0]),
+		}
+
+		if d.HasChange("credential") {
+			input.Credential = expandCredential(d.Get("credential").([]interface{}))
+		}
+
+		if d.HasChange("tenant") {
+			input.Tenant = expandTenant(d.Get("tenant").([]interface{}))
+		}
+
+		_, err = conn.UpdateAppAuthorizationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppFabric App Authorization (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAppAuthorizationRead(ctx, d, meta)...)
+}
+
+func resourceAppAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), appAuthorizationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppFabric App Authorization: %s", d.Id())
+	_, err = conn.DeleteAppAuthorizationWithContext(ctx, &appfabric.DeleteAppAuthorizationInput{
+		AppAuthorizationIdentifier: aws.String(parts[1]),
+		AppBundleIdentifier:        aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppFabric App Authorization (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAppAuthorizationByTwoPartKey(ctx context.Context, conn *appfabric.AppFabric, appBundleARN, appAuthorizationARN string) (*appfabric.AppAuthorization, error) {
+	input := &appfabric.GetAppAuthorizationInput{
+		AppAuthorizationIdentifier: aws.String(appAuthorizationARN),
+		AppBundleIdentifier:        aws.String(appBundleARN),
+	}
+
+	output, err := conn.GetAppAuthorizationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AppAuthorization == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AppAuthorization, nil
+}
+
+func expandCredential(tfList []interface{}) *appfabric.Credential {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appfabric.Credential{}
+
+	if v, ok := tfMap["api_key_credential"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ApiKeyCredential = &appfabric.ApiKeyCredential{
+			ApiKey: aws.String(tfMap["api_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["oauth2_credential"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Oauth2Credential = &appfabric.Oauth2Credential{
+			ClientId:     aws.String(tfMap["client_id"].(string)),
+			ClientSecret: aws.String(tfMap["client_secret"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandTenant(tfList []interface{}) *appfabric.Tenant {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &appfabric.Tenant{
+		TenantDisplayName: aws.String(tfMap["tenant_display_name"].(string)),
+		TenantIdentifier:  aws.String(tfMap["tenant_identifier"].(string)),
+	}
+}
+
+func flattenTenant(apiObject *appfabric.Tenant) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"tenant_display_name": aws.StringValue(apiObject.TenantDisplayName),
+		"tenant_identifier":   aws.StringValue(apiObject.TenantIdentifier),
+	}
+
+	return []interface{}{tfMap}
+}
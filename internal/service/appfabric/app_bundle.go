@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appfabric"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appfabric_app_bundle", name="App Bundle")
+// @Tags(identifierAttribute="arn")
+func ResourceAppBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBundleCreate,
+		ReadWithoutTimeout:   resourceAppBundleRead,
+		UpdateWithoutTimeout: resourceAppBundleUpdate,
+		DeleteWithoutTimeout: resourceAppBundleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAppBundleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	input := &appfabric.CreateAppBundleInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("customer_managed_key_arn"); ok {
+		input.CustomerManagedKeyIdentifier = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAppBundleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppFabric App Bundle: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AppBundle.Arn))
+
+	return append(diags, resourceAppBundleRead(ctx, d, meta)...)
+}
+
+func resourceAppBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	bundle, err := FindAppBundleByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFabric App Bundle (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppFabric App Bundle (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", bundle.Arn)
+	d.Set("customer_managed_key_arn", bundle.CustomerManagedKeyArn)
+
+	return diags
+}
+
+func resourceAppBundleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAppBundleRead(ctx, d, meta)
+}
+
+func resourceAppBundleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	log.Printf("[DEBUG] Deleting AppFabric App Bundle: %s", d.Id())
+	_, err := conn.DeleteAppBundleWithContext(ctx, &appfabric.DeleteAppBundleInput{
+		AppBundleIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppFabric App Bundle (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAppBundleByID(ctx context.Context, conn *appfabric.AppFabric, arn string) (*appfabric.AppBundle, error) {
+	input := &appfabric.GetAppBundleInput{
+		AppBundleIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.GetAppBundleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AppBundle == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AppBundle, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Only one app bundle is allowed per account and Region, so all AppFabric tests run serially.
+func TestAccAppFabric_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"AppBundle": {
+			"basic":              testAccAppBundle_basic,
+			"disappears":         testAccAppBundle_disappears,
+			"tags":               testAccAppBundle_tags,
+			"customerManagedKey": testAccAppBundle_customerManagedKey,
+		},
+		"AppAuthorization": {
+			"basic":      testAccAppAuthorization_basic,
+			"disappears": testAccAppAuthorization_disappears,
+			"tags":       testAccAppAuthorization_tags,
+			"update":     testAccAppAuthorization_update,
+		},
+		"Ingestion": {
+			"basic":      testAccIngestion_basic,
+			"disappears": testAccIngestion_disappears,
+			"tags":       testAccIngestion_tags,
+		},
+		"IngestionDestination": {
+			"basic":      testAccIngestionDestination_basic,
+			"disappears": testAccIngestionDestination_disappears,
+			"tags":       testAccIngestionDestination_tags,
+			"firehose":   testAccIngestionDestination_firehose,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(t *testing.T) {
+	acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApNortheast1RegionID, endpoints.EuWest1RegionID)
+	acctest.PreCheckPartitionHasService(t, appfabric.EndpointsID)
+}
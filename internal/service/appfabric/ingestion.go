@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appfabric"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ingestionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_appfabric_ingestion", name="Ingestion")
+// @Tags(identifierAttribute="arn")
+func ResourceIngestion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIngestionCreate,
+		ReadWithoutTimeout:   resourceIngestionRead,
+		UpdateWithoutTimeout: resourceIngestionUpdate,
+		DeleteWithoutTimeout: resourceIngestionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"app": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"app_bundle_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingestion_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appfabric.IngestionType_Values(), false),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceIngestionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	appBundleARN := d.Get("app_bundle_arn").(string)
+	input := &appfabric.CreateIngestionInput{
+		App:                 aws.String(d.Get("app").(string)),
+		AppBundleIdentifier: aws.String(appBundleARN),
+		IngestionType:       aws.String(d.Get("ingestion_type").(string)),
+		Tags:                getTagsIn(ctx),
+		TenantId:            aws.String(d.Get("tenant_id").(string)),
+	}
+
+	output, err := conn.CreateIngestionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppFabric Ingestion: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{appBundleARN, aws.StringValue(output.Ingestion.Arn)}, ingestionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceIngestionRead(ctx, d, meta)...)
+}
+
+func resourceIngestionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ingestionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ingestion, err := FindIngestionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFabric Ingestion (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppFabric Ingestion (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app", ingestion.App)
+	d.Set("app_bundle_arn", ingestion.AppBundleArn)
+	d.Set("arn", ingestion.Arn)
+	d.Set("ingestion_type", ingestion.IngestionType)
+	d.Set("state", ingestion.State)
+	d.Set("tenant_id", ingestion.TenantId)
+
+	return diags
+}
+
+func resourceIngestionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceIngestionRead(ctx, d, meta)
+}
+
+func resourceIngestionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ingestionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppFabric Ingestion: %s", d.Id())
+	_, err = conn.DeleteIngestionWithContext(ctx, &appfabric.DeleteIngestionInput{
+		AppBundleIdentifier: aws.String(parts[0]),
+		IngestionIdentifier: aws.String(parts[1]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppFabric Ingestion (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindIngestionByTwoPartKey(ctx context.Context, conn *appfabric.AppFabric, appBundleARN, ingestionARN string) (*appfabric.Ingestion, error) {
+	input := &appfabric.GetIngestionInput{
+		AppBundleIdentifier: aws.String(appBundleARN),
+		IngestionIdentifier: aws.String(ingestionARN),
+	}
+
+	output, err := conn.GetIngestionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Ingestion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Ingestion, nil
+}
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appfabric"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ingestionDestinationResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_appfabric_ingestion_destination", name="Ingestion Destination")
+// @Tags(identifierAttribute="arn")
+func ResourceIngestionDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIngestionDestinationCreate,
+		ReadWithoutTimeout:   resourceIngestionDestinationRead,
+		UpdateWithoutTimeout: resourceIngestionDestinationUpdate,
+		DeleteWithoutTimeout: resourceIngestionDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"app_bundle_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audit_log": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"firehose_stream": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"stream_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(3, 64),
+															},
+														},
+													},
+												},
+												"s3_bucket": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(3, 63),
+															},
+															"prefix": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 120),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ingestion_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"processing_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audit_log": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"format": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(appfabric.Format_Values(), false),
+									},
+									"schema": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(appfabric.Schema_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceIngestionDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	appBundleARN := d.Get("app_bundle_arn").(string)
+	ingestionARN := d.Get("ingestion_arn").(string)
+	input := &appfabric.CreateIngestionDestinationInput{
+		AppBundleIdentifier:      aws.String(appBundleARN),
+		DestinationConfiguration: expandDestinationConfiguration(d.Get("destination_configuration").([]interface{})),
+		IngestionIdentifier:      aws.String(ingestionARN),
+		ProcessingConfiguration:  expandProcessingConfiguration(d.Get("processing_configuration").([]interface{})),
+		Tags:                     getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateIngestionDestinationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppFabric Ingestion Destination: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{appBundleARN, ingestionARN, aws.StringValue(output.IngestionDestination.Arn)}, ingestionDestinationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceIngestionDestinationRead(ctx, d, meta)...)
+}
+
+func resourceIngestionDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ingestionDestinationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	destination, err := FindIngestionDestinationByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFabric Ingestion Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppFabric Ingestion Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_bundle_arn", parts[0])
+	d.Set("arn", destination.Arn)
+	if err := d.Set("destination_configuration", flattenDestinationConfiguration(destination.DestinationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting destination_configuration: %s", err)
+	}
+	d.Set("ingestion_arn", destination.IngestionArn)
+	if err := d.Set("processing_configuration", flattenProcessingConfiguration(destination.ProcessingConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting processing_configuration: %s", err)
+	}
+	d.Set("status", destination.Status)
+	d.Set("status_reason", destination.StatusReason)
+
+	return diags
+}
+
+func resourceIngestionDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	if d.HasChange("destination_configuration") {
+		parts, err := flex.ExpandResourceId(d.Id(), ingestionDestinationResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &appfabric.UpdateIngestionDestinationInput{
+			AppBundleIdentifier:            aws.String(parts[0]),
+			DestinationConfiguration:       expandDestinationConfiguration(d.Get("destination_configuration").([]interface{})),
+			IngestionDestinationIdentifier: aws.String(parts[2]),
+			IngestionIdentifier:            aws.String(parts[1]),
+		}
+
+		_, err = conn.UpdateIngestionDestinationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppFabric Ingestion Destination (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIngestionDestinationRead(ctx, d, meta)...)
+}
+
+func resourceIngestionDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppFabricConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ingestionDestinationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppFabric Ingestion Destination: %s", d.Id())
+	_, err = conn.DeleteIngestionDestinationWithContext(ctx, &appfabric.DeleteIngestionDestinationInput{
+		AppBundleIdentifier:            aws.String(parts[0]),
+		IngestionDestinationIdentifier: aws.String(parts[2]),
+		IngestionIdentifier:            aws.String(parts[1]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppFabric Ingestion Destination (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindIngestionDestinationByThreePartKey(ctx context.Context, conn *appfabric.AppFabric, appBundleARN, ingestionARN, ingestionDestinationARN string) (*appfabric.IngestionDestination, error) {
+	input := &appfabric.GetIngestionDestinationInput{
+		AppBundleIdentifier:            aws.String(appBundleARN),
+		IngestionDestinationIdentifier: aws.String(ingestionDestinationARN),
+		IngestionIdentifier:            aws.String(ingestionARN),
+	}
+
+	output, err := conn.GetIngestionDestinationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appfabric.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IngestionDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IngestionDestination, nil
+}
+
+func expandDestinationConfiguration(tfList []interface{}) *appfabric.DestinationConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appfabric.DestinationConfiguration{}
+
+	if v, ok := tfMap["audit_log"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AuditLog = &appfabric.AuditLogDestinationConfiguration{
+			Destination: expandDestination(tfMap["destination"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandDestination(tfList []interface{}) *appfabric.Destination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appfabric.Destination{}
+
+	if v, ok := tfMap["firehose_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.FirehoseStream = &appfabric.FirehoseStream{
+			StreamName: aws.String(tfMap["stream_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3Bucket = &appfabric.S3Bucket{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3Bucket.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandProcessingConfiguration(tfList []interface{}) *appfabric.ProcessingConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appfabric.ProcessingConfiguration{}
+
+	if v, ok := tfMap["audit_log"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AuditLog = &appfabric.AuditLogProcessingConfiguration{
+			Format: aws.String(tfMap["format"].(string)),
+			Schema: aws.String(tfMap["schema"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDestinationConfiguration(apiObject *appfabric.DestinationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuditLog; v != nil {
+		tfMap["audit_log"] = []interface{}{map[string]interface{}{
+			"destination": flattenDestination(v.Destination),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDestination(apiObject *appfabric.Destination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FirehoseStream; v != nil {
+		tfMap["firehose_stream"] = []interface{}{map[string]interface{}{
+			"stream_name": aws.StringValue(v.StreamName),
+		}}
+	}
+
+	if v := apiObject.S3Bucket; v != nil {
+		tfMap["s3_bucket"] = []interface{}{map[string]interface{}{
+			"bucket_name": aws.StringValue(v.BucketName),
+			"prefix":      aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenProcessingConfiguration(apiObject *appfabric.ProcessingConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuditLog; v != nil {
+		tfMap["audit_log"] = []interface{}{map[string]interface{}{
+			"format": aws.StringValue(v.Format),
+			"schema": aws.StringValue(v.Schema),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
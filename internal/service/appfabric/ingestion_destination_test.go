@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appfabric"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccIngestionDestination_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appfabric.IngestionDestination
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appfabric.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "audit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.prefix", "audit"),
+					resource.TestCheckResourceAttrPair(resourceName, "ingestion_arn", "aws_appfabric_ingestion.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.schema", "raw"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "audit-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.prefix", "audit-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIngestionDestination_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appfabric.IngestionDestination
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appfabric.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "audit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceIngestionDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIngestionDestination_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appfabric.IngestionDestination
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appfabric.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngestionDestinationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccIngestionDestinationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIngestionDestination_firehose(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appfabric.IngestionDestination
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appfabric.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_firehose(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.0.stream_name", "aws_kinesis_firehose_delivery_stream.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.schema", "ocsf"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionDestinationExists(ctx context.Context, n string, v *appfabric.IngestionDestination) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricConn(ctx)
+
+		output, err := tfappfabric.FindIngestionDestinationByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionDestinationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_ingestion_destination" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfappfabric.FindIngestionDestinationByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric Ingestion Destination %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccIngestionDestinationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccIngestionConfig_basic, fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName))
+}
+
+func testAccIngestionDestinationConfig_basic(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccIngestionDestinationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "json"
+      schema = "raw"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        s3_bucket {
+          bucket_name = aws_s3_bucket.test.bucket
+          prefix      = %[1]q
+        }
+      }
+    }
+  }
+}
+`, prefix))
+}
+
+func testAccIngestionDestinationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccIngestionDestinationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "json"
+      schema = "raw"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        s3_bucket {
+          bucket_name = aws_s3_bucket.test.bucket
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccIngestionDestinationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccIngestionDestinationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "json"
+      schema = "raw"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        s3_bucket {
+          bucket_name = aws_s3_bucket.test.bucket
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccIngestionDestinationConfig_firehose(rName string) string {
+	return acctest.ConfigCompose(testAccIngestionDestinationConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_policy_document" "firehose_assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["firehose.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.firehose_assume_role.json
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+
+  tags = {
+    AWSAppFabricManaged = "true"
+  }
+}
+
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "json"
+      schema = "ocsf"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        firehose_stream {
+          stream_name = aws_kinesis_firehose_delivery_stream.test.name
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
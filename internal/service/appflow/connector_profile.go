@@ -1489,6 +1489,10 @@ func resourceConnectorProfileUpdate(ctx context.Context, d *schema.ResourceData,
 		ConnectorProfileName:   aws.String(name),
 	}
 
+	// Authorization codes can only be exchanged once, so an unchanged OAuth request is not resent.
+	// This allows OAuth client secrets and tokens to be rotated in place.
+	clearUnchangedConnectorProfileOAuthRequests(d, updateConnectorProfileInput.ConnectorProfileConfig.ConnectorProfileCredentials)
+
 	_, err := conn.UpdateConnectorProfileWithContext(ctx, &updateConnectorProfileInput)
 
 	if err != nil {
@@ -1516,6 +1520,41 @@ func resourceConnectorProfileDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+func clearUnchangedConnectorProfileOAuthRequests(d *schema.ResourceData, credentials *appflow.ConnectorProfileCredentials) {
+	if credentials == nil {
+		return
+	}
+
+	unchanged := func(path string) bool {
+		return !d.HasChange("connector_profile_config.0.connector_profile_credentials.0." + path + ".0.oauth_request")
+	}
+
+	if v := credentials.CustomConnector; v != nil && v.Oauth2 != nil && unchanged("custom_connector.0.oauth2") {
+		v.Oauth2.OAuthRequest = nil
+	}
+	if v := credentials.GoogleAnalytics; v != nil && unchanged("google_analytics") {
+		v.OAuthRequest = nil
+	}
+	if v := credentials.Honeycode; v != nil && unchanged("honeycode") {
+		v.OAuthRequest = nil
+	}
+	if v := credentials.Marketo; v != nil && unchanged("marketo") {
+		v.OAuthRequest = nil
+	}
+	if v := credentials.Salesforce; v != nil && unchanged("salesforce") {
+		v.OAuthRequest = nil
+	}
+	if v := credentials.SAPOData; v != nil && v.OAuthCredentials != nil && unchanged("sapo_data.0.oauth_credentials") {
+		v.OAuthCredentials.OAuthRequest = nil
+	}
+	if v := credentials.Slack; v != nil && unchanged("slack") {
+		v.OAuthRequest = nil
+	}
+	if v := credentials.Zendesk; v != nil && unchanged("zendesk") {
+		v.OAuthRequest = nil
+	}
+}
+
 func expandConnectorProfileConfig(m map[string]interface{}) *appflow.ConnectorProfileConfig {
 	cpc := appflow.ConnectorProfileConfig{
 		ConnectorProfileCredentials: expandConnectorProfileCredentials(m["connector_profile_credentials"].([]interface{})[0].(map[string]interface{})),
@@ -98,3 +98,48 @@ func FindConnectorProfileByARN(ctx context.Context, conn *appflow.Appflow, arn s
 
 	return result, nil
 }
+
+func FindFlowExecutionByTwoPartKey(ctx context.Context, conn *appflow.Appflow, flowName, executionID string) (*appflow.ExecutionRecord, error) {
+	in := &appflow.DescribeFlowExecutionRecordsInput{
+		FlowName: aws.String(flowName),
+	}
+	var result *appflow.ExecutionRecord
+
+	err := conn.DescribeFlowExecutionRecordsPagesWithContext(ctx, in, func(page *appflow.DescribeFlowExecutionRecordsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, execution := range page.FlowExecutions {
+			if execution == nil {
+				continue
+			}
+
+			if aws.StringValue(execution.ExecutionId) == executionID {
+				result = execution
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &retry.NotFoundError{
+			Message:     fmt.Sprintf("No execution %q of flow %q", executionID, flowName),
+			LastRequest: in,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appflow
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	flowExecutionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_appflow_flow_execution", name="Flow Execution")
+func ResourceFlowExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowExecutionCreate,
+		ReadWithoutTimeout:   resourceFlowExecutionRead,
+		DeleteWithoutTimeout: resourceFlowExecutionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFlowExecutionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bytes_processed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_written": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flow_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records_processed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceFlowExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn(ctx)
+
+	flowName := d.Get("flow_name").(string)
+	out, err := conn.StartFlowWithContext(ctx, &appflow.StartFlowInput{
+		FlowName: aws.String(flowName),
+	})
+
+	if err != nil {
+		return diag.Errorf("starting Appflow Flow (%s): %s", flowName, err)
+	}
+
+	if out == nil || out.ExecutionId == nil {
+		return diag.Errorf("starting Appflow Flow (%s): empty output", flowName)
+	}
+
+	executionID := aws.StringValue(out.ExecutionId)
+	id, err := flex.FlattenResourceId([]string{flowName, executionID}, flowExecutionResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := FlowExecutionCompleted(ctx, conn, flowName, executionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for Appflow Flow Execution (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFlowExecutionRead(ctx, d, meta)
+}
+
+func resourceFlowExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), flowExecutionResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flowName, executionID := parts[0], parts[1]
+	out, err := FindFlowExecutionByTwoPartKey(ctx, conn, flowName, executionID)
+
+	// Execution records are only retained for a limited time. Keep the resource in state
+	// so that an expired record does not cause the flow to be run again.
+	if !d.IsNewResource() && tfresource.NotFound(err) && d.Get("execution_id").(string) != "" {
+		log.Printf("[WARN] Appflow Flow Execution (%s) record not found, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Appflow Flow Execution (%s): %s", d.Id(), err)
+	}
+
+	d.Set("execution_id", out.ExecutionId)
+	d.Set("execution_status", out.ExecutionStatus)
+	d.Set("flow_name", flowName)
+	if out.LastUpdatedAt != nil {
+		d.Set("last_updated_at", aws.TimeValue(out.LastUpdatedAt).Format(time.RFC3339))
+	}
+	if out.StartedAt != nil {
+		d.Set("started_at", aws.TimeValue(out.StartedAt).Format(time.RFC3339))
+	}
+	if result := out.ExecutionResult; result != nil {
+		d.Set("bytes_processed", result.BytesProcessed)
+		d.Set("bytes_written", result.BytesWritten)
+		d.Set("records_processed", result.RecordsProcessed)
+	}
+
+	return nil
+}
+
+func resourceFlowExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn(ctx)
+
+	if d.Get("execution_status").(string) != appflow.ExecutionStatusInProgress {
+		return nil
+	}
+
+	log.Printf("[INFO] Cancelling Appflow Flow Execution: %s", d.Id())
+	_, err := conn.CancelFlowExecutionsWithContext(ctx, &appflow.CancelFlowExecutionsInput{
+		ExecutionIds: aws.StringSlice([]string{d.Get("execution_id").(string)}),
+		FlowName:     aws.String(d.Get("flow_name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling Appflow Flow Execution (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFlowExecutionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := flex.ExpandResourceId(d.Id(), flowExecutionResourceIDPartCount, false); err != nil {
+		return nil, err
+	}
+
+	d.Set("wait_for_completion", true)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appflow_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appflow"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppFlowFlowExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var executionID1, executionID2 string
+	rSourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rDestinationName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowExecutionConfig_basic(rSourceName, rDestinationName, rFlowName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExecutionID(resourceName, &executionID1),
+					resource.TestCheckResourceAttr(resourceName, "execution_status", appflow.ExecutionStatusSuccessful),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_name", "aws_appflow_flow.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "records_processed"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccFlowExecutionConfig_basic(rSourceName, rDestinationName, rFlowName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExecutionID(resourceName, &executionID2),
+					resource.TestCheckResourceAttr(resourceName, "execution_status", appflow.ExecutionStatusSuccessful),
+					func(*terraform.State) error {
+						if executionID1 == executionID2 {
+							return fmt.Errorf("Appflow Flow not run again")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowExecutionID(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["execution_id"] == "" {
+			return fmt.Errorf("No Appflow Flow Execution ID is set")
+		}
+
+		*v = rs.Primary.Attributes["execution_id"]
+
+		return nil
+	}
+}
+
+func testAccFlowExecutionConfig_basic(rSourceName, rDestinationName, rFlowName, run string) string {
+	return acctest.ConfigCompose(
+		testAccFlowConfig_taskProperties(rSourceName, rDestinationName, rFlowName),
+		fmt.Sprintf(`
+resource "aws_appflow_flow_execution" "test" {
+  flow_name = aws_appflow_flow.test.name
+
+  triggers = {
+    run = %[1]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, run),
+	)
+}
@@ -41,6 +41,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceFlowExecution,
+			TypeName: "aws_appflow_flow_execution",
+			Name:     "Flow Execution",
+		},
 	}
 }
 
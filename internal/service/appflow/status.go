@@ -27,3 +27,19 @@ func FlowStatus(ctx context.Context, conn *appflow.Appflow, id string) retry.Sta
 		return out, aws.StringValue(out.FlowStatus), nil
 	}
 }
+
+func FlowExecutionStatus(ctx context.Context, conn *appflow.Appflow, flowName, executionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFlowExecutionByTwoPartKey(ctx, conn, flowName, executionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.ExecutionStatus), nil
+	}
+}
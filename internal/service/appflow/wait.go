@@ -5,10 +5,13 @@ package appflow
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appflow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,3 +30,27 @@ func FlowDeleted(ctx context.Context, conn *appflow.Appflow, id string) error {
 
 	return err
 }
+
+func FlowExecutionCompleted(ctx context.Context, conn *appflow.Appflow, flowName, executionID string, timeout time.Duration) (*appflow.ExecutionRecord, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appflow.ExecutionStatusInProgress, appflow.ExecutionStatusCancelStarted},
+		Target:  []string{appflow.ExecutionStatusSuccessful},
+		Refresh: FlowExecutionStatus(ctx, conn, flowName, executionID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+		// The execution record can take a short time to appear after the flow is started.
+		NotFoundChecks: 30,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appflow.ExecutionRecord); ok {
+		if result := output.ExecutionResult; result != nil && result.ErrorInfo != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(result.ErrorInfo.ExecutionMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/artifact"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_artifact_account_settings", name="Account Settings")
+func ResourceAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountSettingsPut,
+		ReadWithoutTimeout:   resourceAccountSettingsRead,
+		UpdateWithoutTimeout: resourceAccountSettingsPut,
+		DeleteWithoutTimeout: resourceAccountSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"notification_subscription_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(artifact.NotificationSubscriptionStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAccountSettingsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ArtifactConn(ctx)
+
+	input := &artifact.PutAccountSettingsInput{
+		NotificationSubscriptionStatus: aws.String(d.Get("notification_subscription_status").(string)),
+	}
+
+	_, err := conn.PutAccountSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Artifact Account Settings: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return append(diags, resourceAccountSettingsRead(ctx, d, meta)...)
+}
+
+func resourceAccountSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ArtifactConn(ctx)
+
+	settings, err := FindAccountSettings(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Artifact Account Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("notification_subscription_status", settings.NotificationSubscriptionStatus)
+
+	return diags
+}
+
+func resourceAccountSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ArtifactConn(ctx)
+
+	// Removing the resource unsubscribes from notifications.
+	_, err := conn.PutAccountSettingsWithContext(ctx, &artifact.PutAccountSettingsInput{
+		NotificationSubscriptionStatus: aws.String(artifact.NotificationSubscriptionStatusNotSubscribed),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Artifact Account Settings (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAccountSettings(ctx context.Context, conn *artifact.Artifact) (*artifact.AccountSettings, error) {
+	input := &artifact.GetAccountSettingsInput{}
+
+	output, err := conn.GetAccountSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccountSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccountSettings, nil
+}
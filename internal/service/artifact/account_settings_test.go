@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package artifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/artifact"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfartifact "github.com/hashicorp/terraform-provider-aws/internal/service/artifact"
+)
+
+func TestAccArtifactAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_artifact_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, artifact.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, artifact.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(artifact.NotificationSubscriptionStatusSubscribed),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_subscription_status", artifact.NotificationSubscriptionStatusSubscribed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingsConfig_basic(artifact.NotificationSubscriptionStatusNotSubscribed),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_subscription_status", artifact.NotificationSubscriptionStatusNotSubscribed),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ArtifactConn(ctx)
+
+		_, err := tfartifact.FindAccountSettings(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccCheckAccountSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ArtifactConn(ctx)
+
+		output, err := tfartifact.FindAccountSettings(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.NotificationSubscriptionStatus); status != artifact.NotificationSubscriptionStatusNotSubscribed {
+			return fmt.Errorf("Artifact Account Settings notification subscription status is %s", status)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountSettingsConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_artifact_account_settings" "test" {
+  notification_subscription_status = %[1]q
+}
+`, status)
+}
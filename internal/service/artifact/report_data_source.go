@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package artifact
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/artifact"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_artifact_report")
+func DataSourceReport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"acceptance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"report_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"series": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"term_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ArtifactConn(ctx)
+
+	reportID := d.Get("report_id").(string)
+	report, err := FindReportByTwoPartKey(ctx, conn, reportID, int64(d.Get("version").(int)))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Artifact Report", err))
+	}
+
+	d.SetId(reportID)
+	d.Set("acceptance_type", report.AcceptanceType)
+	d.Set("arn", report.Arn)
+	d.Set("category", report.Category)
+	d.Set("company_name", report.CompanyName)
+	d.Set("description", report.Description)
+	d.Set("name", report.Name)
+	if report.PeriodEnd != nil {
+		d.Set("period_end", aws.TimeValue(report.PeriodEnd).Format(time.RFC3339))
+	}
+	if report.PeriodStart != nil {
+		d.Set("period_start", aws.TimeValue(report.PeriodStart).Format(time.RFC3339))
+	}
+	d.Set("product_name", report.ProductName)
+	d.Set("series", report.Series)
+	d.Set("state", report.State)
+	d.Set("term_arn", report.TermArn)
+	d.Set("version", report.Version)
+
+	return diags
+}
+
+// FindReportByTwoPartKey returns the latest version of the report when version is 0.
+func FindReportByTwoPartKey(ctx context.Context, conn *artifact.Artifact, reportID string, version int64) (*artifact.ReportDetail, error) {
+	input := &artifact.GetReportMetadataInput{
+		ReportId: aws.String(reportID),
+	}
+
+	if version > 0 {
+		input.ReportVersion = aws.Int64(version)
+	}
+
+	output, err := conn.GetReportMetadataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, artifact.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReportDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReportDetails, nil
+}
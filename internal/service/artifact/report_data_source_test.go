@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package artifact_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/artifact"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	envVarReportID             = "AWS_ARTIFACT_REPORT_ID"
+	envVarReportIDMessageError = "Environment variable AWS_ARTIFACT_REPORT_ID is not set. " +
+		"To test the Artifact report data source, the ID of a report available to the account must be provided."
+)
+
+func TestAccArtifactReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	reportID := envvar.SkipIfEmpty(t, envVarReportID, envVarReportIDMessageError)
+	dataSourceName := "data.aws_artifact_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, artifact.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, artifact.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportDataSourceConfig_basic(reportID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "acceptance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "report_id", reportID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReportDataSourceConfig_basic(reportID string) string {
+	return fmt.Sprintf(`
+data "aws_artifact_report" "test" {
+  report_id = %[1]q
+}
+`, reportID)
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_athena_capacity_reservation", name="Capacity Reservation")
+// @Tags(identifierAttribute="arn")
+func ResourceCapacityReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationRead,
+		UpdateWithoutTimeout: resourceCapacityReservationUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allocated_dpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores, and hyphens"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_dpus": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(24),
+			},
+		},
+	}
+}
+
+func resourceCapacityReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &athena.CreateCapacityReservationInput{
+		Name:       aws.String(name),
+		Tags:       getTagsIn(ctx),
+		TargetDpus: aws.Int64(int64(d.Get("target_dpus").(int))),
+	}
+
+	_, err := conn.CreateCapacityReservationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Athena Capacity Reservation (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCapacityReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn(ctx)
+
+	reservation, err := FindCapacityReservationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Capacity Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allocated_dpus", reservation.AllocatedDpus)
+	d.Set("arn", capacityReservationARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("name", reservation.Name)
+	d.Set("status", reservation.Status)
+	d.Set("target_dpus", reservation.TargetDpus)
+
+	return diags
+}
+
+func resourceCapacityReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn(ctx)
+
+	if d.HasChange("target_dpus") {
+		input := &athena.UpdateCapacityReservationInput{
+			Name:       aws.String(d.Id()),
+			TargetDpus: aws.Int64(int64(d.Get("target_dpus").(int))),
+		}
+
+		_, err := conn.UpdateCapacityReservationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Athena Capacity Reservation (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCapacityReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn(ctx)
+
+	// A reservation must be cancelled before it can be deleted.
+	if status := d.Get("status").(string); status != athena.CapacityReservationStatusCancelled && status != athena.CapacityReservationStatusFailed {
+		log.Printf("[DEBUG] Cancelling Athena Capacity Reservation: %s", d.Id())
+		_, err := conn.CancelCapacityReservationWithContext(ctx, &athena.CancelCapacityReservationInput{
+			Name: aws.String(d.Id()),
+		})
+
+		if errCapacityReservationNotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "cancelling Athena Capacity Reservation (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCapacityReservationCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) cancel: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Capacity Reservation: %s", d.Id())
+	_, err := conn.DeleteCapacityReservationWithContext(ctx, &athena.DeleteCapacityReservationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errCapacityReservationNotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func errCapacityReservationNotFound(err error) bool {
+	return tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found")
+}
+
+func FindCapacityReservationByName(ctx context.Context, conn *athena.Athena, name string) (*athena.CapacityReservation, error) {
+	input := &athena.GetCapacityReservationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityReservationWithContext(ctx, input)
+
+	if errCapacityReservationNotFound(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityReservation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityReservation, nil
+}
+
+func statusCapacityReservation(ctx context.Context, conn *athena.Athena, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCapacityReservationByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitCapacityReservationActive(ctx context.Context, conn *athena.Athena, name string, timeout time.Duration) (*athena.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{athena.CapacityReservationStatusPending, athena.CapacityReservationStatusUpdatePending},
+		Target:  []string{athena.CapacityReservationStatusActive},
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.CapacityReservation); ok {
+		if v := output.LastAllocation; v != nil && aws.StringValue(v.Status) == athena.CapacityAllocationStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s", aws.StringValue(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Athena, name string, timeout time.Duration) (*athena.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{athena.CapacityReservationStatusActive, athena.CapacityReservationStatusCancelling, athena.CapacityReservationStatusPending, athena.CapacityReservationStatusUpdatePending},
+		Target:  []string{athena.CapacityReservationStatusCancelled},
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GetCapacityReservation doesn't return the ARN.
+func capacityReservationARN(c *conns.AWSClient, name string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Region:    c.Region,
+		Service:   "athena",
+		AccountID: c.AccountID,
+		Resource:  fmt.Sprintf("capacity-reservation/%s", name),
+	}.String()
+}
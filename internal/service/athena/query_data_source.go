@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_athena_query")
+func DataSourceQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"query_execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"result_reuse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"max_age_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(0, 10080),
+						},
+					},
+				},
+			},
+			"result_reused": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaConn(ctx)
+
+	input := &athena.StartQueryExecutionInput{
+		QueryString: aws.String(d.Get("query_string").(string)),
+	}
+
+	if v, ok := d.GetOk("catalog"); ok {
+		if input.QueryExecutionContext == nil {
+			input.QueryExecutionContext = &athena.QueryExecutionContext{}
+		}
+		input.QueryExecutionContext.Catalog = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("database"); ok {
+		if input.QueryExecutionContext == nil {
+			input.QueryExecutionContext = &athena.QueryExecutionContext{}
+		}
+		input.QueryExecutionContext.Database = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_location"); ok {
+		input.ResultConfiguration = &athena.ResultConfiguration{
+			OutputLocation: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("result_reuse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResultReuseConfiguration = expandResultReuseConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("workgroup"); ok {
+		input.WorkGroup = aws.String(v.(string))
+	}
+
+	output, err := conn.StartQueryExecutionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Athena Query Execution: %s", err)
+	}
+
+	queryExecutionID := aws.StringValue(output.QueryExecutionId)
+
+	queryExecution, err := waitQueryExecutionSucceeded(ctx, conn, queryExecutionID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Athena Query Execution (%s) success: %s", queryExecutionID, err)
+	}
+
+	var columns []*athena.ColumnInfo
+	var rows []*athena.Row
+	first := true
+
+	err = conn.GetQueryResultsPagesWithContext(ctx, &athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(queryExecutionID),
+	}, func(page *athena.GetQueryResultsOutput, lastPage bool) bool {
+		if page == nil || page.ResultSet == nil {
+			return !lastPage
+		}
+
+		if first {
+			if page.ResultSet.ResultSetMetadata != nil {
+				columns = page.ResultSet.ResultSetMetadata.ColumnInfo
+			}
+
+			// The first row of a SELECT statement's results holds the column headers.
+			if aws.StringValue(queryExecution.StatementType) == athena.StatementTypeDml && len(page.ResultSet.Rows) > 0 {
+				page.ResultSet.Rows = page.ResultSet.Rows[1:]
+			}
+
+			first = false
+		}
+
+		rows = append(rows, page.ResultSet.Rows...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Query Execution (%s) results: %s", queryExecutionID, err)
+	}
+
+	d.SetId(queryExecutionID)
+	if err := d.Set("columns", flattenColumnInfos(columns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting columns: %s", err)
+	}
+	d.Set("query_execution_id", queryExecutionID)
+	if v := queryExecution.Statistics; v != nil && v.ResultReuseInformation != nil {
+		d.Set("result_reused", v.ResultReuseInformation.ReusedPreviousResult)
+	} else {
+		d.Set("result_reused", false)
+	}
+	if err := d.Set("rows", flattenRows(columns, rows)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rows: %s", err)
+	}
+
+	return diags
+}
+
+func waitQueryExecutionSucceeded(ctx context.Context, conn *athena.Athena, id string, timeout time.Duration) (*athena.QueryExecution, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{athena.QueryExecutionStateQueued, athena.QueryExecutionStateRunning},
+		Target:     []string{athena.QueryExecutionStateSucceeded},
+		Refresh:    queryExecutionStateRefreshFunc(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.GetQueryExecutionOutput); ok {
+		return output.QueryExecution, err
+	}
+
+	return nil, err
+}
+
+func expandResultReuseConfiguration(tfMap map[string]interface{}) *athena.ResultReuseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &athena.ResultReuseByAgeConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["max_age_in_minutes"].(int); ok && aws.BoolValue(apiObject.Enabled) {
+		apiObject.MaxAgeInMinutes = aws.Int64(int64(v))
+	}
+
+	return &athena.ResultReuseConfiguration{
+		ResultReuseByAgeConfiguration: apiObject,
+	}
+}
+
+func flattenColumnInfos(apiObjects []*athena.ColumnInfo) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRows(columns []*athena.ColumnInfo, apiObjects []*athena.Row) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := make(map[string]interface{}, len(apiObject.Data))
+
+		for i, datum := range apiObject.Data {
+			// NULL values are omitted from the row.
+			if datum == nil || datum.VarCharValue == nil {
+				continue
+			}
+
+			key := fmt.Sprintf("_col%d", i)
+			if i < len(columns) && columns[i] != nil {
+				key = aws.StringValue(columns[i].Name)
+			}
+
+			tfMap[key] = aws.StringValue(datum.VarCharValue)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAthenaQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", "num"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "integer"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.name", "str"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.type", "varchar"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_execution_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "result_reused", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.num", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.str", "a"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryDataSource_resultReuseConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_resultReuseConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result_reuse_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result_reuse_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "result_reuse_configuration.0.max_age_in_minutes", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.num", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueryDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    engine_version {
+      selected_engine_version = "Athena engine version 3"
+    }
+
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/output/"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQueryDataSourceConfig_base(rName), `
+data "aws_athena_query" "test" {
+  query_string = "SELECT 1 AS num, 'a' AS str"
+  workgroup    = aws_athena_workgroup.test.id
+}
+`)
+}
+
+func testAccQueryDataSourceConfig_resultReuseConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccQueryDataSourceConfig_base(rName), `
+data "aws_athena_query" "test" {
+  query_string = "SELECT 1 AS num"
+  workgroup    = aws_athena_workgroup.test.id
+
+  result_reuse_configuration {
+    enabled            = true
+    max_age_in_minutes = 30
+  }
+}
+`)
+}
@@ -47,6 +47,10 @@ func ResourceWorkGroup() *schema.Resource {
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"bytes_scanned_cutoff_per_query": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -55,6 +59,24 @@ func ResourceWorkGroup() *schema.Resource {
 								validation.IntInSlice([]int{0}),
 							),
 						},
+						"customer_content_encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"enable_minimum_encryption_configuration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 						"enforce_workgroup_configuration": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -83,6 +105,27 @@ func ResourceWorkGroup() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"identity_center_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_identity_center": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"identity_center_instance_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -322,10 +365,22 @@ func expandWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfiguratio
 
 	configuration := &athena.WorkGroupConfiguration{}
 
+	if v, ok := m["additional_configuration"].(string); ok && v != "" {
+		configuration.AdditionalConfiguration = aws.String(v)
+	}
+
 	if v, ok := m["bytes_scanned_cutoff_per_query"].(int); ok && v > 0 {
 		configuration.BytesScannedCutoffPerQuery = aws.Int64(int64(v))
 	}
 
+	if v, ok := m["customer_content_encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.CustomerContentEncryptionConfiguration = expandWorkGroupCustomerContentEncryptionConfiguration(v)
+	}
+
+	if v, ok := m["enable_minimum_encryption_configuration"].(bool); ok && v {
+		configuration.EnableMinimumEncryptionConfiguration = aws.Bool(v)
+	}
+
 	if v, ok := m["enforce_workgroup_configuration"].(bool); ok {
 		configuration.EnforceWorkGroupConfiguration = aws.Bool(v)
 	}
@@ -338,6 +393,10 @@ func expandWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfiguratio
 		configuration.ExecutionRole = aws.String(v)
 	}
 
+	if v, ok := m["identity_center_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.IdentityCenterConfiguration = expandWorkGroupIdentityCenterConfiguration(v)
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"].(bool); ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v)
 	}
@@ -369,6 +428,42 @@ func expandWorkGroupEngineVersion(l []interface{}) *athena.EngineVersion {
 	return engineVersion
 }
 
+func expandWorkGroupCustomerContentEncryptionConfiguration(l []interface{}) *athena.CustomerContentEncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	customerContentEncryptionConfiguration := &athena.CustomerContentEncryptionConfiguration{}
+
+	if v, ok := m["kms_key_arn"].(string); ok && v != "" {
+		customerContentEncryptionConfiguration.KmsKey = aws.String(v)
+	}
+
+	return customerContentEncryptionConfiguration
+}
+
+func expandWorkGroupIdentityCenterConfiguration(l []interface{}) *athena.IdentityCenterConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	identityCenterConfiguration := &athena.IdentityCenterConfiguration{}
+
+	if v, ok := m["enable_identity_center"].(bool); ok {
+		identityCenterConfiguration.EnableIdentityCenter = aws.Bool(v)
+	}
+
+	if v, ok := m["identity_center_instance_arn"].(string); ok && v != "" {
+		identityCenterConfiguration.IdentityCenterInstanceArn = aws.String(v)
+	}
+
+	return identityCenterConfiguration
+}
+
 func expandWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfigurationUpdates {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -378,12 +473,26 @@ func expandWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfi
 
 	configurationUpdates := &athena.WorkGroupConfigurationUpdates{}
 
+	if v, ok := m["additional_configuration"].(string); ok && v != "" {
+		configurationUpdates.AdditionalConfiguration = aws.String(v)
+	}
+
 	if v, ok := m["bytes_scanned_cutoff_per_query"].(int); ok && v > 0 {
 		configurationUpdates.BytesScannedCutoffPerQuery = aws.Int64(int64(v))
 	} else {
 		configurationUpdates.RemoveBytesScannedCutoffPerQuery = aws.Bool(true)
 	}
 
+	if v, ok := m["customer_content_encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configurationUpdates.CustomerContentEncryptionConfiguration = expandWorkGroupCustomerContentEncryptionConfiguration(v)
+	} else {
+		configurationUpdates.RemoveCustomerContentEncryptionConfiguration = aws.Bool(true)
+	}
+
+	if v, ok := m["enable_minimum_encryption_configuration"].(bool); ok {
+		configurationUpdates.EnableMinimumEncryptionConfiguration = aws.Bool(v)
+	}
+
 	if v, ok := m["enforce_workgroup_configuration"].(bool); ok {
 		configurationUpdates.EnforceWorkGroupConfiguration = aws.Bool(v)
 	}
@@ -501,13 +610,17 @@ func flattenWorkGroupConfiguration(configuration *athena.WorkGroupConfiguration)
 	}
 
 	m := map[string]interface{}{
-		"bytes_scanned_cutoff_per_query":     aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
-		"enforce_workgroup_configuration":    aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
-		"engine_version":                     flattenWorkGroupEngineVersion(configuration.EngineVersion),
-		"execution_role":                     aws.StringValue(configuration.ExecutionRole),
-		"publish_cloudwatch_metrics_enabled": aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
-		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
-		"requester_pays_enabled":             aws.BoolValue(configuration.RequesterPaysEnabled),
+		"additional_configuration":                  aws.StringValue(configuration.AdditionalConfiguration),
+		"bytes_scanned_cutoff_per_query":            aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
+		"customer_content_encryption_configuration": flattenWorkGroupCustomerContentEncryptionConfiguration(configuration.CustomerContentEncryptionConfiguration),
+		"enable_minimum_encryption_configuration":   aws.BoolValue(configuration.EnableMinimumEncryptionConfiguration),
+		"enforce_workgroup_configuration":           aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                            flattenWorkGroupEngineVersion(configuration.EngineVersion),
+		"execution_role":                            aws.StringValue(configuration.ExecutionRole),
+		"identity_center_configuration":             flattenWorkGroupIdentityCenterConfiguration(configuration.IdentityCenterConfiguration),
+		"publish_cloudwatch_metrics_enabled":        aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
+		"result_configuration":                      flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
+		"requester_pays_enabled":                    aws.BoolValue(configuration.RequesterPaysEnabled),
 	}
 
 	return []interface{}{m}
@@ -526,6 +639,31 @@ func flattenWorkGroupEngineVersion(engineVersion *athena.EngineVersion) []interf
 	return []interface{}{m}
 }
 
+func flattenWorkGroupCustomerContentEncryptionConfiguration(customerContentEncryptionConfiguration *athena.CustomerContentEncryptionConfiguration) []interface{} {
+	if customerContentEncryptionConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"kms_key_arn": aws.StringValue(customerContentEncryptionConfiguration.KmsKey),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWorkGroupIdentityCenterConfiguration(identityCenterConfiguration *athena.IdentityCenterConfiguration) []interface{} {
+	if identityCenterConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable_identity_center":       aws.BoolValue(identityCenterConfiguration.EnableIdentityCenter),
+		"identity_center_instance_arn": aws.StringValue(identityCenterConfiguration.IdentityCenterInstanceArn),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWorkGroupResultConfiguration(resultConfiguration *athena.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
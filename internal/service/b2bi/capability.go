@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_b2bi_capability", name="Capability")
+// @Tags(identifierAttribute="arn")
+func ResourceCapability() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapabilityCreate,
+		ReadWithoutTimeout:   resourceCapabilityRead,
+		UpdateWithoutTimeout: resourceCapabilityUpdate,
+		DeleteWithoutTimeout: resourceCapabilityDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edi": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_location":  s3LocationSchema(true),
+									"output_location": s3LocationSchema(true),
+									"transformer_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": ediTypeSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instructions_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem:     s3LocationSchema(false).Elem,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(b2bi.CapabilityType_Values(), false),
+			},
+		},
+	}
+}
+
+func s3LocationSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 1024),
+				},
+			},
+		},
+	}
+}
+
+func resourceCapabilityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateCapabilityInput{
+		Configuration: expandCapabilityConfiguration(d.Get("configuration").([]interface{})),
+		Name:          aws.String(name),
+		Tags:          getTagsIn(ctx),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("instructions_documents"); ok {
+		input.InstructionsDocuments = expandS3Locations(v.([]interface{}))
+	}
+
+	output, err := conn.CreateCapabilityWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating B2BI Capability (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CapabilityId))
+
+	return append(diags, resourceCapabilityRead(ctx, d, meta)...)
+}
+
+func resourceCapabilityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	output, err := FindCapabilityByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Capability (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading B2BI Capability (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.CapabilityArn)
+	if err := d.Set("configuration", flattenCapabilityConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("instructions_documents", flattenS3Locations(output.InstructionsDocuments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instructions_documents: %s", err)
+	}
+	d.Set("name", output.Name)
+	d.Set("type", output.Type)
+
+	return diags
+}
+
+func resourceCapabilityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdateCapabilityInput{
+			CapabilityId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("configuration") {
+			input.Configuration = expandCapabilityConfiguration(d.Get("configuration").([]interface{}))
+		}
+
+		if d.HasChange("instructions_documents") {
+			input.InstructionsDocuments = expandS3Locations(d.Get("instructions_documents").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateCapabilityWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating B2BI Capability (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCapabilityRead(ctx, d, meta)...)
+}
+
+func resourceCapabilityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	log.Printf("[DEBUG] Deleting B2BI Capability: %s", d.Id())
+	_, err := conn.DeleteCapabilityWithContext(ctx, &b2bi.DeleteCapabilityInput{
+		CapabilityId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting B2BI Capability (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCapabilityByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetCapabilityOutput, error) {
+	input := &b2bi.GetCapabilityInput{
+		CapabilityId: aws.String(id),
+	}
+
+	output, err := conn.GetCapabilityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandCapabilityConfiguration(tfList []interface{}) *b2bi.CapabilityConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &b2bi.CapabilityConfiguration{}
+
+	if v, ok := tfMap["edi"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Edi = &b2bi.EdiConfiguration{
+			InputLocation:  expandS3Location(tfMap["input_location"].([]interface{})),
+			OutputLocation: expandS3Location(tfMap["output_location"].([]interface{})),
+			TransformerId:  aws.String(tfMap["transformer_id"].(string)),
+			Type:           expandEDIType(tfMap["type"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3Location(tfList []interface{}) *b2bi.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &b2bi.S3Location{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Locations(tfList []interface{}) []*b2bi.S3Location {
+	var apiObjects []*b2bi.S3Location
+
+	for _, tfMapRaw := range tfList {
+		if tfMapRaw == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandS3Location([]interface{}{tfMapRaw}))
+	}
+
+	return apiObjects
+}
+
+func flattenCapabilityConfiguration(apiObject *b2bi.CapabilityConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Edi; v != nil {
+		tfMap["edi"] = []interface{}{map[string]interface{}{
+			"input_location":  flattenS3Location(v.InputLocation),
+			"output_location": flattenS3Location(v.OutputLocation),
+			"transformer_id":  aws.StringValue(v.TransformerId),
+			"type":            flattenEDIType(v.Type),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Location(apiObject *b2bi.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bucket_name": aws.StringValue(apiObject.BucketName),
+		"key":         aws.StringValue(apiObject.Key),
+	}}
+}
+
+func flattenS3Locations(apiObjects []*b2bi.S3Location) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenS3Location(apiObject)[0])
+	}
+
+	return tfList
+}
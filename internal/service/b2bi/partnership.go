@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_b2bi_partnership", name="Partnership")
+// @Tags(identifierAttribute="arn")
+func ResourcePartnership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePartnershipCreate,
+		ReadWithoutTimeout:   resourcePartnershipRead,
+		UpdateWithoutTimeout: resourcePartnershipUpdate,
+		DeleteWithoutTimeout: resourcePartnershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(5, 254),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"phone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 22),
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trading_partner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePartnershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreatePartnershipInput{
+		Capabilities: flex.ExpandStringSet(d.Get("capabilities").(*schema.Set)),
+		Email:        aws.String(d.Get("email").(string)),
+		Name:         aws.String(name),
+		ProfileId:    aws.String(d.Get("profile_id").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("phone"); ok {
+		input.Phone = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePartnershipWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating B2BI Partnership (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PartnershipId))
+
+	return append(diags, resourcePartnershipRead(ctx, d, meta)...)
+}
+
+func resourcePartnershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	output, err := FindPartnershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Partnership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading B2BI Partnership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PartnershipArn)
+	d.Set("capabilities", aws.StringValueSlice(output.Capabilities))
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("email", output.Email)
+	d.Set("name", output.Name)
+	d.Set("phone", output.Phone)
+	d.Set("profile_id", output.ProfileId)
+	d.Set("trading_partner_id", output.TradingPartnerId)
+
+	return diags
+}
+
+func resourcePartnershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdatePartnershipInput{
+			PartnershipId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capabilities") {
+			input.Capabilities = flex.ExpandStringSet(d.Get("capabilities").(*schema.Set))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdatePartnershipWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating B2BI Partnership (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePartnershipRead(ctx, d, meta)...)
+}
+
+func resourcePartnershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIConn(ctx)
+
+	log.Printf("[DEBUG] Deleting B2BI Partnership: %s", d.Id())
+	_, err := conn.DeletePartnershipWithContext(ctx, &b2bi.DeletePartnershipInput{
+		PartnershipId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting B2BI Partnership (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPartnershipByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetPartnershipOutput, error) {
+	input := &b2bi.GetPartnershipInput{
+		PartnershipId: aws.String(id),
+	}
+
+	output, err := conn.GetPartnershipWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -97,7 +97,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 2,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -136,7 +135,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"launch_template": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -196,6 +194,11 @@ func ResourceComputeEnvironment() *schema.Resource {
 							},
 							ValidateFunc: validation.StringInSlice(batch.CRType_Values(), true),
 						},
+						"update_to_latest_image_version": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -259,6 +262,25 @@ func ResourceComputeEnvironment() *schema.Resource {
 				},
 				ValidateFunc: validation.StringInSlice(batch.CEType_Values(), true),
 			},
+			"update_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_execution_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+						"terminate_jobs_on_update": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -300,6 +322,22 @@ func resourceComputeEnvironmentCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Batch Compute Environment (%s) create: %s", d.Id(), err)
 	}
 
+	// UpdatePolicy can't be specified on create.
+	if v, ok := d.GetOk("update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &batch.UpdateComputeEnvironmentInput{
+			ComputeEnvironment: aws.String(d.Id()),
+			UpdatePolicy:       expandUpdatePolicy(v.([]interface{})[0].(map[string]interface{})),
+		}
+
+		if _, err := conn.UpdateComputeEnvironmentWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Batch Compute Environment (%s) update policy: %s", d.Id(), err)
+		}
+
+		if _, err := waitComputeEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Batch Compute Environment (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceComputeEnvironmentRead(ctx, d, meta)...)
 }
 
@@ -325,7 +363,10 @@ func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("compute_environment_name", computeEnvironment.ComputeEnvironmentName)
 	d.Set("compute_environment_name_prefix", create.NamePrefixFromName(aws.StringValue(computeEnvironment.ComputeEnvironmentName)))
 	if computeEnvironment.ComputeResources != nil {
-		if err := d.Set("compute_resources", []interface{}{flattenComputeResource(ctx, computeEnvironment.ComputeResources)}); err != nil {
+		tfMap := flattenComputeResource(ctx, computeEnvironment.ComputeResources)
+		// UpdateToLatestImageVersion is not returned by the API.
+		tfMap["update_to_latest_image_version"] = d.Get("compute_resources.0.update_to_latest_image_version").(bool)
+		if err := d.Set("compute_resources", []interface{}{tfMap}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting compute_resources: %s", err)
 		}
 	} else {
@@ -344,6 +385,13 @@ func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("status", computeEnvironment.Status)
 	d.Set("status_reason", computeEnvironment.StatusReason)
 	d.Set("type", computeEnvironmentType)
+	if computeEnvironment.UpdatePolicy != nil {
+		if err := d.Set("update_policy", []interface{}{flattenUpdatePolicy(computeEnvironment.UpdatePolicy)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting update_policy: %s", err)
+		}
+	} else {
+		d.Set("update_policy", nil)
+	}
 
 	setTagsOut(ctx, computeEnvironment.Tags)
 
@@ -367,6 +415,12 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 			input.State = aws.String(d.Get("state").(string))
 		}
 
+		if d.HasChange("update_policy") {
+			if v, ok := d.GetOk("update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.UpdatePolicy = expandUpdatePolicy(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if computeEnvironmentType := strings.ToUpper(d.Get("type").(string)); computeEnvironmentType == batch.CETypeManaged {
 			// "At least one compute-resources attribute must be specified"
 			computeResourceUpdate := &batch.ComputeResourceUpdate{
@@ -462,6 +516,10 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 					computeResourceUpdate.LaunchTemplate = expandLaunchTemplateSpecificationUpdate(launchTemplate)
 				}
 
+				if d.HasChange("compute_resources.0.update_to_latest_image_version") {
+					computeResourceUpdate.UpdateToLatestImageVersion = aws.Bool(d.Get("compute_resources.0.update_to_latest_image_version").(bool))
+				}
+
 				if d.HasChange("compute_resources.0.tags") {
 					if tags, ok := d.GetOk("compute_resources.0.tags"); ok {
 						computeResourceUpdate.Tags = Tags(tftags.New(ctx, tags.(map[string]interface{})).IgnoreAWS())
@@ -536,6 +594,17 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 	if diff.Id() != "" {
 		// Update.
 
+		// Removing update_policy restores the default update policy.
+		if v := diff.GetRawConfig().GetAttr("update_policy"); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+			if v, ok := diff.GetOk("update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				if tfMap := v.([]interface{})[0].(map[string]interface{}); tfMap["job_execution_timeout_minutes"].(int) != defaultUpdatePolicyJobExecutionTimeoutMinutes || tfMap["terminate_jobs_on_update"].(bool) {
+					if err := diff.SetNew("update_policy", []interface{}{flattenUpdatePolicy(defaultUpdatePolicy())}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
 		fargateComputeResources := isFargateType(diff.Get("compute_resources.0.type").(string))
 
 		if !isUpdatableComputeEnvironment(diff) {
@@ -581,6 +650,18 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 				}
 			}
 
+			if diff.HasChange("compute_resources.0.ec2_configuration.1.image_id_override") {
+				if err := diff.ForceNew("compute_resources.0.ec2_configuration.1.image_id_override"); err != nil {
+					return err
+				}
+			}
+
+			if diff.HasChange("compute_resources.0.ec2_configuration.1.image_type") {
+				if err := diff.ForceNew("compute_resources.0.ec2_configuration.1.image_type"); err != nil {
+					return err
+				}
+			}
+
 			if diff.HasChange("compute_resources.0.ec2_key_pair") {
 				if err := diff.ForceNew("compute_resources.0.ec2_key_pair"); err != nil {
 					return err
@@ -1138,6 +1219,54 @@ func flattenComputeResource(ctx context.Context, apiObject *batch.ComputeResourc
 	return tfMap
 }
 
+const (
+	defaultUpdatePolicyJobExecutionTimeoutMinutes = 30
+)
+
+// defaultUpdatePolicy returns the update policy of a compute environment that has none configured.
+func defaultUpdatePolicy() *batch.UpdatePolicy {
+	return &batch.UpdatePolicy{
+		JobExecutionTimeoutMinutes: aws.Int64(defaultUpdatePolicyJobExecutionTimeoutMinutes),
+		TerminateJobsOnUpdate:      aws.Bool(false),
+	}
+}
+
+func expandUpdatePolicy(tfMap map[string]interface{}) *batch.UpdatePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.UpdatePolicy{}
+
+	if v, ok := tfMap["job_execution_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.JobExecutionTimeoutMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["terminate_jobs_on_update"].(bool); ok {
+		apiObject.TerminateJobsOnUpdate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenUpdatePolicy(apiObject *batch.UpdatePolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JobExecutionTimeoutMinutes; v != nil {
+		tfMap["job_execution_timeout_minutes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TerminateJobsOnUpdate; v != nil {
+		tfMap["terminate_jobs_on_update"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenEKSConfiguration(apiObject *batch.EksConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	guardrailVersionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_bedrock_guardrail_version", name="Guardrail Version")
+func ResourceGuardrailVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGuardrailVersionCreate,
+		ReadWithoutTimeout:   resourceGuardrailVersionRead,
+		UpdateWithoutTimeout: resourceGuardrailVersionUpdate,
+		DeleteWithoutTimeout: resourceGuardrailVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGuardrailVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"guardrail_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGuardrailVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn(ctx)
+
+	guardrailARN := d.Get("guardrail_arn").(string)
+	input := &bedrock.CreateGuardrailVersionInput{
+		GuardrailIdentifier: aws.String(guardrailARN),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateGuardrailVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Guardrail (%s) Version: %s", guardrailARN, err)
+	}
+
+	version := aws.StringValue(output.Version)
+	id, err := flex.FlattenResourceId([]string{guardrailARN, version}, guardrailVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitGuardrailVersionReady(ctx, conn, guardrailARN, version, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Guardrail Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceGuardrailVersionRead(ctx, d, meta)...)
+}
+
+func resourceGuardrailVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), guardrailVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	guardrailARN, version := parts[0], parts[1]
+	output, err := FindGuardrailByTwoPartKey(ctx, conn, guardrailARN, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Guardrail Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Guardrail Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("guardrail_arn", output.GuardrailArn)
+	d.Set("version", output.Version)
+
+	return diags
+}
+
+func resourceGuardrailVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only skip_destroy can be updated, which is a Terraform-only argument.
+	return resourceGuardrailVersionRead(ctx, d, meta)
+}
+
+func resourceGuardrailVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining Bedrock Guardrail Version: %s", d.Id())
+		return diags
+	}
+
+	conn := meta.(*conns.AWSClient).BedrockConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), guardrailVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	guardrailARN, version := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting Bedrock Guardrail Version: %s", d.Id())
+	_, err = conn.DeleteGuardrailWithContext(ctx, &bedrock.DeleteGuardrailInput{
+		GuardrailIdentifier: aws.String(guardrailARN),
+		GuardrailVersion:    aws.String(version),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Guardrail Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitGuardrailVersionDeleted(ctx, conn, guardrailARN, version, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Guardrail Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceGuardrailVersionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := flex.ExpandResourceId(d.Id(), guardrailVersionResourceIDPartCount, false); err != nil {
+		return nil, err
+	}
+
+	d.Set("skip_destroy", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindGuardrailByTwoPartKey(ctx context.Context, conn *bedrock.Bedrock, id, version string) (*bedrock.GetGuardrailOutput, error) {
+	input := &bedrock.GetGuardrailInput{
+		GuardrailIdentifier: aws.String(id),
+		GuardrailVersion:    aws.String(version),
+	}
+
+	output, err := conn.GetGuardrailWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGuardrail(ctx context.Context, conn *bedrock.Bedrock, id, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGuardrailByTwoPartKey(ctx, conn, id, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitGuardrailVersionReady(ctx context.Context, conn *bedrock.Bedrock, id, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrock.GuardrailStatusCreating, bedrock.GuardrailStatusVersioning},
+		Target:  []string{bedrock.GuardrailStatusReady},
+		Refresh: statusGuardrail(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.StatusReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGuardrailVersionDeleted(ctx context.Context, conn *bedrock.Bedrock, id, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrock.GuardrailStatusDeleting, bedrock.GuardrailStatusReady},
+		Target:  []string{},
+		Refresh: statusGuardrail(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.StatusReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
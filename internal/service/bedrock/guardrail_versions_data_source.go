@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_bedrock_guardrail_versions")
+func DataSourceGuardrailVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGuardrailVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"guardrail_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGuardrailVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn(ctx)
+
+	guardrailID := d.Get("guardrail_identifier").(string)
+	input := &bedrock.ListGuardrailsInput{
+		GuardrailIdentifier: aws.String(guardrailID),
+	}
+
+	var output []*bedrock.GuardrailSummary
+
+	err := conn.ListGuardrailsPagesWithContext(ctx, input, func(page *bedrock.ListGuardrailsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Guardrails...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Guardrail (%s) Versions: %s", guardrailID, err)
+	}
+
+	d.SetId(guardrailID)
+
+	if err := d.Set("versions", flattenGuardrailSummaries(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenGuardrailSummaries(apiObjects []*bedrock.GuardrailSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":         aws.StringValue(apiObject.Arn),
+			"created_at":  aws.TimeValue(apiObject.CreatedAt).Format(time.RFC3339),
+			"description": aws.StringValue(apiObject.Description),
+			"id":          aws.StringValue(apiObject.Id),
+			"name":        aws.StringValue(apiObject.Name),
+			"status":      aws.StringValue(apiObject.Status),
+			"updated_at":  aws.TimeValue(apiObject.UpdatedAt).Format(time.RFC3339),
+			"version":     aws.StringValue(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
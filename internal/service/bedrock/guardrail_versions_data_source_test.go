@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccBedrockGuardrailVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	guardrailARN := testAccPreCheckGuardrail(t)
+	dataSourceName := "data.aws_bedrock_guardrail_versions.test"
+	resourceName := "aws_bedrock_guardrail_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrock.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailVersionsDataSourceConfig_basic(guardrailARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "guardrail_identifier", guardrailARN),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "versions.#", 1),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "versions.*", map[string]string{
+						"status":  bedrock.GuardrailStatusReady,
+						"version": "DRAFT",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "versions.*.version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailVersionsDataSourceConfig_basic(guardrailARN string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail_version" "test" {
+  guardrail_arn = %[1]q
+}
+
+data "aws_bedrock_guardrail_versions" "test" {
+  guardrail_identifier = aws_bedrock_guardrail_version.test.guardrail_arn
+
+  depends_on = [aws_bedrock_guardrail_version.test]
+}
+`, guardrailARN)
+}
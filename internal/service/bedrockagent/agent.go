@@ -0,0 +1,502 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	agentVersionDraft = "DRAFT"
+)
+
+// @SDKResource("aws_bedrockagent_agent", name="Agent")
+// @Tags(identifierAttribute="agent_arn")
+func ResourceAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAgentCreate,
+		ReadWithoutTimeout:   resourceAgentRead,
+		UpdateWithoutTimeout: resourceAgentUpdate,
+		DeleteWithoutTimeout: resourceAgentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"agent_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"agent_resource_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"foundation_model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"guardrail_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guardrail_identifier": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressEquivalentGuardrailIdentifier,
+						},
+						"guardrail_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"instruction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(40, 4000),
+			},
+			"prepare_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"prepared_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"skip_resource_in_use_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	name := d.Get("agent_name").(string)
+	input := &bedrockagent.CreateAgentInput{
+		AgentName:            aws.String(name),
+		AgentResourceRoleArn: aws.String(d.Get("agent_resource_role_arn").(string)),
+		FoundationModel:      aws.String(d.Get("foundation_model").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("customer_encryption_key_arn"); ok {
+		input.CustomerEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("guardrail_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.GuardrailConfiguration = expandGuardrailConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("idle_session_ttl_in_seconds"); ok {
+		input.IdleSessionTTLInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("instruction"); ok {
+		input.Instruction = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAgentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Agent (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Agent.AgentId))
+
+	if _, err := waitAgentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Agent (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("prepare_agent").(bool) {
+		if _, err := prepareAgent(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceAgentRead(ctx, d, meta)...)
+}
+
+func resourceAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	agent, err := FindAgentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Agent (%s): %s", d.Id(), err)
+	}
+
+	d.Set("agent_arn", agent.AgentArn)
+	d.Set("agent_id", agent.AgentId)
+	d.Set("agent_name", agent.AgentName)
+	d.Set("agent_resource_role_arn", agent.AgentResourceRoleArn)
+	d.Set("agent_version", agent.AgentVersion)
+	d.Set("customer_encryption_key_arn", agent.CustomerEncryptionKeyArn)
+	d.Set("description", agent.Description)
+	d.Set("foundation_model", agent.FoundationModel)
+	if err := d.Set("guardrail_configuration", flattenGuardrailConfiguration(agent.GuardrailConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting guardrail_configuration: %s", err)
+	}
+	d.Set("idle_session_ttl_in_seconds", agent.IdleSessionTTLInSeconds)
+	d.Set("instruction", agent.Instruction)
+	if agent.PreparedAt != nil {
+		d.Set("prepared_at", aws.TimeValue(agent.PreparedAt).Format(time.RFC3339))
+	} else {
+		d.Set("prepared_at", nil)
+	}
+
+	return diags
+}
+
+func resourceAgentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	if d.HasChanges("agent_name", "agent_resource_role_arn", "customer_encryption_key_arn", "description", "foundation_model", "guardrail_configuration", "idle_session_ttl_in_seconds", "instruction") {
+		input := &bedrockagent.UpdateAgentInput{
+			AgentId:              aws.String(d.Id()),
+			AgentName:            aws.String(d.Get("agent_name").(string)),
+			AgentResourceRoleArn: aws.String(d.Get("agent_resource_role_arn").(string)),
+			FoundationModel:      aws.String(d.Get("foundation_model").(string)),
+		}
+
+		if v, ok := d.GetOk("customer_encryption_key_arn"); ok {
+			input.CustomerEncryptionKeyArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("guardrail_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.GuardrailConfiguration = expandGuardrailConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("guardrail_configuration") {
+			// Detach the guardrail.
+			input.GuardrailConfiguration = &bedrockagent.GuardrailConfiguration{}
+		}
+
+		if v, ok := d.GetOk("idle_session_ttl_in_seconds"); ok {
+			input.IdleSessionTTLInSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("instruction"); ok {
+			input.Instruction = aws.String(v.(string))
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateAgentWithContext(ctx, input)
+		}, bedrockagent.ErrCodeConflictException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Agent (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAgentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Agent (%s) update: %s", d.Id(), err)
+		}
+
+		if d.Get("prepare_agent").(bool) {
+			if _, err := prepareAgent(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceAgentRead(ctx, d, meta)...)
+}
+
+func resourceAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	log.Printf("[INFO] Deleting Bedrock Agent: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAgentWithContext(ctx, &bedrockagent.DeleteAgentInput{
+			AgentId:                aws.String(d.Id()),
+			SkipResourceInUseCheck: aws.Bool(d.Get("skip_resource_in_use_check").(bool)),
+		})
+	}, bedrockagent.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Agent (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAgentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Agent (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceAgentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("prepare_agent", true)
+	d.Set("skip_resource_in_use_check", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// prepareAgent creates a DRAFT version of the agent that can be used for testing and aliasing.
+// Changes to the agent, its action groups or its knowledge bases only take effect once the agent is prepared again.
+func prepareAgent(ctx context.Context, conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	input := &bedrockagent.PrepareAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	// The agent may still be preparing after a change made by another resource.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.PrepareAgentWithContext(ctx, input)
+	}, bedrockagent.ErrCodeConflictException)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent (%s): %w", id, err)
+	}
+
+	agent, err := waitAgentPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent (%s) prepare: %w", id, err)
+	}
+
+	return agent, nil
+}
+
+func FindAgentByID(ctx context.Context, conn *bedrockagent.BedrockAgent, id string) (*bedrockagent.Agent, error) {
+	input := &bedrockagent.GetAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	output, err := conn.GetAgentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Agent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Agent, nil
+}
+
+func statusAgent(ctx context.Context, conn *bedrockagent.BedrockAgent, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAgentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AgentStatus), nil
+	}
+}
+
+func waitAgentCreated(ctx context.Context, conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusCreating},
+		Target:  []string{bedrockagent.AgentStatusNotPrepared, bedrockagent.AgentStatusPrepared},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentUpdated(ctx context.Context, conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusUpdating},
+		Target:  []string{bedrockagent.AgentStatusNotPrepared, bedrockagent.AgentStatusPrepared},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentPrepared(ctx context.Context, conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusNotPrepared, bedrockagent.AgentStatusPreparing},
+		Target:  []string{bedrockagent.AgentStatusPrepared},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentDeleted(ctx context.Context, conn *bedrockagent.BedrockAgent, id string, timeout time.Duration) (*bedrockagent.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrockagent.AgentStatusDeleting},
+		Target:  []string{},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandGuardrailConfiguration(tfMap map[string]interface{}) *bedrockagent.GuardrailConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.GuardrailConfiguration{}
+
+	if v, ok := tfMap["guardrail_identifier"].(string); ok && v != "" {
+		apiObject.GuardrailIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["guardrail_version"].(string); ok && v != "" {
+		apiObject.GuardrailVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGuardrailConfiguration(apiObject *bedrockagent.GuardrailConfiguration) []interface{} {
+	if apiObject == nil || apiObject.GuardrailIdentifier == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"guardrail_identifier": aws.StringValue(apiObject.GuardrailIdentifier),
+		"guardrail_version":    aws.StringValue(apiObject.GuardrailVersion),
+	}}
+}
+
+// suppressEquivalentGuardrailIdentifier treats a guardrail ID and the corresponding guardrail ARN as equal.
+func suppressEquivalentGuardrailIdentifier(k, old, new string, d *schema.ResourceData) bool {
+	guardrailID := func(v string) string {
+		return v[strings.LastIndex(v, "/")+1:]
+	}
+
+	return guardrailID(old) == guardrailID(new)
+}
@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	agentActionGroupResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_bedrockagent_agent_action_group", name="Agent Action Group")
+func ResourceAgentActionGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAgentActionGroupCreate,
+		ReadWithoutTimeout:   resourceAgentActionGroupRead,
+		UpdateWithoutTimeout: resourceAgentActionGroupUpdate,
+		DeleteWithoutTimeout: resourceAgentActionGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgentActionGroupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAgentActionGroupCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action_group_executor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_control": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(bedrockagent.CustomControlMethod_Values(), false),
+							ExactlyOneOf: []string{"action_group_executor.0.custom_control", "action_group_executor.0.lambda"},
+						},
+						"lambda": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"action_group_executor.0.custom_control", "action_group_executor.0.lambda"},
+						},
+					},
+				},
+			},
+			"action_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"action_group_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(bedrockagent.ActionGroupState_Values(), false),
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_schema": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"api_schema_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"payload": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"api_schema.0.payload", "api_schema.0.s3"},
+						},
+						"s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"api_schema.0.payload", "api_schema.0.s3"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"s3_object_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"api_schema_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"api_schema"},
+			},
+			"api_schema_file_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"parent_action_group_signature": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(bedrockagent.ActionGroupSignature_Values(), false),
+				ConflictsWith: []string{"action_group_executor", "api_schema", "api_schema_file"},
+			},
+			"prepare_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"skip_resource_in_use_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAgentActionGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	agentID := d.Get("agent_id").(string)
+	name := d.Get("action_group_name").(string)
+	input := &bedrockagent.CreateAgentActionGroupInput{
+		ActionGroupName: aws.String(name),
+		AgentId:         aws.String(agentID),
+		AgentVersion:    aws.String(agentVersionDraft),
+	}
+
+	if v, ok := d.GetOk("action_group_executor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ActionGroupExecutor = expandActionGroupExecutor(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("action_group_state"); ok {
+		input.ActionGroupState = aws.String(v.(string))
+	}
+
+	apiSchema, hash, err := expandAgentActionGroupAPISchema(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input.ApiSchema = apiSchema
+	d.Set("api_schema_file_hash", hash)
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_action_group_signature"); ok {
+		input.ParentActionGroupSignature = aws.String(v.(string))
+	}
+
+	// The agent may still be preparing after a change made by another resource.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateAgentActionGroupWithContext(ctx, input)
+	}, bedrockagent.ErrCodeConflictException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Agent Action Group (%s): %s", name, err)
+	}
+
+	actionGroupID := aws.StringValue(outputRaw.(*bedrockagent.CreateAgentActionGroupOutput).AgentActionGroup.ActionGroupId)
+	id, err := flex.FlattenResourceId([]string{actionGroupID, agentID, agentVersionDraft}, agentActionGroupResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("prepare_agent").(bool) {
+		if _, err := prepareAgent(ctx, conn, agentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceAgentActionGroupRead(ctx, d, meta)...)
+}
+
+func resourceAgentActionGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), agentActionGroupResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	actionGroupID, agentID, agentVersion := parts[0], parts[1], parts[2]
+	actionGroup, err := FindAgentActionGroupByThreePartKey(ctx, conn, actionGroupID, agentID, agentVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Action Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Agent Action Group (%s): %s", d.Id(), err)
+	}
+
+	if actionGroup.ActionGroupExecutor != nil {
+		if err := d.Set("action_group_executor", []interface{}{flattenActionGroupExecutor(actionGroup.ActionGroupExecutor)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting action_group_executor: %s", err)
+		}
+	} else {
+		d.Set("action_group_executor", nil)
+	}
+	d.Set("action_group_id", actionGroup.ActionGroupId)
+	d.Set("action_group_name", actionGroup.ActionGroupName)
+	d.Set("action_group_state", actionGroup.ActionGroupState)
+	d.Set("agent_id", actionGroup.AgentId)
+	d.Set("agent_version", actionGroup.AgentVersion)
+	// When the schema is read from a local file, drift is detected through the file's hash instead.
+	if _, ok := d.GetOk("api_schema_file"); !ok {
+		if actionGroup.ApiSchema != nil {
+			if err := d.Set("api_schema", []interface{}{flattenAPISchema(actionGroup.ApiSchema)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting api_schema: %s", err)
+			}
+		} else {
+			d.Set("api_schema", nil)
+		}
+	}
+	d.Set("description", actionGroup.Description)
+	d.Set("parent_action_group_signature", actionGroup.ParentActionSignature)
+
+	return diags
+}
+
+func resourceAgentActionGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	if d.HasChanges("action_group_executor", "action_group_name", "action_group_state", "api_schema", "api_schema_file", "api_schema_file_hash", "description", "parent_action_group_signature") {
+		parts, err := flex.ExpandResourceId(d.Id(), agentActionGroupResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		actionGroupID, agentID, agentVersion := parts[0], parts[1], parts[2]
+		input := &bedrockagent.UpdateAgentActionGroupInput{
+			ActionGroupId:   aws.String(actionGroupID),
+			ActionGroupName: aws.String(d.Get("action_group_name").(string)),
+			AgentId:         aws.String(agentID),
+			AgentVersion:    aws.String(agentVersion),
+		}
+
+		if v, ok := d.GetOk("action_group_executor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ActionGroupExecutor = expandActionGroupExecutor(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("action_group_state"); ok {
+			input.ActionGroupState = aws.String(v.(string))
+		}
+
+		apiSchema, hash, err := expandAgentActionGroupAPISchema(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.ApiSchema = apiSchema
+		d.Set("api_schema_file_hash", hash)
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("parent_action_group_signature"); ok {
+			input.ParentActionGroupSignature = aws.String(v.(string))
+		}
+
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateAgentActionGroupWithContext(ctx, input)
+		}, bedrockagent.ErrCodeConflictException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Agent Action Group (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("prepare_agent").(bool) {
+			if _, err := prepareAgent(ctx, conn, agentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceAgentActionGroupRead(ctx, d, meta)...)
+}
+
+func resourceAgentActionGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), agentActionGroupResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	actionGroupID, agentID, agentVersion := parts[0], parts[1], parts[2]
+
+	log.Printf("[INFO] Deleting Bedrock Agent Action Group: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAgentActionGroupWithContext(ctx, &bedrockagent.DeleteAgentActionGroupInput{
+			ActionGroupId:          aws.String(actionGroupID),
+			AgentId:                aws.String(agentID),
+			AgentVersion:           aws.String(agentVersion),
+			SkipResourceInUseCheck: aws.Bool(d.Get("skip_resource_in_use_check").(bool)),
+		})
+	}, bedrockagent.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Agent Action Group (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("prepare_agent").(bool) {
+		if _, err := prepareAgent(ctx, conn, agentID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAgentActionGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := flex.ExpandResourceId(d.Id(), agentActionGroupResourceIDPartCount, false); err != nil {
+		return nil, err
+	}
+
+	d.Set("prepare_agent", true)
+	d.Set("skip_resource_in_use_check", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAgentActionGroupCustomizeDiff hashes the local API schema file so that changes to its content are planned as an update.
+func resourceAgentActionGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("api_schema_file") {
+		return d.SetNewComputed("api_schema_file_hash")
+	}
+
+	v, ok := d.GetOk("api_schema_file")
+
+	if !ok {
+		if d.Get("api_schema_file_hash").(string) != "" {
+			return d.SetNew("api_schema_file_hash", "")
+		}
+
+		return nil
+	}
+
+	_, hash, err := readAPISchemaFile(v.(string))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("api_schema_file_hash").(string) != hash {
+		return d.SetNew("api_schema_file_hash", hash)
+	}
+
+	return nil
+}
+
+func FindAgentActionGroupByThreePartKey(ctx context.Context, conn *bedrockagent.BedrockAgent, actionGroupID, agentID, agentVersion string) (*bedrockagent.AgentActionGroup, error) {
+	input := &bedrockagent.GetAgentActionGroupInput{
+		ActionGroupId: aws.String(actionGroupID),
+		AgentId:       aws.String(agentID),
+		AgentVersion:  aws.String(agentVersion),
+	}
+
+	output, err := conn.GetAgentActionGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentActionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentActionGroup, nil
+}
+
+// readAPISchemaFile returns the content of the API schema file and its Base64-encoded SHA256 hash.
+func readAPISchemaFile(v string) (string, string, error) {
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("expanding homedir in api_schema_file (%s): %w", v, err)
+	}
+
+	content, err := os.ReadFile(filename)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading api_schema_file (%s): %w", filename, err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	return string(content), base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+// expandAgentActionGroupAPISchema returns the API schema along with the hash of the local API schema file, if any.
+func expandAgentActionGroupAPISchema(d *schema.ResourceData) (*bedrockagent.APISchema, string, error) {
+	if v, ok := d.GetOk("api_schema_file"); ok {
+		content, hash, err := readAPISchemaFile(v.(string))
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return &bedrockagent.APISchema{
+			Payload: aws.String(content),
+		}, hash, nil
+	}
+
+	if v, ok := d.GetOk("api_schema"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return expandAPISchema(v.([]interface{})[0].(map[string]interface{})), "", nil
+	}
+
+	return nil, "", nil
+}
+
+func expandActionGroupExecutor(tfMap map[string]interface{}) *bedrockagent.ActionGroupExecutor {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.ActionGroupExecutor{}
+
+	if v, ok := tfMap["custom_control"].(string); ok && v != "" {
+		apiObject.CustomControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["lambda"].(string); ok && v != "" {
+		apiObject.Lambda = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAPISchema(tfMap map[string]interface{}) *bedrockagent.APISchema {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.APISchema{}
+
+	if v, ok := tfMap["payload"].(string); ok && v != "" {
+		apiObject.Payload = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3 = expandS3Identifier(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3Identifier(tfMap map[string]interface{}) *bedrockagent.S3Identifier {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrockagent.S3Identifier{}
+
+	if v, ok := tfMap["s3_bucket_name"].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_object_key"].(string); ok && v != "" {
+		apiObject.S3ObjectKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenActionGroupExecutor(apiObject *bedrockagent.ActionGroupExecutor) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"custom_control": aws.StringValue(apiObject.CustomControl),
+		"lambda":         aws.StringValue(apiObject.Lambda),
+	}
+}
+
+func flattenAPISchema(apiObject *bedrockagent.APISchema) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload": aws.StringValue(apiObject.Payload),
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"s3_bucket_name": aws.StringValue(v.S3BucketName),
+			"s3_object_key":  aws.StringValue(v.S3ObjectKey),
+		}}
+	}
+
+	return tfMap
+}
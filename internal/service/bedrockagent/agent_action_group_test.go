@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockAgentAgentActionGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.AgentActionGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_payload(rName, "test-fixtures/api_schema.yaml"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_group_executor.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "action_group_executor.0.lambda", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "action_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "action_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_state", bedrockagent.ActionGroupStateEnabled),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_schema.0.payload"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema_file_hash", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_resource_in_use_check"},
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentActionGroup_apiSchemaFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 bedrockagent.AgentActionGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+	schemaFile := filepath.Join(t.TempDir(), "api_schema.yaml")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopyAPISchemaFile(t, "test-fixtures/api_schema.yaml", schemaFile)
+				},
+				Config: testAccAgentActionGroupConfig_apiSchemaFile(rName, schemaFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "api_schema.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema_file", schemaFile),
+					resource.TestCheckResourceAttrSet(resourceName, "api_schema_file_hash"),
+				),
+			},
+			{
+				// Only the file's content changes, its path stays the same.
+				PreConfig: func() {
+					testAccCopyAPISchemaFile(t, "test-fixtures/api_schema_modified.yaml", schemaFile)
+				},
+				Config: testAccAgentActionGroupConfig_apiSchemaFile(rName, schemaFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName, &v2),
+					testAccCheckAgentActionGroupAPISchemaChanged(&v1, &v2),
+					resource.TestCheckResourceAttrSet(resourceName, "api_schema_file_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentActionGroup_userInput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.AgentActionGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_userInput(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_group_executor.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parent_action_group_signature", bedrockagent.ActionGroupSignatureAmazonUserInput),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_resource_in_use_check"},
+			},
+		},
+	})
+}
+
+func testAccCheckAgentActionGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_agent_action_group" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfbedrockagent.FindAgentActionGroupByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Action Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentActionGroupExists(ctx context.Context, n string, v *bedrockagent.AgentActionGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn(ctx)
+
+		output, err := tfbedrockagent.FindAgentActionGroupByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAgentActionGroupAPISchemaChanged(before, after *bedrockagent.AgentActionGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ApiSchema == nil || after.ApiSchema == nil {
+			return fmt.Errorf("Bedrock Agent Action Group has no API schema")
+		}
+
+		if *before.ApiSchema.Payload == *after.ApiSchema.Payload {
+			return fmt.Errorf("Bedrock Agent Action Group API schema was not updated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCopyAPISchemaFile(t *testing.T, src, dst string) {
+	t.Helper()
+
+	content, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatalf("reading %s: %s", src, err)
+	}
+
+	if err := os.WriteFile(dst, content, 0600); err != nil {
+		t.Fatalf("writing %s: %s", dst, err)
+	}
+}
+
+func testAccAgentActionGroupConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "Basic instructions for the agent to follow when answering."), fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Principal": {
+      "Service": "lambda.amazonaws.com"
+    },
+    "Effect": "Allow"
+  }]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+}
+`, rName))
+}
+
+func testAccAgentActionGroupConfig_payload(rName, schemaFile string) string {
+	return acctest.ConfigCompose(testAccAgentActionGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name          = %[1]q
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  skip_resource_in_use_check = true
+
+  action_group_executor {
+    lambda = aws_lambda_function.test.arn
+  }
+
+  api_schema {
+    payload = file(%[2]q)
+  }
+}
+`, rName, schemaFile))
+}
+
+func testAccAgentActionGroupConfig_apiSchemaFile(rName, schemaFile string) string {
+	return acctest.ConfigCompose(testAccAgentActionGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name          = %[1]q
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  api_schema_file            = %[2]q
+  skip_resource_in_use_check = true
+
+  action_group_executor {
+    lambda = aws_lambda_function.test.arn
+  }
+}
+`, rName, schemaFile))
+}
+
+func testAccAgentActionGroupConfig_userInput(rName string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "Basic instructions for the agent to follow when answering."), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name             = %[1]q
+  agent_id                      = aws_bedrockagent_agent.test.agent_id
+  parent_action_group_signature = "AMAZON.UserInput"
+  skip_resource_in_use_check    = true
+}
+`, rName))
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	agentAliasResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_bedrockagent_agent_alias", name="Agent Alias")
+// @Tags(identifierAttribute="agent_alias_arn")
+func ResourceAgentAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAgentAliasCreate,
+		ReadWithoutTimeout:   resourceAgentAliasRead,
+		UpdateWithoutTimeout: resourceAgentAliasUpdate,
+		DeleteWithoutTimeout: resourceAgentAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"agent_alias_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_alias_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_alias_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"provisioned_throughput": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAgentAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	agentID := d.Get("agent_id").(string)
+	name := d.Get("agent_alias_name").(string)
+	input := &bedrockagent.CreateAgentAliasInput{
+		AgentAliasName: aws.String(name),
+		AgentId:        aws.String(agentID),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// Without a routing configuration a new agent version is created from the DRAFT version.
+	if v, ok := d.GetOk("routing_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.RoutingConfiguration = expandAgentAliasRoutingConfiguration(v.([]interface{}))
+	}
+
+	// The agent may still be preparing after a change made by another resource.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateAgentAliasWithContext(ctx, input)
+	}, bedrockagent.ErrCodeConflictException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Agent Alias (%s): %s", name, err)
+	}
+
+	aliasID := aws.StringValue(outputRaw.(*bedrockagent.CreateAgentAliasOutput).AgentAlias.AgentAliasId)
+	id, err := flex.FlattenResourceId([]string{aliasID, agentID}, agentAliasResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAgentAliasPrepared(ctx, conn, aliasID, agentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Agent Alias (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAgentAliasRead(ctx, d, meta)...)
+}
+
+func resourceAgentAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), agentAliasResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	aliasID, agentID := parts[0], parts[1]
+	alias, err := FindAgentAliasByTwoPartKey(ctx, conn, aliasID, agentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Agent Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("agent_alias_arn", alias.AgentAliasArn)
+	d.Set("agent_alias_id", alias.AgentAliasId)
+	d.Set("agent_alias_name", alias.AgentAliasName)
+	d.Set("agent_id", alias.AgentId)
+	d.Set("description", alias.Description)
+	if err := d.Set("routing_configuration", flattenAgentAliasRoutingConfiguration(alias.RoutingConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting routing_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAgentAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	if d.HasChanges("agent_alias_name", "description", "routing_configuration") {
+		parts, err := flex.ExpandResourceId(d.Id(), agentAliasResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		aliasID, agentID := parts[0], parts[1]
+		input := &bedrockagent.UpdateAgentAliasInput{
+			AgentAliasId:   aws.String(aliasID),
+			AgentAliasName: aws.String(d.Get("agent_alias_name").(string)),
+			AgentId:        aws.String(agentID),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		// Always send the routing configuration so that the alias keeps pointing at its current version.
+		if v, ok := d.GetOk("routing_configuration"); ok && len(v.([]interface{})) > 0 {
+			input.RoutingConfiguration = expandAgentAliasRoutingConfiguration(v.([]interface{}))
+		}
+
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateAgentAliasWithContext(ctx, input)
+		}, bedrockagent.ErrCodeConflictException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Agent Alias (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAgentAliasPrepared(ctx, conn, aliasID, agentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Agent Alias (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAgentAliasRead(ctx, d, meta)...)
+}
+
+func resourceAgentAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), agentAliasResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	aliasID, agentID := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting Bedrock Agent Alias: %s", d.Id())
+	_, err = conn.DeleteAgentAliasWithContext(ctx, &bedrockagent.DeleteAgentAliasInput{
+		AgentAliasId: aws.String(aliasID),
+		AgentId:      aws.String(agentID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Agent Alias (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAgentAliasDeleted(ctx, conn, aliasID, agentID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Agent Alias (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAgentAliasByTwoPartKey(ctx context.Context, conn *bedrockagent.BedrockAgent, aliasID, agentID string) (*bedrockagent.AgentAlias, error) {
+	input := &bedrockagent.GetAgentAliasInput{
+		AgentAliasId: aws.String(aliasID),
+		AgentId:      aws.String(agentID),
+	}
+
+	output, err := conn.GetAgentAliasWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentAlias == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentAlias, nil
+}
+
+func statusAgentAlias(ctx context.Context, conn *bedrockagent.BedrockAgent, aliasID, agentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAgentAliasByTwoPartKey(ctx, conn, aliasID, agentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AgentAliasStatus), nil
+	}
+}
+
+func waitAgentAliasPrepared(ctx context.Context, conn *bedrockagent.BedrockAgent, aliasID, agentID string, timeout time.Duration) (*bedrockagent.AgentAlias, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrockagent.AgentAliasStatusCreating, bedrockagent.AgentAliasStatusUpdating},
+		Target:  []string{bedrockagent.AgentAliasStatusPrepared},
+		Refresh: statusAgentAlias(ctx, conn, aliasID, agentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.AgentAlias); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentAliasDeleted(ctx context.Context, conn *bedrockagent.BedrockAgent, aliasID, agentID string, timeout time.Duration) (*bedrockagent.AgentAlias, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{bedrockagent.AgentAliasStatusDeleting},
+		Target:  []string{},
+		Refresh: statusAgentAlias(ctx, conn, aliasID, agentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.AgentAlias); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAgentAliasRoutingConfiguration(tfList []interface{}) []*bedrockagent.AgentAliasRoutingConfigurationListItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*bedrockagent.AgentAliasRoutingConfigurationListItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &bedrockagent.AgentAliasRoutingConfigurationListItem{}
+
+		if v, ok := tfMap["agent_version"].(string); ok && v != "" {
+			apiObject.AgentVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["provisioned_throughput"].(string); ok && v != "" {
+			apiObject.ProvisionedThroughput = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAgentAliasRoutingConfiguration(apiObjects []*bedrockagent.AgentAliasRoutingConfigurationListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"agent_version":          aws.StringValue(apiObject.AgentVersion),
+			"provisioned_throughput": aws.StringValue(apiObject.ProvisionedThroughput),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	agentKnowledgeBaseAssociationResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_bedrockagent_agent_knowledge_base_association", name="Agent Knowledge Base Association")
+func ResourceAgentKnowledgeBaseAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAgentKnowledgeBaseAssociationCreate,
+		ReadWithoutTimeout:   resourceAgentKnowledgeBaseAssociationRead,
+		UpdateWithoutTimeout: resourceAgentKnowledgeBaseAssociationUpdate,
+		DeleteWithoutTimeout: resourceAgentKnowledgeBaseAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgentKnowledgeBaseAssociationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"knowledge_base_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"knowledge_base_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      bedrockagent.KnowledgeBaseStateEnabled,
+				ValidateFunc: validation.StringInSlice(bedrockagent.KnowledgeBaseState_Values(), false),
+			},
+			"prepare_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAgentKnowledgeBaseAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	agentID := d.Get("agent_id").(string)
+	knowledgeBaseID := d.Get("knowledge_base_id").(string)
+	id, err := flex.FlattenResourceId([]string{agentID, agentVersionDraft, knowledgeBaseID}, agentKnowledgeBaseAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &bedrockagent.AssociateAgentKnowledgeBaseInput{
+		AgentId:            aws.String(agentID),
+		AgentVersion:       aws.String(agentVersionDraft),
+		Description:        aws.String(d.Get("description").(string)),
+		KnowledgeBaseId:    aws.String(knowledgeBaseID),
+		KnowledgeBaseState: aws.String(d.Get("knowledge_base_state").(string)),
+	}
+
+	// The agent may still be preparing after a change made by another resource.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.AssociateAgentKnowledgeBaseWithContext(ctx, input)
+	}, bedrockagent.ErrCodeConflictException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Agent Knowledge Base Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("prepare_agent").(bool) {
+		if _, err := prepareAgent(ctx, conn, agentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceAgentKnowledgeBaseAssociationRead(ctx, d, meta)...)
+}
+
+func resourceAgentKnowledgeBaseAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), agentKnowledgeBaseAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	agentID, agentVersion, knowledgeBaseID := parts[0], parts[1], parts[2]
+	output, err := FindAgentKnowledgeBaseByThreePartKey(ctx, conn, agentID, agentVersion, knowledgeBaseID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Knowledge Base Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Agent Knowledge Base Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("agent_id", output.AgentId)
+	d.Set("agent_version", output.AgentVersion)
+	d.Set("description", output.Description)
+	d.Set("knowledge_base_id", output.KnowledgeBaseId)
+	d.Set("knowledge_base_state", output.KnowledgeBaseState)
+
+	return diags
+}
+
+func resourceAgentKnowledgeBaseAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	if d.HasChanges("description", "knowledge_base_state") {
+		parts, err := flex.ExpandResourceId(d.Id(), agentKnowledgeBaseAssociationResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		agentID, agentVersion, knowledgeBaseID := parts[0], parts[1], parts[2]
+		input := &bedrockagent.UpdateAgentKnowledgeBaseInput{
+			AgentId:            aws.String(agentID),
+			AgentVersion:       aws.String(agentVersion),
+			Description:        aws.String(d.Get("description").(string)),
+			KnowledgeBaseId:    aws.String(knowledgeBaseID),
+			KnowledgeBaseState: aws.String(d.Get("knowledge_base_state").(string)),
+		}
+
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateAgentKnowledgeBaseWithContext(ctx, input)
+		}, bedrockagent.ErrCodeConflictException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Agent Knowledge Base Association (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("prepare_agent").(bool) {
+			if _, err := prepareAgent(ctx, conn, agentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceAgentKnowledgeBaseAssociationRead(ctx, d, meta)...)
+}
+
+func resourceAgentKnowledgeBaseAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockAgentConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), agentKnowledgeBaseAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	agentID, agentVersion, knowledgeBaseID := parts[0], parts[1], parts[2]
+
+	log.Printf("[INFO] Deleting Bedrock Agent Knowledge Base Association: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DisassociateAgentKnowledgeBaseWithContext(ctx, &bedrockagent.DisassociateAgentKnowledgeBaseInput{
+			AgentId:         aws.String(agentID),
+			AgentVersion:    aws.String(agentVersion),
+			KnowledgeBaseId: aws.String(knowledgeBaseID),
+		})
+	}, bedrockagent.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Agent Knowledge Base Association (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("prepare_agent").(bool) {
+		if _, err := prepareAgent(ctx, conn, agentID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAgentKnowledgeBaseAssociationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := flex.ExpandResourceId(d.Id(), agentKnowledgeBaseAssociationResourceIDPartCount, false); err != nil {
+		return nil, err
+	}
+
+	d.Set("prepare_agent", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindAgentKnowledgeBaseByThreePartKey(ctx context.Context, conn *bedrockagent.BedrockAgent, agentID, agentVersion, knowledgeBaseID string) (*bedrockagent.AgentKnowledgeBase, error) {
+	input := &bedrockagent.GetAgentKnowledgeBaseInput{
+		AgentId:         aws.String(agentID),
+		AgentVersion:    aws.String(agentVersion),
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+	}
+
+	output, err := conn.GetAgentKnowledgeBaseWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrockagent.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentKnowledgeBase == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentKnowledgeBase, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Knowledge bases cannot be created by this provider, so an existing one must be supplied.
+func TestAccBedrockAgentAgentKnowledgeBaseAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "BEDROCK_KNOWLEDGE_BASE_ID"
+	knowledgeBaseID := os.Getenv(key)
+	if knowledgeBaseID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v bedrockagent.AgentKnowledgeBase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_knowledge_base_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentKnowledgeBaseAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentKnowledgeBaseAssociationConfig_basic(rName, knowledgeBaseID, bedrockagent.KnowledgeBaseStateEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_id", knowledgeBaseID),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_state", bedrockagent.KnowledgeBaseStateEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgentKnowledgeBaseAssociationConfig_basic(rName, knowledgeBaseID, bedrockagent.KnowledgeBaseStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_state", bedrockagent.KnowledgeBaseStateDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentKnowledgeBaseAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_agent_knowledge_base_association" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfbedrockagent.FindAgentKnowledgeBaseByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Knowledge Base Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentKnowledgeBaseAssociationExists(ctx context.Context, n string, v *bedrockagent.AgentKnowledgeBase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn(ctx)
+
+		output, err := tfbedrockagent.FindAgentKnowledgeBaseByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAgentKnowledgeBaseAssociationConfig_basic(rName, knowledgeBaseID, state string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "Basic instructions for the agent to follow when answering."), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_knowledge_base_association" "test" {
+  agent_id             = aws_bedrockagent_agent.test.agent_id
+  description          = %[1]q
+  knowledge_base_id    = %[2]q
+  knowledge_base_state = %[3]q
+}
+`, rName, knowledgeBaseID, state))
+}
@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockAgentAgent_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.Agent
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_basic(rName, "Basic instructions for the agent to follow when answering."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "agent_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_resource_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "foundation_model", "anthropic.claude-v2"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "500"),
+					resource.TestCheckResourceAttr(resourceName, "instruction", "Basic instructions for the agent to follow when answering."),
+					resource.TestCheckResourceAttr(resourceName, "prepare_agent", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "prepared_at"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgentConfig_basic(rName, "Updated instructions for the agent to follow when answering."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instruction", "Updated instructions for the agent to follow when answering."),
+					resource.TestCheckResourceAttrSet(resourceName, "prepared_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgent_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.Agent
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_basic(rName, "Basic instructions for the agent to follow when answering."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceAgent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgent_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrockagent.Agent
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prepare_agent"},
+			},
+			{
+				Config: testAccAgentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAgentConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// Guardrails cannot be created by this provider, so an existing one must be supplied.
+func TestAccBedrockAgentAgent_guardrailConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "BEDROCK_GUARDRAIL_ARN"
+	guardrailARN := os.Getenv(key)
+	if guardrailARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v bedrockagent.Agent
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, bedrockagent.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrockagent.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_guardrailConfiguration(rName, guardrailARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_configuration.0.guardrail_version", "aws_bedrock_guardrail_version.test", "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgentConfig_basic(rName, "Basic instructions for the agent to follow when answering."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_agent" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindAgentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentExists(ctx context.Context, n string, v *bedrockagent.Agent) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentConn(ctx)
+
+		output, err := tfbedrockagent.FindAgentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAgentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Principal": {
+      "Service": "bedrock.amazonaws.com"
+    },
+    "Effect": "Allow",
+    "Condition": {
+      "StringEquals": {
+        "aws:SourceAccount": "${data.aws_caller_identity.current.account_id}"
+      }
+    }
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "bedrock:InvokeModel",
+    "Effect": "Allow",
+    "Resource": "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+  }]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAgentConfig_basic(rName, instruction string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name                  = %[1]q
+  agent_resource_role_arn     = aws_iam_role.test.arn
+  idle_session_ttl_in_seconds = 500
+  instruction                 = %[2]q
+  foundation_model            = "anthropic.claude-v2"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, instruction))
+}
+
+func testAccAgentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test.arn
+  foundation_model        = "anthropic.claude-v2"
+  prepare_agent           = false
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAgentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test.arn
+  foundation_model        = "anthropic.claude-v2"
+  prepare_agent           = false
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAgentConfig_guardrailConfiguration(rName, guardrailARN string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role_policy" "guardrail" {
+  name = "%[1]s-guardrail"
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "bedrock:ApplyGuardrail",
+    "Effect": "Allow",
+    "Resource": %[2]q
+  }]
+}
+EOF
+}
+
+resource "aws_bedrock_guardrail_version" "test" {
+  guardrail_arn = %[2]q
+}
+
+resource "aws_bedrockagent_agent" "test" {
+  agent_name                  = %[1]q
+  agent_resource_role_arn     = aws_iam_role.test.arn
+  idle_session_ttl_in_seconds = 500
+  instruction                 = "Basic instructions for the agent to follow when answering."
+  foundation_model            = "anthropic.claude-v2"
+
+  guardrail_configuration {
+    guardrail_identifier = %[2]q
+    guardrail_version    = aws_bedrock_guardrail_version.test.version
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy.guardrail]
+}
+`, rName, guardrailARN))
+}
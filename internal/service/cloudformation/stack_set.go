@@ -60,11 +60,11 @@ func ResourceStackSet() *schema.Resource {
 				MinItems: 1,
 				MaxItems: 1,
 				Optional: true,
-				ForceNew: true,
 				ConflictsWith: []string{
 					"administration_role_arn",
 					"execution_role_name",
 				},
+				DiffSuppressFunc: suppressDisabledAutoDeploymentDiff,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -134,6 +134,11 @@ func ResourceStackSet() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"concurrency_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(cloudformation.ConcurrencyMode_Values(), false),
+						},
 						"failure_tolerance_count": {
 							Type:          schema.TypeInt,
 							Optional:      true,
@@ -384,6 +389,11 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.AdministrationRoleARN = nil
 		input.ExecutionRoleName = nil
 		input.AutoDeployment = expandAutoDeployment(v.([]interface{}))
+	} else if d.HasChange("auto_deployment") {
+		// Removing `auto_deployment`, or reverting an out-of-band change, disables automatic deployment.
+		input.AutoDeployment = &cloudformation.AutoDeployment{
+			Enabled: aws.Bool(false),
+		}
 	}
 
 	output, err := conn.UpdateStackSetWithContext(ctx, input)
@@ -440,6 +450,23 @@ func resourceStackSetImport(ctx context.Context, d *schema.ResourceData, meta in
 	return []*schema.ResourceData{d}, nil
 }
 
+// suppressDisabledAutoDeploymentDiff treats disabled automatic deployment as equivalent to an absent `auto_deployment` block.
+func suppressDisabledAutoDeploymentDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("auto_deployment")
+
+	if len(n.([]interface{})) > 0 {
+		return false
+	}
+
+	for _, tfMapRaw := range o.([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok && tfMap["enabled"].(bool) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func expandAutoDeployment(l []interface{}) *cloudformation.AutoDeployment {
 	if len(l) == 0 {
 		return nil
@@ -413,6 +413,39 @@ func TestAccCloudFormationStackSet_operationPreferences(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSet_operationPreferencesConcurrencyMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackSet cloudformation.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetConfig_operationPreferencesConcurrencyMode(rName, "SOFT_FAILURE_TOLERANCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.concurrency_mode", "SOFT_FAILURE_TOLERANCE"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.region_concurrency_type", "PARALLEL"),
+				),
+			},
+			{
+				Config: testAccStackSetConfig_operationPreferencesConcurrencyMode(rName, "STRICT_FAILURE_TOLERANCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.concurrency_mode", "STRICT_FAILURE_TOLERANCE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSet_parameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackSet1, stackSet2 cloudformation.StackSet
@@ -825,6 +858,68 @@ func TestAccCloudFormationStackSet_autoDeploymentDisabled(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSet_autoDeploymentUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckStackSet(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetConfig_autoDeployment(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet1),
+					resource.TestCheckResourceAttr(resourceName, "auto_deployment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_deployment.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_deployment.0.retain_stacks_on_account_removal", "false"),
+				),
+			},
+			{
+				Config: testAccStackSetConfig_autoDeployment(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet2),
+					testAccCheckStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestCheckResourceAttr(resourceName, "auto_deployment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_deployment.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_deployment.0.retain_stacks_on_account_removal", "true"),
+				),
+			},
+			{
+				Config: testAccStackSetConfig_autoDeploymentNone(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet2),
+					testAccCheckStackSetNotRecreated(&stackSet1, &stackSet2),
+					testAccCheckStackSetAutoDeploymentEnabled(&stackSet2, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStackSetAutoDeploymentEnabled(stackSet *cloudformation.StackSet, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var enabled bool
+		if stackSet.AutoDeployment != nil {
+			enabled = aws.BoolValue(stackSet.AutoDeployment.Enabled)
+		}
+
+		if enabled != expected {
+			return fmt.Errorf("CloudFormation StackSet (%s) auto deployment enabled: %t, expected: %t", aws.StringValue(stackSet.StackSetName), enabled, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckStackSetExists(ctx context.Context, resourceName string, v *cloudformation.StackSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -1418,3 +1513,36 @@ TEMPLATE
 }
 `, rName, testAccStackSetTemplateBodyVPC(rName), enabled, retainStacksOnAccountRemoval)
 }
+
+func testAccStackSetConfig_operationPreferencesConcurrencyMode(rName, concurrencyMode string) string {
+	return acctest.ConfigCompose(testAccStackSetConfig_baseAdministrationRoleARNs(rName, 1), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = aws_iam_role.test[0].arn
+  name                    = %[1]q
+
+  operation_preferences {
+    concurrency_mode        = %[2]q
+    failure_tolerance_count = 1
+    max_concurrent_count    = 10
+    region_concurrency_type = "PARALLEL"
+  }
+
+  template_body = <<TEMPLATE
+%[3]s
+TEMPLATE
+}
+`, rName, concurrencyMode, testAccStackSetTemplateBodyVPC(rName)))
+}
+
+func testAccStackSetConfig_autoDeploymentNone(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name             = %[1]q
+  permission_model = "SERVICE_MANAGED"
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccStackSetTemplateBodyVPC(rName))
+}
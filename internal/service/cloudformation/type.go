@@ -323,6 +323,9 @@ func expandOperationPreferences(tfMap map[string]interface{}) *cloudformation.St
 
 	apiObject := &cloudformation.StackSetOperationPreferences{}
 
+	if v, ok := tfMap["concurrency_mode"].(string); ok && v != "" {
+		apiObject.ConcurrencyMode = aws.String(v)
+	}
 	if v, ok := tfMap["failure_tolerance_count"].(int); ok {
 		apiObject.FailureToleranceCount = aws.Int64(int64(v))
 	}
@@ -338,8 +341,8 @@ func expandOperationPreferences(tfMap map[string]interface{}) *cloudformation.St
 	if v, ok := tfMap["region_concurrency_type"].(string); ok && v != "" {
 		apiObject.RegionConcurrencyType = aws.String(v)
 	}
-	if v, ok := tfMap["region_order"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.RegionOrder = flex.ExpandStringSet(v)
+	if v, ok := tfMap["region_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RegionOrder = flex.ExpandStringList(v)
 	}
 
 	if ftc, ftp := aws.Int64Value(apiObject.FailureToleranceCount), aws.Int64Value(apiObject.FailureTolerancePercentage); ftp == 0 {
@@ -12,6 +12,7 @@ const (
 	ResNameResourceServer    = "Resource Server"
 	ResNameRiskConfiguration = "Risk Configuration"
 	ResNameUserGroup         = "User Group"
+	ResNameUserImportJob     = "User Import Job"
 	ResNameUserPoolClient    = "User Pool Client"
 	ResNameUserPoolDomain    = "User Pool Domain"
 	ResNameUserPool          = "User Pool"
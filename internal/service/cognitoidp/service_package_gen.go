@@ -73,6 +73,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserGroup,
 			TypeName: "aws_cognito_user_group",
 		},
+		{
+			Factory:  ResourceUserImportJob,
+			TypeName: "aws_cognito_user_import_job",
+		},
 		{
 			Factory:  ResourceUserInGroup,
 			TypeName: "aws_cognito_user_in_group",
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_cognito_user_import_job")
+func ResourceUserImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserImportJobCreate,
+		ReadWithoutTimeout:   resourceUserImportJobRead,
+		DeleteWithoutTimeout: resourceUserImportJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImportJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudwatch_logs_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failed_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"imported_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"skipped_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserImportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn(ctx)
+
+	source := d.Get("source").(string)
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
+	}
+
+	name := d.Get("job_name").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+	input := &cognitoidentityprovider.CreateUserImportJobInput{
+		CloudWatchLogsRoleArn: aws.String(d.Get("cloudwatch_logs_role_arn").(string)),
+		JobName:               aws.String(name),
+		UserPoolId:            aws.String(userPoolID),
+	}
+
+	output, err := conn.CreateUserImportJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Cognito User Import Job (%s): %s", name, err)
+	}
+
+	jobID := aws.StringValue(output.UserImportJob.JobId)
+	d.SetId(userImportJobCreateResourceID(userPoolID, jobID))
+
+	if err := uploadUserImportJobCSV(ctx, aws.StringValue(output.UserImportJob.PreSignedUrl), path); err != nil {
+		return sdkdiag.AppendErrorf(diags, "uploading Cognito User Import Job (%s) CSV file (%s): %s", d.Id(), path, err)
+	}
+
+	_, err = conn.StartUserImportJobWithContext(ctx, &cognitoidentityprovider.StartUserImportJobInput{
+		JobId:      aws.String(jobID),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Cognito User Import Job (%s): %s", d.Id(), err)
+	}
+
+	job, err := waitUserImportJobCompleted(ctx, conn, userPoolID, jobID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Cognito User Import Job (%s) complete: %s", d.Id(), err)
+	}
+
+	if n := aws.Int64Value(job.FailedUsers); n > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Cognito User Import Job (%s) failed to import %d user(s)", d.Id(), n),
+			Detail:   fmt.Sprintf("%s\n\nThe failed rows are logged to the CloudWatch Logs log group /aws/cognito/userpools/%s/%s.", aws.StringValue(job.CompletionMessage), userPoolID, name),
+		})
+	}
+
+	return append(diags, resourceUserImportJobRead(ctx, d, meta)...)
+}
+
+func resourceUserImportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn(ctx)
+
+	userPoolID, jobID, err := userImportJobParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	job, err := FindUserImportJobByTwoPartKey(ctx, conn, userPoolID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CognitoIDP, create.ErrActionReading, ResNameUserImportJob, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito User Import Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cloudwatch_logs_role_arn", job.CloudWatchLogsRoleArn)
+	if job.CompletionDate != nil {
+		d.Set("completion_date", aws.TimeValue(job.CompletionDate).Format(time.RFC3339))
+	} else {
+		d.Set("completion_date", nil)
+	}
+	d.Set("completion_message", job.CompletionMessage)
+	if job.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(job.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("failed_users", job.FailedUsers)
+	d.Set("imported_users", job.ImportedUsers)
+	d.Set("job_id", job.JobId)
+	d.Set("job_name", job.JobName)
+	d.Set("skipped_users", job.SkippedUsers)
+	if job.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(job.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("status", job.Status)
+	d.Set("user_pool_id", job.UserPoolId)
+
+	return diags
+}
+
+func resourceUserImportJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn(ctx)
+
+	userPoolID, jobID, err := userImportJobParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Import jobs cannot be deleted. Stop the job if it is still running, otherwise just remove it from state.
+	switch status := d.Get("status").(string); status {
+	case cognitoidentityprovider.UserImportJobStatusTypeCreated, cognitoidentityprovider.UserImportJobStatusTypePending, cognitoidentityprovider.UserImportJobStatusTypeInProgress:
+	default:
+		log.Printf("[DEBUG] Cognito User Import Job (%s) has status %s, removing from state", d.Id(), status)
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping Cognito User Import Job: %s", d.Id())
+	_, err = conn.StopUserImportJobWithContext(ctx, &cognitoidentityprovider.StopUserImportJobInput{
+		JobId:      aws.String(jobID),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, cognitoidentityprovider.ErrCodeUnsupportedOperationException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping Cognito User Import Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceUserImportJobImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	userPoolID, jobID, err := userImportJobParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("job_id", jobID)
+	d.Set("user_pool_id", userPoolID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const userImportJobResourceIDSeparator = "/"
+
+func userImportJobCreateResourceID(userPoolID, jobID string) string {
+	parts := []string{userPoolID, jobID}
+	id := strings.Join(parts, userImportJobResourceIDSeparator)
+
+	return id
+}
+
+func userImportJobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userImportJobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USERPOOLID%[2]sJOBID", id, userImportJobResourceIDSeparator)
+}
+
+// uploadUserImportJobCSV uploads the CSV file at path to the job's pre-signed S3 URL.
+func uploadUserImportJobCSV(ctx context.Context, url, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, url, file)
+	if err != nil {
+		return err
+	}
+
+	request.ContentLength = info.Size()
+	request.Header.Set("Content-Type", "text/csv")
+	request.Header.Set("x-amz-server-side-encryption", "aws:kms")
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("HTTP status %d: %s", response.StatusCode, body)
+	}
+
+	return nil
+}
+
+func FindUserImportJobByTwoPartKey(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, jobID string) (*cognitoidentityprovider.UserImportJobType, error) {
+	input := &cognitoidentityprovider.DescribeUserImportJobInput{
+		JobId:      aws.String(jobID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.DescribeUserImportJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserImportJob == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserImportJob, nil
+}
+
+func statusUserImportJob(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindUserImportJobByTwoPartKey(ctx, conn, userPoolID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitUserImportJobCompleted(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, jobID string, timeout time.Duration) (*cognitoidentityprovider.UserImportJobType, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{cognitoidentityprovider.UserImportJobStatusTypeCreated, cognitoidentityprovider.UserImportJobStatusTypePending, cognitoidentityprovider.UserImportJobStatusTypeInProgress},
+		Target:  []string{cognitoidentityprovider.UserImportJobStatusTypeSucceeded},
+		Refresh: statusUserImportJob(ctx, conn, userPoolID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cognitoidentityprovider.UserImportJobType); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s (failed users: %d)", aws.StringValue(output.CompletionMessage), aws.Int64Value(output.FailedUsers)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_deadline_farm", name="Farm")
+// @Tags(identifierAttribute="arn")
+func ResourceFarm() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFarmCreate,
+		ReadWithoutTimeout:   resourceFarmRead,
+		UpdateWithoutTimeout: resourceFarmUpdate,
+		DeleteWithoutTimeout: resourceFarmDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceFarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	input := &deadline.CreateFarmInput{
+		DisplayName: aws.String(d.Get("display_name").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFarmWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Farm: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FarmId))
+
+	return append(diags, resourceFarmRead(ctx, d, meta)...)
+}
+
+func resourceFarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farm, err := FindFarmByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Farm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Farm (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", farmARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("description", farm.Description)
+	d.Set("display_name", farm.DisplayName)
+	d.Set("farm_id", farm.FarmId)
+	d.Set("kms_key_arn", farm.KmsKeyArn)
+
+	return diags
+}
+
+func resourceFarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	if d.HasChanges("description", "display_name") {
+		input := &deadline.UpdateFarmInput{
+			Description: aws.String(d.Get("description").(string)),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			FarmId:      aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateFarmWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Farm (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFarmRead(ctx, d, meta)...)
+}
+
+func resourceFarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Farm: %s", d.Id())
+	_, err := conn.DeleteFarmWithContext(ctx, &deadline.DeleteFarmInput{
+		FarmId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Farm (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFarmByID(ctx context.Context, conn *deadline.Deadline, id string) (*deadline.GetFarmOutput, error) {
+	input := &deadline.GetFarmInput{
+		FarmId: aws.String(id),
+	}
+
+	output, err := conn.GetFarmWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// The Get* APIs don't return resource ARNs, so they are built from the IDs.
+func farmARN(c *conns.AWSClient, farmID string) string {
+	return deadlineARN(c, fmt.Sprintf("farm/%s", farmID))
+}
+
+func deadlineARN(c *conns.AWSClient, resource string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   deadline.ServiceName,
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  resource,
+	}.String()
+}
@@ -0,0 +1,934 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	fleetResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_deadline_fleet", name="Fleet")
+// @Tags(identifierAttribute="arn")
+func ResourceFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetCreate,
+		ReadWithoutTimeout:   resourceFleetRead,
+		UpdateWithoutTimeout: resourceFleetUpdate,
+		DeleteWithoutTimeout: resourceFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.customer_managed", "configuration.0.service_managed_ec2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(deadline.AutoScalingMode_Values(), false),
+									},
+									"storage_profile_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"worker_capabilities": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"accelerator_count":            rangeSchema(0),
+												"accelerator_total_memory_mib": rangeSchema(0),
+												"accelerator_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(deadline.AcceleratorType_Values(), false),
+													},
+												},
+												"cpu_architecture_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CpuArchitectureType_Values(), false),
+												},
+												"custom_amount":    customAmountSchema(),
+												"custom_attribute": customAttributeSchema(),
+												"memory_mib":       requiredRangeSchema(512),
+												"os_family": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CustomerManagedFleetOperatingSystemFamily_Values(), false),
+												},
+												"vcpu_count": requiredRangeSchema(1),
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_managed_ec2": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.customer_managed", "configuration.0.service_managed_ec2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_capabilities": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allowed_instance_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"cpu_architecture_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CpuArchitectureType_Values(), false),
+												},
+												"custom_amount":    customAmountSchema(),
+												"custom_attribute": customAttributeSchema(),
+												"excluded_instance_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"memory_mib": requiredRangeSchema(512),
+												"os_family": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.ServiceManagedFleetOperatingSystemFamily_Values(), false),
+												},
+												"root_ebs_volume": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"iops": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(3000, 16000),
+															},
+															"size_gib": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"throughput_mib": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(125, 1000),
+															},
+														},
+													},
+												},
+												"vcpu_count": requiredRangeSchema(1),
+											},
+										},
+									},
+									"instance_market_options": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.Ec2MarketType_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_worker_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func rangeSchema(minValue int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     rangeResource(minValue),
+	}
+}
+
+func requiredRangeSchema(minValue int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem:     rangeResource(minValue),
+	}
+}
+
+func rangeResource(minValue int) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(minValue),
+			},
+			"min": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(minValue),
+			},
+		},
+	}
+}
+
+func customAmountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+				"min": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func customAttributeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID := d.Get("farm_id").(string)
+	input := &deadline.CreateFleetInput{
+		DisplayName:    aws.String(d.Get("display_name").(string)),
+		FarmId:         aws.String(farmID),
+		MaxWorkerCount: aws.Int64(int64(d.Get("max_worker_count").(int))),
+		MinWorkerCount: aws.Int64(int64(d.Get("min_worker_count").(int))),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandFleetConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFleetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Fleet: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{farmID, aws.StringValue(output.FleetId)}, fleetResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitFleetCreated(ctx, conn, farmID, aws.StringValue(output.FleetId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFleetRead(ctx, d, meta)...)
+}
+
+func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), fleetResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	farmID, fleetID := parts[0], parts[1]
+	fleet, err := FindFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", fleetARN(meta.(*conns.AWSClient), farmID, fleetID))
+	if fleet.Configuration != nil {
+		if err := d.Set("configuration", []interface{}{flattenFleetConfiguration(fleet.Configuration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+		}
+	} else {
+		d.Set("configuration", nil)
+	}
+	d.Set("description", fleet.Description)
+	d.Set("display_name", fleet.DisplayName)
+	d.Set("farm_id", fleet.FarmId)
+	d.Set("fleet_id", fleet.FleetId)
+	d.Set("max_worker_count", fleet.MaxWorkerCount)
+	d.Set("min_worker_count", fleet.MinWorkerCount)
+	d.Set("role_arn", fleet.RoleArn)
+	d.Set("status", fleet.Status)
+
+	return diags
+}
+
+func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), fleetResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	farmID, fleetID := parts[0], parts[1]
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &deadline.UpdateFleetInput{
+			FarmId:  aws.String(farmID),
+			FleetId: aws.String(fleetID),
+		}
+
+		if d.HasChange("configuration") {
+			if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Configuration = expandFleetConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChanges("max_worker_count", "min_worker_count") {
+			input.MaxWorkerCount = aws.Int64(int64(d.Get("max_worker_count").(int)))
+			input.MinWorkerCount = aws.Int64(int64(d.Get("min_worker_count").(int)))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		_, err := conn.UpdateFleetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Fleet (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFleetUpdated(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Fleet (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFleetRead(ctx, d, meta)...)
+}
+
+func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), fleetResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	farmID, fleetID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Fleet: %s", d.Id())
+	_, err = conn.DeleteFleetWithContext(ctx, &deadline.DeleteFleetInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetDeleted(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Deadline Cloud Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFleetByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string) (*deadline.GetFleetOutput, error) {
+	input := &deadline.GetFleetInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+	}
+
+	output, err := conn.GetFleetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFleet(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitFleetCreated(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{deadline.FleetStatusCreateInProgress},
+		Target:  []string{deadline.FleetStatusActive},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetUpdated(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{deadline.FleetStatusUpdateInProgress},
+		Target:  []string{deadline.FleetStatusActive},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeleted(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: deadline.FleetStatus_Values(),
+		Target:  []string{},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func fleetARN(c *conns.AWSClient, farmID, fleetID string) string {
+	return deadlineARN(c, fmt.Sprintf("farm/%s/fleet/%s", farmID, fleetID))
+}
+
+func expandFleetConfiguration(tfMap map[string]interface{}) *deadline.FleetConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &deadline.FleetConfiguration{}
+
+	if v, ok := tfMap["customer_managed"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomerManaged = expandCustomerManagedFleetConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["service_managed_ec2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ServiceManagedEc2 = expandServiceManagedEc2FleetConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCustomerManagedFleetConfiguration(tfMap map[string]interface{}) *deadline.CustomerManagedFleetConfiguration {
+	apiObject := &deadline.CustomerManagedFleetConfiguration{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_profile_id"].(string); ok && v != "" {
+		apiObject.StorageProfileId = aws.String(v)
+	}
+
+	if v, ok := tfMap["worker_capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WorkerCapabilities = expandCustomerManagedWorkerCapabilities(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCustomerManagedWorkerCapabilities(tfMap map[string]interface{}) *deadline.CustomerManagedWorkerCapabilities {
+	apiObject := &deadline.CustomerManagedWorkerCapabilities{}
+
+	if minValue, maxValue, ok := expandRange(tfMap["accelerator_count"]); ok {
+		apiObject.AcceleratorCount = &deadline.AcceleratorCountRange{Min: minValue, Max: maxValue}
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["accelerator_total_memory_mib"]); ok {
+		apiObject.AcceleratorTotalMemoryMiB = &deadline.AcceleratorTotalMemoryMiBRange{Min: minValue, Max: maxValue}
+	}
+
+	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["cpu_architecture_type"].(string); ok && v != "" {
+		apiObject.CpuArchitectureType = aws.String(v)
+	}
+
+	if v, ok := tfMap["custom_amount"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CustomAmounts = expandFleetAmountCapabilities(v)
+	}
+
+	if v, ok := tfMap["custom_attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CustomAttributes = expandFleetAttributeCapabilities(v)
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["memory_mib"]); ok {
+		apiObject.MemoryMiB = &deadline.MemoryMiBRange{Min: minValue, Max: maxValue}
+	}
+
+	if v, ok := tfMap["os_family"].(string); ok && v != "" {
+		apiObject.OsFamily = aws.String(v)
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["vcpu_count"]); ok {
+		apiObject.VCpuCount = &deadline.VCpuCountRange{Min: minValue, Max: maxValue}
+	}
+
+	return apiObject
+}
+
+func expandServiceManagedEc2FleetConfiguration(tfMap map[string]interface{}) *deadline.ServiceManagedEc2FleetConfiguration {
+	apiObject := &deadline.ServiceManagedEc2FleetConfiguration{}
+
+	if v, ok := tfMap["instance_capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InstanceCapabilities = expandServiceManagedEc2InstanceCapabilities(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["instance_market_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InstanceMarketOptions = &deadline.ServiceManagedEc2InstanceMarketOptions{
+			Type: aws.String(v[0].(map[string]interface{})["type"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandServiceManagedEc2InstanceCapabilities(tfMap map[string]interface{}) *deadline.ServiceManagedEc2InstanceCapabilities {
+	apiObject := &deadline.ServiceManagedEc2InstanceCapabilities{}
+
+	if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["cpu_architecture_type"].(string); ok && v != "" {
+		apiObject.CpuArchitectureType = aws.String(v)
+	}
+
+	if v, ok := tfMap["custom_amount"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CustomAmounts = expandFleetAmountCapabilities(v)
+	}
+
+	if v, ok := tfMap["custom_attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CustomAttributes = expandFleetAttributeCapabilities(v)
+	}
+
+	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["memory_mib"]); ok {
+		apiObject.MemoryMiB = &deadline.MemoryMiBRange{Min: minValue, Max: maxValue}
+	}
+
+	if v, ok := tfMap["os_family"].(string); ok && v != "" {
+		apiObject.OsFamily = aws.String(v)
+	}
+
+	if v, ok := tfMap["root_ebs_volume"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		volume := &deadline.Ec2EbsVolume{}
+
+		if v, ok := tfMap["iops"].(int); ok && v != 0 {
+			volume.Iops = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["size_gib"].(int); ok && v != 0 {
+			volume.SizeGiB = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["throughput_mib"].(int); ok && v != 0 {
+			volume.ThroughputMiB = aws.Int64(int64(v))
+		}
+
+		apiObject.RootEbsVolume = volume
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["vcpu_count"]); ok {
+		apiObject.VCpuCount = &deadline.VCpuCountRange{Min: minValue, Max: maxValue}
+	}
+
+	return apiObject
+}
+
+// expandRange returns the min and max of a range block. max is nil when unset.
+func expandRange(v interface{}) (*int64, *int64, bool) {
+	tfList, ok := v.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil, false
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	minValue := aws.Int64(int64(tfMap["min"].(int)))
+
+	var maxValue *int64
+	if v, ok := tfMap["max"].(int); ok && v != 0 {
+		maxValue = aws.Int64(int64(v))
+	}
+
+	return minValue, maxValue, true
+}
+
+func expandFleetAmountCapabilities(tfList []interface{}) []*deadline.FleetAmountCapability {
+	var apiObjects []*deadline.FleetAmountCapability
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &deadline.FleetAmountCapability{
+			Min:  aws.Float64(tfMap["min"].(float64)),
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["max"].(float64); ok && v != 0 {
+			apiObject.Max = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFleetAttributeCapabilities(tfList []interface{}) []*deadline.FleetAttributeCapability {
+	var apiObjects []*deadline.FleetAttributeCapability
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &deadline.FleetAttributeCapability{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFleetConfiguration(apiObject *deadline.FleetConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManaged; v != nil {
+		m := map[string]interface{}{
+			"mode":               aws.StringValue(v.Mode),
+			"storage_profile_id": aws.StringValue(v.StorageProfileId),
+		}
+
+		if v := v.WorkerCapabilities; v != nil {
+			c := map[string]interface{}{
+				"accelerator_types":     aws.StringValueSlice(v.AcceleratorTypes),
+				"cpu_architecture_type": aws.StringValue(v.CpuArchitectureType),
+				"custom_amount":         flattenFleetAmountCapabilities(v.CustomAmounts),
+				"custom_attribute":      flattenFleetAttributeCapabilities(v.CustomAttributes),
+				"os_family":             aws.StringValue(v.OsFamily),
+			}
+
+			if v := v.AcceleratorCount; v != nil {
+				c["accelerator_count"] = flattenRange(v.Min, v.Max)
+			}
+
+			if v := v.AcceleratorTotalMemoryMiB; v != nil {
+				c["accelerator_total_memory_mib"] = flattenRange(v.Min, v.Max)
+			}
+
+			if v := v.MemoryMiB; v != nil {
+				c["memory_mib"] = flattenRange(v.Min, v.Max)
+			}
+
+			if v := v.VCpuCount; v != nil {
+				c["vcpu_count"] = flattenRange(v.Min, v.Max)
+			}
+
+			m["worker_capabilities"] = []interface{}{c}
+		}
+
+		tfMap["customer_managed"] = []interface{}{m}
+	}
+
+	if v := apiObject.ServiceManagedEc2; v != nil {
+		m := map[string]interface{}{}
+
+		if v := v.InstanceCapabilities; v != nil {
+			c := map[string]interface{}{
+				"allowed_instance_types":  aws.StringValueSlice(v.AllowedInstanceTypes),
+				"cpu_architecture_type":   aws.StringValue(v.CpuArchitectureType),
+				"custom_amount":           flattenFleetAmountCapabilities(v.CustomAmounts),
+				"custom_attribute":        flattenFleetAttributeCapabilities(v.CustomAttributes),
+				"excluded_instance_types": aws.StringValueSlice(v.ExcludedInstanceTypes),
+				"os_family":               aws.StringValue(v.OsFamily),
+			}
+
+			if v := v.MemoryMiB; v != nil {
+				c["memory_mib"] = flattenRange(v.Min, v.Max)
+			}
+
+			if v := v.RootEbsVolume; v != nil {
+				c["root_ebs_volume"] = []interface{}{map[string]interface{}{
+					"iops":           aws.Int64Value(v.Iops),
+					"size_gib":       aws.Int64Value(v.SizeGiB),
+					"throughput_mib": aws.Int64Value(v.ThroughputMiB),
+				}}
+			}
+
+			if v := v.VCpuCount; v != nil {
+				c["vcpu_count"] = flattenRange(v.Min, v.Max)
+			}
+
+			m["instance_capabilities"] = []interface{}{c}
+		}
+
+		if v := v.InstanceMarketOptions; v != nil {
+			m["instance_market_options"] = []interface{}{map[string]interface{}{
+				"type": aws.StringValue(v.Type),
+			}}
+		}
+
+		tfMap["service_managed_ec2"] = []interface{}{m}
+	}
+
+	return tfMap
+}
+
+func flattenRange(minValue, maxValue *int64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"max": aws.Int64Value(maxValue),
+		"min": aws.Int64Value(minValue),
+	}}
+}
+
+func flattenFleetAmountCapabilities(apiObjects []*deadline.FleetAmountCapability) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"max":  aws.Float64Value(apiObject.Max),
+			"min":  aws.Float64Value(apiObject.Min),
+			"name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenFleetAttributeCapabilities(apiObjects []*deadline.FleetAttributeCapability) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
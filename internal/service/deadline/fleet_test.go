@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDeadlineFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetFleetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "deadline", regexache.MustCompile(`farm/.+/fleet/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.mode", "NO_SCALING"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.cpu_architecture_type", "x86_64"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.memory_mib.0.min", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.os_family", "LINUX"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.vcpu_count.0.min", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.service_managed_ec2.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_id"),
+					resource.TestCheckResourceAttr(resourceName, "max_worker_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_worker_count", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_worker_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetFleetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFleet_serviceManagedEC2(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetFleetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_serviceManagedEC2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.service_managed_ec2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.service_managed_ec2.0.instance_capabilities.0.cpu_architecture_type", "x86_64"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.service_managed_ec2.0.instance_capabilities.0.os_family", "LINUX"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.service_managed_ec2.0.instance_market_options.0.type", "spot"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFleetExists(ctx context.Context, n string, v *deadline.GetFleetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		output, err := tfdeadline.FindFleetByTwoPartKey(ctx, conn, rs.Primary.Attributes["farm_id"], rs.Primary.Attributes["fleet_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_fleet" {
+				continue
+			}
+
+			_, err := tfdeadline.FindFleetByTwoPartKey(ctx, conn, rs.Primary.Attributes["farm_id"], rs.Primary.Attributes["fleet_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Deadline Cloud Fleet %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFleetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "credentials.deadline.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccFleetConfig_basic(rName string, maxWorkerCount int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_fleet" "test" {
+  farm_id          = aws_deadline_farm.test.id
+  display_name     = %[1]q
+  role_arn         = aws_iam_role.test.arn
+  max_worker_count = %[2]d
+
+  configuration {
+    customer_managed {
+      mode = "NO_SCALING"
+
+      worker_capabilities {
+        cpu_architecture_type = "x86_64"
+        os_family             = "LINUX"
+
+        memory_mib {
+          min = 1024
+        }
+
+        vcpu_count {
+          min = 1
+        }
+      }
+    }
+  }
+}
+`, rName, maxWorkerCount))
+}
+
+func testAccFleetConfig_serviceManagedEC2(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_fleet" "test" {
+  farm_id          = aws_deadline_farm.test.id
+  display_name     = %[1]q
+  role_arn         = aws_iam_role.test.arn
+  max_worker_count = 1
+
+  configuration {
+    service_managed_ec2 {
+      instance_capabilities {
+        cpu_architecture_type = "x86_64"
+        os_family             = "LINUX"
+
+        memory_mib {
+          min = 2048
+          max = 8192
+        }
+
+        vcpu_count {
+          min = 2
+          max = 4
+        }
+      }
+
+      instance_market_options {
+        type = "spot"
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	queueResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_deadline_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+func ResourceQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueCreate,
+		ReadWithoutTimeout:   resourceQueueRead,
+		UpdateWithoutTimeout: resourceQueueUpdate,
+		DeleteWithoutTimeout: resourceQueueDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allowed_storage_profile_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_budget_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(deadline.DefaultQueueBudgetAction_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_attachment_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"root_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 63),
+						},
+						"s3_bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+					},
+				},
+			},
+			"job_run_as_user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"posix": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"run_as": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(deadline.RunAs_Values(), false),
+						},
+						"windows": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"required_file_system_location_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	farmID := d.Get("farm_id").(string)
+	input := &deadline.CreateQueueInput{
+		DisplayName: aws.String(d.Get("display_name").(string)),
+		FarmId:      aws.String(farmID),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("allowed_storage_profile_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.AllowedStorageProfileIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("default_budget_action"); ok {
+		input.DefaultBudgetAction = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_attachment_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobAttachmentSettings = expandJobAttachmentSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("job_run_as_user"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobRunAsUser = expandJobRunAsUser(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("required_file_system_location_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.RequiredFileSystemLocationNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateQueueWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Deadline Cloud Queue: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{farmID, aws.StringValue(output.QueueId)}, queueResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceQueueRead(ctx, d, meta)...)
+}
+
+func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), queueResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	farmID, queueID := parts[0], parts[1]
+	queue, err := FindQueueByTwoPartKey(ctx, conn, farmID, queueID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Deadline Cloud Queue (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allowed_storage_profile_ids", aws.StringValueSlice(queue.AllowedStorageProfileIds))
+	d.Set("arn", queueARN(meta.(*conns.AWSClient), farmID, queueID))
+	d.Set("default_budget_action", queue.DefaultBudgetAction)
+	d.Set("description", queue.Description)
+	d.Set("display_name", queue.DisplayName)
+	d.Set("farm_id", queue.FarmId)
+	if queue.JobAttachmentSettings != nil {
+		if err := d.Set("job_attachment_settings", []interface{}{flattenJobAttachmentSettings(queue.JobAttachmentSettings)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting job_attachment_settings: %s", err)
+		}
+	} else {
+		d.Set("job_attachment_settings", nil)
+	}
+	if queue.JobRunAsUser != nil {
+		if err := d.Set("job_run_as_user", []interface{}{flattenJobRunAsUser(queue.JobRunAsUser)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting job_run_as_user: %s", err)
+		}
+	} else {
+		d.Set("job_run_as_user", nil)
+	}
+	d.Set("queue_id", queue.QueueId)
+	d.Set("required_file_system_location_names", aws.StringValueSlice(queue.RequiredFileSystemLocationNames))
+	d.Set("role_arn", queue.RoleArn)
+	d.Set("status", queue.Status)
+
+	return diags
+}
+
+func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), queueResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &deadline.UpdateQueueInput{
+			FarmId:  aws.String(parts[0]),
+			QueueId: aws.String(parts[1]),
+		}
+
+		if d.HasChange("allowed_storage_profile_ids") {
+			o, n := d.GetChange("allowed_storage_profile_ids")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AllowedStorageProfileIdsToAdd = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.AllowedStorageProfileIdsToRemove = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("default_budget_action") {
+			input.DefaultBudgetAction = aws.String(d.Get("default_budget_action").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("job_attachment_settings") {
+			if v, ok := d.GetOk("job_attachment_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.JobAttachmentSettings = expandJobAttachmentSettings(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("job_run_as_user") {
+			if v, ok := d.GetOk("job_run_as_user"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.JobRunAsUser = expandJobRunAsUser(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("required_file_system_location_names") {
+			o, n := d.GetChange("required_file_system_location_names")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.RequiredFileSystemLocationNamesToAdd = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.RequiredFileSystemLocationNamesToRemove = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		_, err = conn.UpdateQueueWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Deadline Cloud Queue (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceQueueRead(ctx, d, meta)...)
+}
+
+func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeadlineConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), queueResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Deadline Cloud Queue: %s", d.Id())
+	_, err = conn.DeleteQueueWithContext(ctx, &deadline.DeleteQueueInput{
+		FarmId:  aws.String(parts[0]),
+		QueueId: aws.String(parts[1]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Deadline Cloud Queue (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindQueueByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, queueID string) (*deadline.GetQueueOutput, error) {
+	input := &deadline.GetQueueInput{
+		FarmId:  aws.String(farmID),
+		QueueId: aws.String(queueID),
+	}
+
+	output, err := conn.GetQueueWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func queueARN(c *conns.AWSClient, farmID, queueID string) string {
+	return deadlineARN(c, fmt.Sprintf("farm/%s/queue/%s", farmID, queueID))
+}
+
+func expandJobAttachmentSettings(tfMap map[string]interface{}) *deadline.JobAttachmentSettings {
+	return &deadline.JobAttachmentSettings{
+		RootPrefix:   aws.String(tfMap["root_prefix"].(string)),
+		S3BucketName: aws.String(tfMap["s3_bucket_name"].(string)),
+	}
+}
+
+func expandJobRunAsUser(tfMap map[string]interface{}) *deadline.JobRunAsUser {
+	apiObject := &deadline.JobRunAsUser{
+		RunAs: aws.String(tfMap["run_as"].(string)),
+	}
+
+	if v, ok := tfMap["posix"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Posix = &deadline.PosixUser{
+			Group: aws.String(tfMap["group"].(string)),
+			User:  aws.String(tfMap["user"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["windows"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Windows = &deadline.WindowsUser{
+			PasswordArn: aws.String(tfMap["password_arn"].(string)),
+			User:        aws.String(tfMap["user"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenJobAttachmentSettings(apiObject *deadline.JobAttachmentSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"root_prefix":    aws.StringValue(apiObject.RootPrefix),
+		"s3_bucket_name": aws.StringValue(apiObject.S3BucketName),
+	}
+}
+
+func flattenJobRunAsUser(apiObject *deadline.JobRunAsUser) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"run_as": aws.StringValue(apiObject.RunAs),
+	}
+
+	if v := apiObject.Posix; v != nil {
+		tfMap["posix"] = []interface{}{map[string]interface{}{
+			"group": aws.StringValue(v.Group),
+			"user":  aws.StringValue(v.User),
+		}}
+	}
+
+	if v := apiObject.Windows; v != nil {
+		tfMap["windows"] = []interface{}{map[string]interface{}{
+			"password_arn": aws.StringValue(v.PasswordArn),
+			"user":         aws.StringValue(v.User),
+		}}
+	}
+
+	return tfMap
+}
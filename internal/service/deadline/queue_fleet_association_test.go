@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDeadlineQueueFleetAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetQueueFleetAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue_fleet_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueFleetAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueFleetAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_deadline_fleet.test", "fleet_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_id", "aws_deadline_queue.test", "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueueFleetAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetQueueFleetAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue_fleet_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueFleetAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueFleetAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceQueueFleetAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQueueFleetAssociationExists(ctx context.Context, n string, v *deadline.GetQueueFleetAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		output, err := tfdeadline.FindQueueFleetAssociationByThreePartKey(ctx, conn, rs.Primary.Attributes["farm_id"], rs.Primary.Attributes["queue_id"], rs.Primary.Attributes["fleet_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQueueFleetAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_queue_fleet_association" {
+				continue
+			}
+
+			_, err := tfdeadline.FindQueueFleetAssociationByThreePartKey(ctx, conn, rs.Primary.Attributes["farm_id"], rs.Primary.Attributes["queue_id"], rs.Primary.Attributes["fleet_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Deadline Cloud Queue Fleet Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccQueueFleetAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_basic(rName, 1), fmt.Sprintf(`
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+}
+
+resource "aws_deadline_queue_fleet_association" "test" {
+  farm_id  = aws_deadline_farm.test.id
+  fleet_id = aws_deadline_fleet.test.fleet_id
+  queue_id = aws_deadline_queue.test.queue_id
+}
+`, rName))
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deadline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDeadlineQueue_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_storage_profile_ids.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "deadline", regexache.MustCompile(`farm/.+/queue/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_budget_action", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "job_attachment_settings.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "required_file_system_location_names.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueue_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceQueue(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueue_jobRunAsUser(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_jobRunAsUser(rName, "QUEUE_CONFIGURED_USER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "job_run_as_user.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_run_as_user.0.run_as", "QUEUE_CONFIGURED_USER"),
+					resource.TestCheckResourceAttr(resourceName, "job_run_as_user.0.posix.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_run_as_user.0.posix.0.group", "render"),
+					resource.TestCheckResourceAttr(resourceName, "job_run_as_user.0.posix.0.user", "render"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_jobRunAsUser(rName, "WORKER_AGENT_USER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "job_run_as_user.0.run_as", "WORKER_AGENT_USER"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueue_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, deadline.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccQueueConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQueueExists(ctx context.Context, n string, v *deadline.GetQueueOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		output, err := tfdeadline.FindQueueByTwoPartKey(ctx, conn, rs.Primary.Attributes["farm_id"], rs.Primary.Attributes["queue_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_queue" {
+				continue
+			}
+
+			_, err := tfdeadline.FindQueueByTwoPartKey(ctx, conn, rs.Primary.Attributes["farm_id"], rs.Primary.Attributes["queue_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Deadline Cloud Queue %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccQueueConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+}
+`, rName)
+}
+
+func testAccQueueConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+  description  = %[2]q
+}
+`, rName, description))
+}
+
+func testAccQueueConfig_jobRunAsUser(rName, runAs string) string {
+	return acctest.ConfigCompose(testAccQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+
+  job_run_as_user {
+    run_as = %[2]q
+
+    posix {
+      group = "render"
+      user  = "render"
+    }
+  }
+}
+`, rName, runAs))
+}
+
+func testAccQueueConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccQueueConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
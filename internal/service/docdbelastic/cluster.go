@@ -0,0 +1,570 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Cluster")
+// @Tags(identifierAttribute="arn")
+func newResourceCluster(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCluster{}
+	r.SetDefaultCreateTimeout(45 * time.Minute)
+	r.SetDefaultUpdateTimeout(45 * time.Minute)
+	r.SetDefaultDeleteTimeout(45 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCluster struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCluster) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_docdbelastic_cluster"
+}
+
+func (r *resourceCluster) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_user_password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(docdbelastic.Auth_Values()...),
+				},
+			},
+			"backup_retention_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 35),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z][0-9a-z-]*$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				},
+			},
+			"preferred_backup_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([01]\d|2[0-3]):[0-5]\d-([01]\d|2[0-3]):[0-5]\d$`), `must be in the format "hh24:mi-hh24:mi"`),
+				},
+			},
+			"preferred_maintenance_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shard_capacity": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2, 4, 8, 16, 32, 64),
+				},
+			},
+			"shard_count": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"shard_instance_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"subnet_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"vpc_security_group_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceCluster) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceClusterData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	name := data.Name.ValueString()
+	input := &docdbelastic.CreateClusterInput{
+		AdminUserName:     flex.StringFromFramework(ctx, data.AdminUserName),
+		AdminUserPassword: flex.StringFromFramework(ctx, data.AdminUserPassword),
+		AuthType:          flex.StringFromFramework(ctx, data.AuthType),
+		ClientToken:       aws.String(id.UniqueId()),
+		ClusterName:       aws.String(name),
+		ShardCapacity:     flex.Int64FromFramework(ctx, data.ShardCapacity),
+		ShardCount:        flex.Int64FromFramework(ctx, data.ShardCount),
+		Tags:              getTagsIn(ctx),
+	}
+
+	if !data.BackupRetentionPeriod.IsUnknown() {
+		input.BackupRetentionPeriod = flex.Int64FromFramework(ctx, data.BackupRetentionPeriod)
+	}
+
+	if !data.KMSKeyID.IsUnknown() {
+		input.KmsKeyId = flex.StringFromFramework(ctx, data.KMSKeyID)
+	}
+
+	if !data.PreferredBackupWindow.IsUnknown() {
+		input.PreferredBackupWindow = flex.StringFromFramework(ctx, data.PreferredBackupWindow)
+	}
+
+	if !data.PreferredMaintenanceWindow.IsUnknown() {
+		input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, data.PreferredMaintenanceWindow)
+	}
+
+	if !data.ShardInstanceCount.IsUnknown() {
+		input.ShardInstanceCount = flex.Int64FromFramework(ctx, data.ShardInstanceCount)
+	}
+
+	if !data.SubnetIDs.IsUnknown() {
+		input.SubnetIds = flex.ExpandFrameworkStringSet(ctx, data.SubnetIDs)
+	}
+
+	if !data.VPCSecurityGroupIDs.IsUnknown() {
+		input.VpcSecurityGroupIds = flex.ExpandFrameworkStringSet(ctx, data.VPCSecurityGroupIDs)
+	}
+
+	output, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DocumentDB Elastic Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.Cluster.ClusterArn)
+	data.ID = data.ARN
+
+	cluster, err := waitClusterCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocumentDB Elastic Cluster (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.refreshFromOutput(ctx, cluster)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCluster) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceClusterData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	cluster, err := findClusterByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DocumentDB Elastic Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, cluster)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceClusterData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	if clusterHasChanges(old, new) {
+		input := &docdbelastic.UpdateClusterInput{
+			ClientToken: aws.String(id.UniqueId()),
+			ClusterArn:  flex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.AdminUserPassword.Equal(old.AdminUserPassword) {
+			input.AdminUserPassword = flex.StringFromFramework(ctx, new.AdminUserPassword)
+		}
+
+		if !new.AuthType.Equal(old.AuthType) {
+			input.AuthType = flex.StringFromFramework(ctx, new.AuthType)
+		}
+
+		if !new.BackupRetentionPeriod.IsUnknown() && !new.BackupRetentionPeriod.Equal(old.BackupRetentionPeriod) {
+			input.BackupRetentionPeriod = flex.Int64FromFramework(ctx, new.BackupRetentionPeriod)
+		}
+
+		if !new.PreferredBackupWindow.IsUnknown() && !new.PreferredBackupWindow.Equal(old.PreferredBackupWindow) {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, new.PreferredBackupWindow)
+		}
+
+		if !new.PreferredMaintenanceWindow.IsUnknown() && !new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) {
+			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, new.PreferredMaintenanceWindow)
+		}
+
+		if !new.ShardCapacity.Equal(old.ShardCapacity) {
+			input.ShardCapacity = flex.Int64FromFramework(ctx, new.ShardCapacity)
+		}
+
+		if !new.ShardCount.Equal(old.ShardCount) {
+			input.ShardCount = flex.Int64FromFramework(ctx, new.ShardCount)
+		}
+
+		if !new.ShardInstanceCount.IsUnknown() && !new.ShardInstanceCount.Equal(old.ShardInstanceCount) {
+			input.ShardInstanceCount = flex.Int64FromFramework(ctx, new.ShardInstanceCount)
+		}
+
+		if !new.SubnetIDs.IsUnknown() && !new.SubnetIDs.Equal(old.SubnetIDs) {
+			input.SubnetIds = flex.ExpandFrameworkStringSet(ctx, new.SubnetIDs)
+		}
+
+		if !new.VPCSecurityGroupIDs.IsUnknown() && !new.VPCSecurityGroupIDs.Equal(old.VPCSecurityGroupIDs) {
+			input.VpcSecurityGroupIds = flex.ExpandFrameworkStringSet(ctx, new.VPCSecurityGroupIDs)
+		}
+
+		_, err := conn.UpdateClusterWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DocumentDB Elastic Cluster (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		cluster, err := waitClusterUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DocumentDB Elastic Cluster (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.refreshFromOutput(ctx, cluster)
+	} else {
+		new.BackupRetentionPeriod = old.BackupRetentionPeriod
+		new.PreferredBackupWindow = old.PreferredBackupWindow
+		new.PreferredMaintenanceWindow = old.PreferredMaintenanceWindow
+		new.ShardInstanceCount = old.ShardInstanceCount
+		new.SubnetIDs = old.SubnetIDs
+		new.VPCSecurityGroupIDs = old.VPCSecurityGroupIDs
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceCluster) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceClusterData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	_, err := conn.DeleteClusterWithContext(ctx, &docdbelastic.DeleteClusterInput{
+		ClusterArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DocumentDB Elastic Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocumentDB Elastic Cluster (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceCluster) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func clusterHasChanges(old, new resourceClusterData) bool {
+	return !new.AdminUserPassword.Equal(old.AdminUserPassword) ||
+		!new.AuthType.Equal(old.AuthType) ||
+		!new.BackupRetentionPeriod.Equal(old.BackupRetentionPeriod) ||
+		!new.PreferredBackupWindow.Equal(old.PreferredBackupWindow) ||
+		!new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) ||
+		!new.ShardCapacity.Equal(old.ShardCapacity) ||
+		!new.ShardCount.Equal(old.ShardCount) ||
+		!new.ShardInstanceCount.Equal(old.ShardInstanceCount) ||
+		!new.SubnetIDs.Equal(old.SubnetIDs) ||
+		!new.VPCSecurityGroupIDs.Equal(old.VPCSecurityGroupIDs)
+}
+
+type resourceClusterData struct {
+	AdminUserName              types.String   `tfsdk:"admin_user_name"`
+	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
+	ARN                        types.String   `tfsdk:"arn"`
+	AuthType                   types.String   `tfsdk:"auth_type"`
+	BackupRetentionPeriod      types.Int64    `tfsdk:"backup_retention_period"`
+	Endpoint                   types.String   `tfsdk:"endpoint"`
+	ID                         types.String   `tfsdk:"id"`
+	KMSKeyID                   types.String   `tfsdk:"kms_key_id"`
+	Name                       types.String   `tfsdk:"name"`
+	PreferredBackupWindow      types.String   `tfsdk:"preferred_backup_window"`
+	PreferredMaintenanceWindow types.String   `tfsdk:"preferred_maintenance_window"`
+	ShardCapacity              types.Int64    `tfsdk:"shard_capacity"`
+	ShardCount                 types.Int64    `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int64    `tfsdk:"shard_instance_count"`
+	SubnetIDs                  types.Set      `tfsdk:"subnet_ids"`
+	Tags                       types.Map      `tfsdk:"tags"`
+	TagsAll                    types.Map      `tfsdk:"tags_all"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
+	VPCSecurityGroupIDs        types.Set      `tfsdk:"vpc_security_group_ids"`
+}
+
+func (data *resourceClusterData) refreshFromOutput(ctx context.Context, apiObject *docdbelastic.Cluster) {
+	if apiObject == nil {
+		return
+	}
+
+	data.AdminUserName = flex.StringToFramework(ctx, apiObject.AdminUserName)
+	data.ARN = flex.StringToFramework(ctx, apiObject.ClusterArn)
+	data.AuthType = flex.StringToFramework(ctx, apiObject.AuthType)
+	data.BackupRetentionPeriod = flex.Int64ToFramework(ctx, apiObject.BackupRetentionPeriod)
+	data.Endpoint = flex.StringToFramework(ctx, apiObject.ClusterEndpoint)
+	data.ID = data.ARN
+	data.KMSKeyID = flex.StringToFramework(ctx, apiObject.KmsKeyId)
+	data.Name = flex.StringToFramework(ctx, apiObject.ClusterName)
+	data.PreferredBackupWindow = flex.StringToFramework(ctx, apiObject.PreferredBackupWindow)
+	data.PreferredMaintenanceWindow = flex.StringToFramework(ctx, apiObject.PreferredMaintenanceWindow)
+	data.ShardCapacity = flex.Int64ToFramework(ctx, apiObject.ShardCapacity)
+	data.ShardCount = flex.Int64ToFramework(ctx, apiObject.ShardCount)
+	data.ShardInstanceCount = flex.Int64ToFramework(ctx, apiObject.ShardInstanceCount)
+	data.SubnetIDs = flex.FlattenFrameworkStringSet(ctx, apiObject.SubnetIds)
+	data.VPCSecurityGroupIDs = flex.FlattenFrameworkStringSet(ctx, apiObject.VpcSecurityGroupIds)
+}
+
+func findClusterByARN(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) (*docdbelastic.Cluster, error) {
+	input := &docdbelastic.GetClusterInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.GetClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+func statusCluster(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitClusterCreated(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{docdbelastic.StatusCreating},
+		Target:  []string{docdbelastic.StatusActive},
+		Refresh: statusCluster(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterUpdated(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			docdbelastic.StatusMerging,
+			docdbelastic.StatusModifying,
+			docdbelastic.StatusSplitting,
+			docdbelastic.StatusUpdating,
+		},
+		Target:  []string{docdbelastic.StatusActive},
+		Refresh: statusCluster(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{docdbelastic.StatusActive, docdbelastic.StatusDeleting},
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
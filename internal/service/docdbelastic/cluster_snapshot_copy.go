@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Cluster Snapshot Copy")
+// @Tags(identifierAttribute="arn")
+func newResourceClusterSnapshotCopy(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceClusterSnapshotCopy{}
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceClusterSnapshotCopy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceClusterSnapshotCopy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_docdbelastic_cluster_snapshot_copy"
+}
+
+func (r *resourceClusterSnapshotCopy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_snapshot_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_snapshot_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z][0-9a-z-]*$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceClusterSnapshotCopy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceClusterSnapshotCopyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	name := data.TargetSnapshotName.ValueString()
+	input := &docdbelastic.CopyClusterSnapshotInput{
+		CopyTags:           flex.BoolFromFramework(ctx, data.CopyTags),
+		SnapshotArn:        flex.StringFromFramework(ctx, data.SourceSnapshotARN),
+		Tags:               getTagsIn(ctx),
+		TargetSnapshotName: aws.String(name),
+	}
+
+	if !data.KMSKeyID.IsUnknown() {
+		input.KmsKeyId = flex.StringFromFramework(ctx, data.KMSKeyID)
+	}
+
+	output, err := conn.CopyClusterSnapshotWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DocumentDB Elastic Cluster Snapshot Copy (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Snapshot.SnapshotArn)
+
+	snapshot, err := waitClusterSnapshotCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocumentDB Elastic Cluster Snapshot Copy (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.refreshFromOutput(ctx, snapshot)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceClusterSnapshotCopy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceClusterSnapshotCopyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	snapshot, err := findClusterSnapshotByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DocumentDB Elastic Cluster Snapshot Copy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, snapshot)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceClusterSnapshotCopy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+	var new resourceClusterSnapshotCopyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceClusterSnapshotCopy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceClusterSnapshotCopyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticConn(ctx)
+
+	_, err := conn.DeleteClusterSnapshotWithContext(ctx, &docdbelastic.DeleteClusterSnapshotInput{
+		SnapshotArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DocumentDB Elastic Cluster Snapshot Copy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterSnapshotDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocumentDB Elastic Cluster Snapshot Copy (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceClusterSnapshotCopy) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceClusterSnapshotCopyData struct {
+	ARN                  types.String   `tfsdk:"arn"`
+	ClusterARN           types.String   `tfsdk:"cluster_arn"`
+	CopyTags             types.Bool     `tfsdk:"copy_tags"`
+	ID                   types.String   `tfsdk:"id"`
+	KMSKeyID             types.String   `tfsdk:"kms_key_id"`
+	SnapshotCreationTime types.String   `tfsdk:"snapshot_creation_time"`
+	SourceSnapshotARN    types.String   `tfsdk:"source_snapshot_arn"`
+	Status               types.String   `tfsdk:"status"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	TagsAll              types.Map      `tfsdk:"tags_all"`
+	TargetSnapshotName   types.String   `tfsdk:"target_snapshot_name"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *resourceClusterSnapshotCopyData) refreshFromOutput(ctx context.Context, apiObject *docdbelastic.ClusterSnapshot) {
+	if apiObject == nil {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, apiObject.SnapshotArn)
+	data.ClusterARN = flex.StringToFramework(ctx, apiObject.ClusterArn)
+	data.ID = data.ARN
+	data.KMSKeyID = flex.StringToFramework(ctx, apiObject.KmsKeyId)
+	data.SnapshotCreationTime = flex.StringToFramework(ctx, apiObject.SnapshotCreationTime)
+	data.Status = flex.StringToFramework(ctx, apiObject.Status)
+	data.TargetSnapshotName = flex.StringToFramework(ctx, apiObject.SnapshotName)
+}
+
+func findClusterSnapshotByARN(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) (*docdbelastic.ClusterSnapshot, error) {
+	input := &docdbelastic.GetClusterSnapshotInput{
+		SnapshotArn: aws.String(arn),
+	}
+
+	output, err := conn.GetClusterSnapshotWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Snapshot == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Snapshot, nil
+}
+
+func statusClusterSnapshot(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterSnapshotByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitClusterSnapshotCreated(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.ClusterSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{docdbelastic.StatusCopying, docdbelastic.StatusCreating},
+		Target:  []string{docdbelastic.StatusActive},
+		Refresh: statusClusterSnapshot(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*docdbelastic.ClusterSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterSnapshotDeleted(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.ClusterSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{docdbelastic.StatusActive, docdbelastic.StatusDeleting},
+		Target:  []string{},
+		Refresh: statusClusterSnapshot(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*docdbelastic.ClusterSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+// Exports for use in tests only.
+var (
+	ResourceCluster             = newResourceCluster
+	ResourceClusterSnapshotCopy = newResourceClusterSnapshotCopy
+
+	FindClusterByARN         = findClusterByARN
+	FindClusterSnapshotByARN = findClusterSnapshotByARN
+)
@@ -12,3 +12,7 @@ var (
 	UpdateTags   = updateTags
 	UpdateTagsV2 = updateTagsV2
 )
+
+const (
+	AppRunnerENIDescriptionFilter = appRunnerENIDescriptionFilter
+)
@@ -1476,6 +1476,8 @@ func deleteLingeringENIs(ctx context.Context, conn *ec2.EC2, filterName, resourc
 
 	err = multierror.Append(err, deleteLingeringComprehendENIs(ctx, &g, conn, filterName, resourceId, timeout))
 
+	err = multierror.Append(err, deleteLingeringAppRunnerENIs(ctx, &g, conn, filterName, resourceId, timeout))
+
 	return multierror.Append(err, g.Wait()).ErrorOrNil()
 }
 
@@ -1497,36 +1499,39 @@ func deleteLingeringLambdaENIs(ctx context.Context, g *multierror.Group, conn *e
 	}
 
 	for _, v := range networkInterfaces {
-		v := v
+		networkInterfaceID := aws.StringValue(v.NetworkInterfaceId)
 		g.Go(func() error {
-			networkInterfaceID := aws.StringValue(v.NetworkInterfaceId)
-
-			if v.Attachment != nil && aws.StringValue(v.Attachment.InstanceOwnerId) == "amazon-aws" {
-				networkInterface, err := WaitNetworkInterfaceAvailableAfterUse(ctx, conn, networkInterfaceID, timeout)
-
-				if tfresource.NotFound(err) {
-					return nil
-				}
+			if err := deleteHyperplaneENI(ctx, conn, networkInterfaceID, timeout); err != nil {
+				return fmt.Errorf("deleting Lambda ENI (%s): %w", networkInterfaceID, err)
+			}
 
-				if err != nil {
-					return fmt.Errorf("waiting for Lambda ENI (%s) to become available for detachment: %w", networkInterfaceID, err)
-				}
+			return nil
+		})
+	}
 
-				v = networkInterface
-			}
+	return nil
+}
 
-			if v.Attachment != nil {
-				err = DetachNetworkInterface(ctx, conn, networkInterfaceID, aws.StringValue(v.Attachment.AttachmentId), timeout)
+// appRunnerENIDescriptionFilter matches the description of the ENIs App Runner creates for a VPC connector.
+const appRunnerENIDescriptionFilter = "*App Runner*"
 
-				if err != nil {
-					return fmt.Errorf("detaching Lambda ENI (%s): %w", networkInterfaceID, err)
-				}
-			}
+func deleteLingeringAppRunnerENIs(ctx context.Context, g *multierror.Group, conn *ec2.EC2, filterName, resourceId string, timeout time.Duration) error {
+	networkInterfaces, err := FindNetworkInterfaces(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			filterName:    resourceId,
+			"description": appRunnerENIDescriptionFilter,
+		}),
+	})
 
-			err = DeleteNetworkInterface(ctx, conn, networkInterfaceID)
+	if err != nil {
+		return fmt.Errorf("listing EC2 Network Interfaces: %w", err)
+	}
 
-			if err != nil {
-				return fmt.Errorf("deleting Lambda ENI (%s): %w", networkInterfaceID, err)
+	for _, v := range networkInterfaces {
+		networkInterfaceID := aws.StringValue(v.NetworkInterfaceId)
+		g.Go(func() error {
+			if err := deleteHyperplaneENI(ctx, conn, networkInterfaceID, timeout); err != nil {
+				return fmt.Errorf("deleting App Runner ENI (%s): %w", networkInterfaceID, err)
 			}
 
 			return nil
@@ -1536,6 +1541,44 @@ func deleteLingeringLambdaENIs(ctx context.Context, g *multierror.Group, conn *e
 	return nil
 }
 
+// deleteHyperplaneENI deletes a service-managed (Hyperplane) ENI once the owning service has released it.
+func deleteHyperplaneENI(ctx context.Context, conn *ec2.EC2, networkInterfaceID string, timeout time.Duration) error {
+	networkInterface, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if networkInterface.Attachment != nil && aws.StringValue(networkInterface.Attachment.InstanceOwnerId) == "amazon-aws" {
+		networkInterface, err = WaitNetworkInterfaceAvailableAfterUse(ctx, conn, networkInterfaceID, timeout)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("waiting for available after use: %w", err)
+		}
+	}
+
+	if networkInterface.Attachment != nil {
+		if err := DetachNetworkInterface(ctx, conn, networkInterfaceID, aws.StringValue(networkInterface.Attachment.AttachmentId), timeout); err != nil {
+			return err
+		}
+	}
+
+	// EC2 can still briefly report a released ENI as in use after the stability window.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return nil, DeleteNetworkInterface(ctx, conn, networkInterfaceID)
+	}, errCodeInvalidNetworkInterfaceInUse)
+
+	return err
+}
+
 func deleteLingeringComprehendENIs(ctx context.Context, g *multierror.Group, conn *ec2.EC2, filterName, resourceId string, timeout time.Duration) error {
 	// Deletion appears to take approximately 5 minutes
 	if minimumTimeout := 10 * time.Minute; timeout < minimumTimeout {
@@ -2495,8 +2495,9 @@ func WaitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.EC2, i
 		Refresh:    StatusNetworkInterfaceStatus(ctx, conn, id),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
-		// Handle EC2 ENI eventual consistency. It can take up to 3 minutes.
-		ContinuousTargetOccurence: 18,
+		// Handle EC2 ENI eventual consistency. The remainder of the up to 3 minute window
+		// is covered by retrying the deletion while EC2 reports the ENI as in use.
+		ContinuousTargetOccurence: 6,
 		NotFoundChecks:            1,
 	}
 
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -97,6 +100,12 @@ func ResourceCapacityProvider() *schema.Resource {
 								},
 							},
 						},
+						"managed_draining": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ecs.ManagedDraining_Values(), false),
+						},
 						"managed_termination_protection": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -121,6 +130,14 @@ func resourceCapacityProviderCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
+	if v, ok := d.GetOk("auto_scaling_group_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		diags = append(diags, validateCapacityProviderAutoScalingGroup(ctx, meta.(*conns.AWSClient).AutoScalingConn(ctx), v.([]interface{})[0].(map[string]interface{}), true)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	name := d.Get("name").(string)
 	input := ecs.CreateCapacityProviderInput{
 		Name:                     aws.String(name),
@@ -194,6 +211,15 @@ func resourceCapacityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
 	if d.HasChangesExcept("tags", "tags_all") {
+		if v, ok := d.GetOk("auto_scaling_group_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			warnWarmPool := d.HasChanges("auto_scaling_group_provider.0.auto_scaling_group_arn", "auto_scaling_group_provider.0.managed_scaling")
+			diags = append(diags, validateCapacityProviderAutoScalingGroup(ctx, meta.(*conns.AWSClient).AutoScalingConn(ctx), v.([]interface{})[0].(map[string]interface{}), warnWarmPool)...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+
 		input := &ecs.UpdateCapacityProviderInput{
 			AutoScalingGroupProvider: expandAutoScalingGroupProviderUpdate(d.Get("auto_scaling_group_provider")),
 			Name:                     aws.String(d.Get("name").(string)),
@@ -267,6 +293,51 @@ func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// validateCapacityProviderAutoScalingGroup checks the capacity provider's settings against the current configuration of its Auto Scaling group.
+// Validation runs at apply time so that changes to the Auto Scaling group made in the same apply are taken into account.
+// The warm pool warning is only returned if warnWarmPool is true, so that it isn't repeated on unrelated updates.
+func validateCapacityProviderAutoScalingGroup(ctx context.Context, conn *autoscaling.AutoScaling, tfMap map[string]interface{}, warnWarmPool bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupARN, err := arn.Parse(tfMap["auto_scaling_group_arn"].(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, name, found := strings.Cut(groupARN.Resource, "autoScalingGroupName/")
+
+	if !found {
+		return diags
+	}
+
+	group, err := tfautoscaling.FindGroupByName(ctx, conn, name)
+
+	// Leave it to ECS to report a missing Auto Scaling group.
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	// The check is best-effort, so don't require autoscaling:DescribeAutoScalingGroups.
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		return sdkdiag.AppendWarningf(diags, "skipping Auto Scaling Group (%s) validation: %s", name, err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s): %s", name, err)
+	}
+
+	if v := tfMap["managed_termination_protection"].(string); v == ecs.ManagedTerminationProtectionEnabled && !aws.BoolValue(group.NewInstancesProtectedFromScaleIn) {
+		diags = sdkdiag.AppendErrorf(diags, "managed_termination_protection is %s but Auto Scaling Group (%s) does not protect new instances from scale in: set protect_from_scale_in on the Auto Scaling Group", v, name)
+	}
+
+	if warnWarmPool && group.WarmPoolConfiguration != nil {
+		diags = sdkdiag.AppendWarningf(diags, "Auto Scaling Group (%s) has a warm pool: its instances must set ECS_WARM_POOLS_CHECK=true in the ECS container agent configuration so that they register with the cluster only when they leave the warm pool", name)
+	}
+
+	return diags
+}
+
 func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScalingGroupProvider {
 	if configured == nil {
 		return nil
@@ -281,6 +352,10 @@ func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScali
 	arn := p["auto_scaling_group_arn"].(string)
 	prov.AutoScalingGroupArn = aws.String(arn)
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = aws.String(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
@@ -302,6 +377,10 @@ func expandAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScali
 	prov := ecs.AutoScalingGroupProviderUpdate{}
 	p := configured.([]interface{})[0].(map[string]interface{})
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = aws.String(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
@@ -350,6 +429,7 @@ func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []m
 
 	p := map[string]interface{}{
 		"auto_scaling_group_arn":         aws.StringValue(provider.AutoScalingGroupArn),
+		"managed_draining":               aws.StringValue(provider.ManagedDraining),
 		"managed_termination_protection": aws.StringValue(provider.ManagedTerminationProtection),
 		"managed_scaling":                []map[string]interface{}{},
 	}
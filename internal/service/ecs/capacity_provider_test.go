@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -181,6 +183,86 @@ func TestAccECSCapacityProvider_managedScalingPartial(t *testing.T) {
 	})
 }
 
+func TestAccECSCapacityProvider_managedDraining(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider ecs.CapacityProvider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityProviderConfig_managedDraining(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityProviderConfig_managedDraining(rName, "ENABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSCapacityProvider_managedTerminationProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider ecs.CapacityProvider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCapacityProviderConfig_managedTerminationProtection(rName, false, "ENABLED"),
+				ExpectError: regexache.MustCompile(`does not protect new instances from scale in`),
+			},
+			{
+				Config: testAccCapacityProviderConfig_managedTerminationProtection(rName, true, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccCapacityProviderConfig_managedTerminationProtection(rName, true, "DISABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSCapacityProvider_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider ecs.CapacityProvider
@@ -280,6 +362,10 @@ func testAccCheckCapacityProviderExists(ctx context.Context, resourceName string
 }
 
 func testAccCapacityProviderConfig_base(rName string) string {
+	return testAccCapacityProviderConfig_baseProtectFromScaleIn(rName, false)
+}
+
+func testAccCapacityProviderConfig_baseProtectFromScaleIn(rName string, protectFromScaleIn bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -291,11 +377,12 @@ resource "aws_launch_template" "test" {
 }
 
 resource "aws_autoscaling_group" "test" {
-  availability_zones = data.aws_availability_zones.available.names
-  desired_capacity   = 0
-  max_size           = 0
-  min_size           = 0
-  name               = %[1]q
+  availability_zones    = data.aws_availability_zones.available.names
+  desired_capacity      = 0
+  max_size              = 0
+  min_size              = 0
+  name                  = %[1]q
+  protect_from_scale_in = %[2]t
 
   launch_template {
     id = aws_launch_template.test.id
@@ -313,7 +400,7 @@ resource "aws_autoscaling_group" "test" {
     ]
   }
 }
-`, rName))
+`, rName, protectFromScaleIn))
 }
 
 func testAccCapacityProviderConfig_basic(rName string) string {
@@ -365,6 +452,36 @@ resource "aws_ecs_capacity_provider" "test" {
 `, rName))
 }
 
+func testAccCapacityProviderConfig_managedDraining(rName, managedDraining string) string {
+	return acctest.ConfigCompose(testAccCapacityProviderConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+    managed_draining       = %[2]q
+  }
+}
+`, rName, managedDraining))
+}
+
+func testAccCapacityProviderConfig_managedTerminationProtection(rName string, protectFromScaleIn bool, managedTerminationProtection string) string {
+	return acctest.ConfigCompose(testAccCapacityProviderConfig_baseProtectFromScaleIn(rName, protectFromScaleIn), fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn         = aws_autoscaling_group.test.arn
+    managed_termination_protection = %[2]q
+
+    managed_scaling {
+      status = "ENABLED"
+    }
+  }
+}
+`, rName, managedTerminationProtection))
+}
+
 func testAccCapacityProviderConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return acctest.ConfigCompose(testAccCapacityProviderConfig_base(rName), fmt.Sprintf(`
 resource "aws_ecs_capacity_provider" "test" {
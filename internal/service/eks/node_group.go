@@ -466,7 +466,38 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
-	// Do any version update first.
+	// Apply any rollout setting changes before a version update so that they govern the node rotation.
+	updateConfigFirst := d.HasChange("update_config") && d.HasChanges("launch_template", "release_version", "version")
+
+	if updateConfigFirst {
+		input := &eks.UpdateNodegroupConfigInput{
+			ClientRequestToken: aws.String(id.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			NodegroupName:      aws.String(nodeGroupName),
+		}
+
+		if v, ok := d.GetOk("update_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.UpdateConfig = expandNodegroupUpdateConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if input.UpdateConfig != nil {
+			output, err := conn.UpdateNodegroupConfigWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating EKS Node Group (%s) update config: %s", d.Id(), err)
+			}
+
+			updateID := aws.StringValue(output.Update.Id)
+
+			_, err = waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return diag.Errorf("waiting for EKS Node Group (%s) update config update (%s): %s", d.Id(), updateID, err)
+			}
+		}
+	}
+
+	// Do any version update next.
 	if d.HasChanges("launch_template", "release_version", "version") {
 		input := &eks.UpdateNodegroupVersionInput{
 			ClientRequestToken: aws.String(id.UniqueId()),
@@ -518,7 +549,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChanges("labels", "scaling_config", "taint", "update_config") {
+	if d.HasChanges("labels", "scaling_config", "taint") || (d.HasChange("update_config") && !updateConfigFirst) {
 		oldLabelsRaw, newLabelsRaw := d.GetChange("labels")
 		oldTaintsRaw, newTaintsRaw := d.GetChange("taint")
 
@@ -536,7 +567,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			}
 		}
 
-		if d.HasChange("update_config") {
+		if d.HasChange("update_config") && !updateConfigFirst {
 			if v, ok := d.GetOk("update_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.UpdateConfig = expandNodegroupUpdateConfig(v.([]interface{})[0].(map[string]interface{}))
 			}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticache_reserved_cache_node", name="Reserved Cache Node")
+// @Tags(identifierAttribute="arn")
+func ResourceReservedCacheNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReservedCacheNodeCreate,
+		ReadWithoutTimeout:   resourceReservedCacheNodeRead,
+		UpdateWithoutTimeout: resourceReservedCacheNodeUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReservedCacheNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	offeringID := d.Get("offering_id").(string)
+	input := &elasticache.PurchaseReservedCacheNodesOfferingInput{
+		CacheNodeCount:               aws.Int64(int64(d.Get("cache_node_count").(int))),
+		ReservedCacheNodesOfferingId: aws.String(offeringID),
+		Tags:                         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedCacheNodeId = aws.String(v.(string))
+	}
+
+	output, err := conn.PurchaseReservedCacheNodesOfferingWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "purchasing ElastiCache Reserved Cache Node (offering %s): %s", offeringID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReservedCacheNode.ReservedCacheNodeId))
+
+	if _, err := waitReservedCacheNodeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Reserved Cache Node (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReservedCacheNodeRead(ctx, d, meta)...)
+}
+
+func resourceReservedCacheNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	reservation, err := FindReservedCacheNodeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Reserved Cache Node (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", reservation.ReservationARN)
+	d.Set("cache_node_count", reservation.CacheNodeCount)
+	d.Set("cache_node_type", reservation.CacheNodeType)
+	d.Set("duration", reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set("offering_id", reservation.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", reservation.OfferingType)
+	d.Set("product_description", reservation.ProductDescription)
+	if err := d.Set("recurring_charges", flattenRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recurring_charges: %s", err)
+	}
+	d.Set("reservation_id", reservation.ReservedCacheNodeId)
+	if v := reservation.StartTime; v != nil {
+		d.Set("start_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("state", reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	return diags
+}
+
+func resourceReservedCacheNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceReservedCacheNodeRead(ctx, d, meta)
+}
+
+func flattenRecurringCharges(apiObjects []*elasticache.RecurringCharge) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(apiObject.RecurringChargeFrequency),
+		})
+	}
+
+	return tfList
+}
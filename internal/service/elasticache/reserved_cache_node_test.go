@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+)
+
+func TestAccElastiCacheReservedCacheNode_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RUN_ELASTICACHE_RESERVED_CACHE_NODE_TESTS"
+	if os.Getenv(key) != "true" {
+		t.Skipf("Environment variable %s is not set to true", key)
+	}
+
+	var reservation elasticache.ReservedCacheNode
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_reserved_cache_node.test"
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedCacheNodeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservedCacheNodeExists(ctx, resourceName, &reservation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "elasticache", regexache.MustCompile(`reserved-instance:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cache_node_count", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cache_node_type", resourceName, "cache_node_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "duration", resourceName, "duration"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fixed_price", resourceName, "fixed_price"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_type", resourceName, "offering_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "product_description", resourceName, "product_description"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", tfelasticache.ReservedCacheNodeStateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReservedCacheNodeExists(ctx context.Context, n string, v *elasticache.ReservedCacheNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Reserved Cache Node ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn(ctx)
+
+		output, err := tfelasticache.FindReservedCacheNodeByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedCacheNodeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_reserved_cache_node_offering" "test" {
+  cache_node_type     = "cache.t4g.small"
+  duration            = 31536000
+  offering_type       = "No Upfront"
+  product_description = "redis"
+}
+
+resource "aws_elasticache_reserved_cache_node" "test" {
+  offering_id    = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  reservation_id = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
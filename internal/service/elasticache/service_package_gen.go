@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceReplicationGroup,
 			TypeName: "aws_elasticache_replication_group",
 		},
+		{
+			Factory:  DataSourceReservedCacheNodeOffering,
+			TypeName: "aws_elasticache_reserved_cache_node_offering",
+		},
 		{
 			Factory:  DataSourceSubnetGroup,
 			TypeName: "aws_elasticache_subnet_group",
@@ -74,6 +78,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceReservedCacheNode,
+			TypeName: "aws_elasticache_reserved_cache_node",
+			Name:     "Reserved Cache Node",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceSubnetGroup,
 			TypeName: "aws_elasticache_subnet_group",
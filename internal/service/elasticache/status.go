@@ -129,3 +129,24 @@ func statusGlobalReplicationGroupMember(ctx context.Context, conn *elasticache.E
 		return member, aws.StringValue(member.Status), nil
 	}
 }
+
+const (
+	ReservedCacheNodeStateActive         = "active"
+	ReservedCacheNodeStatePaymentPending = "payment-pending"
+	ReservedCacheNodeStateRetired        = "retired"
+)
+
+// statusReservedCacheNode fetches a Reserved Cache Node and its State
+func statusReservedCacheNode(ctx context.Context, conn *elasticache.ElastiCache, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		reservation, err := FindReservedCacheNodeByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return reservation, aws.StringValue(reservation.State), nil
+	}
+}
@@ -234,3 +234,22 @@ func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elastic
 	}
 	return nil, err
 }
+
+// waitReservedCacheNodeCreated waits for a Reserved Cache Node purchase to become active
+func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ReservedCacheNode, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{ReservedCacheNodeStatePaymentPending},
+		Target:         []string{ReservedCacheNodeStateActive},
+		Refresh:        statusReservedCacheNode(ctx, conn, id),
+		NotFoundChecks: 5,
+		Timeout:        timeout,
+		MinTimeout:     10 * time.Second,
+		Delay:          30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.ReservedCacheNode); ok {
+		return v, err
+	}
+	return nil, err
+}
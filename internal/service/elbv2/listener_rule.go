@@ -73,426 +73,430 @@ func ResourceListenerRule() *schema.Resource {
 				ForceNew:     false,
 				ValidateFunc: validListenerRulePriority,
 			},
-			"action": {
-				Type:     schema.TypeList,
-				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(elbv2.ActionTypeEnum_Values(), true),
-						},
-						"order": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntBetween(listenerActionOrderMin, listenerActionOrderMax),
-						},
+			"action":          listenerRuleActionSchema(),
+			"condition":       listenerRuleConditionSchema(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+		),
+	}
+}
 
-						"target_group_arn": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumForward),
-							ValidateFunc:     verify.ValidARN,
-						},
+func listenerRuleActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(elbv2.ActionTypeEnum_Values(), true),
+				},
+				"order": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(listenerActionOrderMin, listenerActionOrderMax),
+				},
 
-						"forward": {
-							Type:             schema.TypeList,
-							Optional:         true,
-							DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumForward),
-							MaxItems:         1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"target_group": {
-										Type:     schema.TypeSet,
-										MinItems: 2,
-										MaxItems: 5,
-										Required: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"arn": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: verify.ValidARN,
-												},
-												"weight": {
-													Type:         schema.TypeInt,
-													ValidateFunc: validation.IntBetween(0, 999),
-													Default:      1,
-													Optional:     true,
-												},
-											},
+				"target_group_arn": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumForward),
+					ValidateFunc:     verify.ValidARN,
+				},
+
+				"forward": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumForward),
+					MaxItems:         1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_group": {
+								Type:     schema.TypeSet,
+								MinItems: 2,
+								MaxItems: 5,
+								Required: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"arn": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"weight": {
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 999),
+											Default:      1,
+											Optional:     true,
 										},
 									},
-									"stickiness": {
-										Type:             schema.TypeList,
-										Optional:         true,
-										DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
-										MaxItems:         1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"enabled": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"duration": {
-													Type:         schema.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(1, 604800),
-												},
-											},
+								},
+							},
+							"stickiness": {
+								Type:             schema.TypeList,
+								Optional:         true,
+								DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+								MaxItems:         1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"enabled": {
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  false,
+										},
+										"duration": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(1, 604800),
 										},
 									},
 								},
 							},
 						},
+					},
+				},
 
-						"redirect": {
-							Type:             schema.TypeList,
-							Optional:         true,
-							DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumRedirect),
-							MaxItems:         1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"host": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Default:      "#{host}",
-										ValidateFunc: validation.StringLenBetween(1, 128),
-									},
+				"redirect": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumRedirect),
+					MaxItems:         1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"host": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "#{host}",
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
 
-									"path": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Default:      "/#{path}",
-										ValidateFunc: validation.StringLenBetween(1, 128),
-									},
+							"path": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "/#{path}",
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
 
-									"port": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "#{port}",
-									},
+							"port": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "#{port}",
+							},
 
-									"protocol": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "#{protocol}",
-										ValidateFunc: validation.StringInSlice([]string{
-											"#{protocol}",
-											"HTTP",
-											"HTTPS",
-										}, false),
-									},
+							"protocol": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "#{protocol}",
+								ValidateFunc: validation.StringInSlice([]string{
+									"#{protocol}",
+									"HTTP",
+									"HTTPS",
+								}, false),
+							},
 
-									"query": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Default:      "#{query}",
-										ValidateFunc: validation.StringLenBetween(0, 128),
-									},
+							"query": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "#{query}",
+								ValidateFunc: validation.StringLenBetween(0, 128),
+							},
 
-									"status_code": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(elbv2.RedirectActionStatusCodeEnum_Values(), false),
-									},
-								},
+							"status_code": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(elbv2.RedirectActionStatusCodeEnum_Values(), false),
 							},
 						},
+					},
+				},
 
-						"fixed_response": {
-							Type:             schema.TypeList,
-							Optional:         true,
-							DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumFixedResponse),
-							MaxItems:         1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"content_type": {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											"text/plain",
-											"text/css",
-											"text/html",
-											"application/javascript",
-											"application/json",
-										}, false),
-									},
+				"fixed_response": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumFixedResponse),
+					MaxItems:         1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"content_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"text/plain",
+									"text/css",
+									"text/html",
+									"application/javascript",
+									"application/json",
+								}, false),
+							},
 
-									"message_body": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringLenBetween(0, 1024),
-									},
+							"message_body": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 1024),
+							},
 
-									"status_code": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Computed:     true,
-										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[245]\d\d$`), ""),
-									},
-								},
+							"status_code": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[245]\d\d$`), ""),
 							},
 						},
+					},
+				},
 
-						"authenticate_cognito": {
-							Type:             schema.TypeList,
-							Optional:         true,
-							DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumAuthenticateCognito),
-							MaxItems:         1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"authentication_request_extra_params": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"on_unauthenticated_request": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Computed:     true,
-										ValidateFunc: validation.StringInSlice(elbv2.AuthenticateCognitoActionConditionalBehaviorEnum_Values(), true),
-									},
-									"scope": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "openid",
-									},
-									"session_cookie_name": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "AWSELBAuthSessionCookie",
-									},
-									"session_timeout": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  604800,
-									},
-									"user_pool_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"user_pool_client_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"user_pool_domain": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+				"authenticate_cognito": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumAuthenticateCognito),
+					MaxItems:         1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"authentication_request_extra_params": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"on_unauthenticated_request": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(elbv2.AuthenticateCognitoActionConditionalBehaviorEnum_Values(), true),
+							},
+							"scope": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "openid",
+							},
+							"session_cookie_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "AWSELBAuthSessionCookie",
+							},
+							"session_timeout": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Default:  604800,
+							},
+							"user_pool_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"user_pool_client_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"user_pool_domain": {
+								Type:     schema.TypeString,
+								Required: true,
 							},
 						},
+					},
+				},
 
-						"authenticate_oidc": {
-							Type:             schema.TypeList,
-							Optional:         true,
-							DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumAuthenticateOidc),
-							MaxItems:         1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"authentication_request_extra_params": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"authorization_endpoint": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"client_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"client_secret": {
-										Type:      schema.TypeString,
-										Required:  true,
-										Sensitive: true,
-									},
-									"issuer": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"on_unauthenticated_request": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Computed:     true,
-										ValidateFunc: validation.StringInSlice(elbv2.AuthenticateOidcActionConditionalBehaviorEnum_Values(), true),
-									},
-									"scope": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "openid",
-									},
-									"session_cookie_name": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "AWSELBAuthSessionCookie",
-									},
-									"session_timeout": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  604800,
-									},
-									"token_endpoint": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"user_info_endpoint": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+				"authenticate_oidc": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					DiffSuppressFunc: suppressIfActionTypeNot(elbv2.ActionTypeEnumAuthenticateOidc),
+					MaxItems:         1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"authentication_request_extra_params": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"authorization_endpoint": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"client_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"client_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+							"issuer": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"on_unauthenticated_request": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(elbv2.AuthenticateOidcActionConditionalBehaviorEnum_Values(), true),
+							},
+							"scope": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "openid",
+							},
+							"session_cookie_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "AWSELBAuthSessionCookie",
+							},
+							"session_timeout": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Default:  604800,
+							},
+							"token_endpoint": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"user_info_endpoint": {
+								Type:     schema.TypeString,
+								Required: true,
 							},
 						},
 					},
 				},
 			},
-			"condition": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"host_header": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"values": {
-										Type:     schema.TypeSet,
-										Required: true,
-										MinItems: 1,
-										Elem: &schema.Schema{
-											Type:         schema.TypeString,
-											ValidateFunc: validation.StringLenBetween(1, 128),
-										},
-										Set: schema.HashString,
-									},
+		},
+	}
+}
+
+func listenerRuleConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_header": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 128),
 								},
+								Set: schema.HashString,
 							},
 						},
-						"http_header": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"http_header_name": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringMatch(regexache.MustCompile("^[0-9A-Za-z_!#$%&'*+,.^`|~-]{1,40}$"), ""), // was "," meant to be included? +-. creates a range including: +,-.
-									},
-									"values": {
-										Type: schema.TypeSet,
-										Elem: &schema.Schema{
-											Type:         schema.TypeString,
-											ValidateFunc: validation.StringLenBetween(1, 128),
-										},
-										Required: true,
-										Set:      schema.HashString,
-									},
+					},
+				},
+				"http_header": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"http_header_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile("^[0-9A-Za-z_!#$%&'*+,.^`|~-]{1,40}$"), ""), // was "," meant to be included? +-. creates a range including: +,-.
+							},
+							"values": {
+								Type: schema.TypeSet,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 128),
 								},
+								Required: true,
+								Set:      schema.HashString,
 							},
 						},
-						"http_request_method": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"values": {
-										Type: schema.TypeSet,
-										Elem: &schema.Schema{
-											Type:         schema.TypeString,
-											ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Za-z-_]{1,40}$`), ""),
-										},
-										Required: true,
-										Set:      schema.HashString,
-									},
+					},
+				},
+				"http_request_method": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": {
+								Type: schema.TypeSet,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Za-z-_]{1,40}$`), ""),
 								},
+								Required: true,
+								Set:      schema.HashString,
 							},
 						},
-						"path_pattern": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"values": {
-										Type:     schema.TypeSet,
-										Required: true,
-										MinItems: 1,
-										Elem: &schema.Schema{
-											Type:         schema.TypeString,
-											ValidateFunc: validation.StringLenBetween(1, 128),
-										},
-										Set: schema.HashString,
-									},
+					},
+				},
+				"path_pattern": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 128),
 								},
+								Set: schema.HashString,
 							},
 						},
-						"query_string": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"key": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+					},
+				},
+				"query_string": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
 							},
 						},
-						"source_ip": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"values": {
-										Type: schema.TypeSet,
-										Elem: &schema.Schema{
-											Type:         schema.TypeString,
-											ValidateFunc: verify.ValidCIDRNetworkAddress,
-										},
-										Required: true,
-										Set:      schema.HashString,
-									},
+					},
+				},
+				"source_ip": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": {
+								Type: schema.TypeSet,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidCIDRNetworkAddress,
 								},
+								Required: true,
+								Set:      schema.HashString,
 							},
 						},
 					},
 				},
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: customdiff.Sequence(
-			verify.SetTagsDiff,
-		),
 	}
 }
 
 func suppressIfActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 	return func(k, old, new string, d *schema.ResourceData) bool {
-		take := 2
-		i := strings.IndexFunc(k, func(r rune) bool {
-			if r == '.' {
-				take -= 1
-				return take == 0
-			}
-			return false
-		})
-		at := k[:i+1] + "type"
+		// k is e.g. "action.0.forward.0.stickiness.0.enabled" or, for aws_lb_listener_rules, "rule.1.action.0.target_group_arn".
+		const prefix = "action."
+		i := strings.Index(k, prefix) + len(prefix)
+		j := strings.Index(k[i:], ".")
+		at := k[:i+j+1] + "type"
 		return d.Get(at).(string) != t
 	}
 }
@@ -606,11 +610,23 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	sort.Slice(rule.Actions, func(i, j int) bool {
-		return aws.Int64Value(rule.Actions[i].Order) < aws.Int64Value(rule.Actions[j].Order)
+	d.Set("action", flattenListenerRuleActions(d, "action", rule.Actions))
+
+	if err := d.Set("condition", flattenListenerRuleConditions(rule.Conditions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting condition: %s", err)
+	}
+
+	return diags
+}
+
+// flattenListenerRuleActions flattens rule actions. The OIDC client secret cannot be read from the API,
+// so it is passed through from the configuration at prefix, e.g. "action".
+func flattenListenerRuleActions(d *schema.ResourceData, prefix string, apiObjects []*elbv2.Action) []interface{} {
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.Int64Value(apiObjects[i].Order) < aws.Int64Value(apiObjects[j].Order)
 	})
-	actions := make([]interface{}, len(rule.Actions))
-	for i, action := range rule.Actions {
+	actions := make([]interface{}, len(apiObjects))
+	for i, action := range apiObjects {
 		actionMap := make(map[string]interface{})
 		actionMap["type"] = aws.StringValue(action.Type)
 		actionMap["order"] = aws.Int64Value(action.Order)
@@ -690,7 +706,7 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 
 			// The LB API currently provides no way to read the ClientSecret
 			// Instead we passthrough the configuration value into the state
-			clientSecret := d.Get("action." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret").(string)
+			clientSecret := d.Get(prefix + "." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret").(string)
 
 			actionMap["authenticate_oidc"] = []map[string]interface{}{
 				{
@@ -711,10 +727,13 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 
 		actions[i] = actionMap
 	}
-	d.Set("action", actions)
 
-	conditions := make([]interface{}, len(rule.Conditions))
-	for i, condition := range rule.Conditions {
+	return actions
+}
+
+func flattenListenerRuleConditions(apiObjects []*elbv2.RuleCondition) []interface{} {
+	conditions := make([]interface{}, len(apiObjects))
+	for i, condition := range apiObjects {
 		conditionMap := make(map[string]interface{})
 
 		switch aws.StringValue(condition.Field) {
@@ -767,11 +786,8 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 
 		conditions[i] = conditionMap
 	}
-	if err := d.Set("condition", conditions); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting condition: %s", err)
-	}
 
-	return diags
+	return conditions
 }
 
 func resourceListenerRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lb_listener_rules", name="Listener Rules")
+func ResourceListenerRules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceListenerRulesCreate,
+		ReadWithoutTimeout:   resourceListenerRulesRead,
+		UpdateWithoutTimeout: resourceListenerRulesUpdate,
+		DeleteWithoutTimeout: resourceListenerRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": listenerRuleActionSchema(),
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"condition": listenerRuleConditionSchema(),
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceListenerRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	listenerARN := d.Get("listener_arn").(string)
+
+	if err := putListenerRules(ctx, conn, d, listenerARN); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELBv2 Listener Rules (%s): %s", listenerARN, err)
+	}
+
+	d.SetId(listenerARN)
+
+	return append(diags, resourceListenerRulesRead(ctx, d, meta)...)
+}
+
+func resourceListenerRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	rules, err := FindListenerRulesByListenerARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ELBv2 Listener Rules (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener Rules (%s): %s", d.Id(), err)
+	}
+
+	tfList := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		priority, _ := strconv.Atoi(aws.StringValue(rule.Priority))
+
+		tfList[i] = map[string]interface{}{
+			"action":    flattenListenerRuleActions(d, fmt.Sprintf("rule.%d.action", i), rule.Actions),
+			"arn":       aws.StringValue(rule.RuleArn),
+			"condition": flattenListenerRuleConditions(rule.Conditions),
+			"priority":  priority,
+		}
+	}
+
+	d.Set("listener_arn", d.Id())
+	if err := d.Set("rule", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	}
+
+	return diags
+}
+
+func resourceListenerRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	if err := putListenerRules(ctx, conn, d, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ELBv2 Listener Rules (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceListenerRulesRead(ctx, d, meta)...)
+}
+
+func resourceListenerRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	rules, err := FindListenerRulesByListenerARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener Rules (%s): %s", d.Id(), err)
+	}
+
+	for _, rule := range rules {
+		if err := deleteListenerRule(ctx, conn, aws.StringValue(rule.RuleArn)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting ELBv2 Listener Rules (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// putListenerRules makes the listener's rules match the configuration.
+// Rules are matched to the listener's existing rules by position. Existing rules are modified in place,
+// rules the configuration no longer contains (or that are not managed by Terraform) are deleted,
+// new rules are created at free priorities and finally all priorities are compacted to 1..N in a single request.
+// On create no rule is managed yet, so every existing non-default rule of the listener is deleted.
+func putListenerRules(ctx context.Context, conn *elbv2.ELBV2, d *schema.ResourceData, listenerARN string) error {
+	rules, err := FindListenerRulesByListenerARN(ctx, conn, listenerARN)
+
+	if err != nil {
+		return fmt.Errorf("reading rules: %w", err)
+	}
+
+	existing := make(map[string]*elbv2.Rule, len(rules))
+	for _, rule := range rules {
+		existing[aws.StringValue(rule.RuleArn)] = rule
+	}
+
+	o, n := d.GetChange("rule")
+	oldList, newList := o.([]interface{}), n.([]interface{})
+
+	// Rule ARN for each configured rule, by position.
+	ruleARNs := make([]string, len(newList))
+	for i := range newList {
+		if i >= len(oldList) || oldList[i] == nil {
+			continue
+		}
+
+		if arn := oldList[i].(map[string]interface{})["arn"].(string); existing[arn] != nil {
+			ruleARNs[i] = arn
+		}
+	}
+
+	keep := make(map[string]bool, len(ruleARNs))
+	for _, arn := range ruleARNs {
+		if arn != "" {
+			keep[arn] = true
+		}
+	}
+
+	for arn := range existing {
+		if keep[arn] {
+			continue
+		}
+
+		if err := deleteListenerRule(ctx, conn, arn); err != nil {
+			return err
+		}
+
+		delete(existing, arn)
+	}
+
+	inUse := make(map[int64]bool, len(existing))
+	for _, rule := range existing {
+		priority, _ := strconv.ParseInt(aws.StringValue(rule.Priority), 10, 64)
+		inUse[priority] = true
+	}
+
+	for i, tfMapRaw := range newList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		actions, err := expandLbListenerActions(tfMap["action"].([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		conditions, err := lbListenerRuleConditions(tfMap["condition"].(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		if arn := ruleARNs[i]; arn != "" {
+			if !d.HasChanges(fmt.Sprintf("rule.%d.action", i), fmt.Sprintf("rule.%d.condition", i)) {
+				continue
+			}
+
+			input := &elbv2.ModifyRuleInput{
+				Actions:    actions,
+				Conditions: conditions,
+				RuleArn:    aws.String(arn),
+			}
+
+			if _, err := conn.ModifyRuleWithContext(ctx, input); err != nil {
+				return fmt.Errorf("modifying rule (%s): %w", arn, err)
+			}
+
+			continue
+		}
+
+		// New rules are created at a free priority and moved into place below.
+		priority, ok := nextFreeListenerRulePriority(inUse)
+		if !ok {
+			return fmt.Errorf("creating rule %d: no free priority", i+1)
+		}
+		inUse[priority] = true
+
+		input := &elbv2.CreateRuleInput{
+			Actions:     actions,
+			Conditions:  conditions,
+			ListenerArn: aws.String(listenerARN),
+			Priority:    aws.Int64(priority),
+		}
+
+		output, err := conn.CreateRuleWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating rule %d: %w", i+1, err)
+		}
+
+		if output == nil || len(output.Rules) == 0 {
+			return fmt.Errorf("creating rule %d: no rules returned in response", i+1)
+		}
+
+		ruleARNs[i] = aws.StringValue(output.Rules[0].RuleArn)
+		existing[ruleARNs[i]] = output.Rules[0]
+	}
+
+	var rulePriorities []*elbv2.RulePriorityPair
+	for i, arn := range ruleARNs {
+		priority := int64(i + 1)
+
+		if aws.StringValue(existing[arn].Priority) == strconv.FormatInt(priority, 10) {
+			continue
+		}
+
+		rulePriorities = append(rulePriorities, &elbv2.RulePriorityPair{
+			Priority: aws.Int64(priority),
+			RuleArn:  aws.String(arn),
+		})
+	}
+
+	if len(rulePriorities) > 0 {
+		input := &elbv2.SetRulePrioritiesInput{
+			RulePriorities: rulePriorities,
+		}
+
+		if _, err := conn.SetRulePrioritiesWithContext(ctx, input); err != nil {
+			return fmt.Errorf("setting rule priorities: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// nextFreeListenerRulePriority returns the highest priority not in use.
+// Allocating from the top of the range keeps the low priorities the rules are compacted to free.
+func nextFreeListenerRulePriority(inUse map[int64]bool) (int64, bool) {
+	for priority := int64(listenerRulePriorityMax); priority >= listenerRulePriorityMin; priority-- {
+		if !inUse[priority] {
+			return priority, true
+		}
+	}
+
+	return 0, false
+}
+
+func deleteListenerRule(ctx context.Context, conn *elbv2.ELBV2, arn string) error {
+	log.Printf("[DEBUG] Deleting ELBv2 Listener Rule: %s", arn)
+	_, err := conn.DeleteRuleWithContext(ctx, &elbv2.DeleteRuleInput{
+		RuleArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeRuleNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting rule (%s): %w", arn, err)
+	}
+
+	return nil
+}
+
+// FindListenerRulesByListenerARN returns the listener's non-default rules, ordered by priority.
+func FindListenerRulesByListenerARN(ctx context.Context, conn *elbv2.ELBV2, arn string) ([]*elbv2.Rule, error) {
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(arn),
+	}
+	var output []*elbv2.Rule
+
+	for {
+		page, err := conn.DescribeRulesWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeListenerNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range page.Rules {
+			if rule == nil || aws.BoolValue(rule.IsDefault) {
+				continue
+			}
+
+			output = append(output, rule)
+		}
+
+		if page.NextMarker == nil {
+			break
+		}
+
+		input.Marker = page.NextMarker
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		pi, _ := strconv.Atoi(aws.StringValue(output[i].Priority))
+		pj, _ := strconv.Atoi(aws.StringValue(output[j].Priority))
+		return pi < pj
+	})
+
+	return output, nil
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccELBV2ListenerRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rules []*elbv2.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rules.test"
+	listenerResourceName := "aws_lb_listener.test"
+	targetGroupResourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExists(ctx, resourceName, &rules),
+					resource.TestCheckResourceAttrPair(resourceName, "id", listenerResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", listenerResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "rule.0.arn", "elasticloadbalancing", regexache.MustCompile(fmt.Sprintf(`listener-rule/app/%s/.+$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.type", "forward"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.action.0.target_group_arn", targetGroupResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.condition.*.path_pattern.0.values.*", "/static/*"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.0.type", "fixed-response"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.0.fixed_response.0.status_code", "404"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.1.condition.*.path_pattern.0.values.*", "/missing/*"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rules []*elbv2.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExists(ctx, resourceName, &rules),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelbv2.ResourceListenerRules(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerRules_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after []*elbv2.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccListenerRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.type", "fixed-response"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.fixed_response.0.status_code", "503"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.condition.*.path_pattern.0.values.*", "/maintenance/*"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.0.type", "forward"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.1.condition.*.path_pattern.0.values.*", "/static/*"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.priority", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.action.0.type", "redirect"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.2.condition.*.host_header.0.values.*", "old.example.com"),
+				),
+			},
+			{
+				Config: testAccListenerRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRulesExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.type", "forward"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.0.type", "fixed-response"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckListenerRulesExists(ctx context.Context, n string, v *[]*elbv2.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn(ctx)
+
+		output, err := tfelbv2.FindListenerRulesByListenerARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckListenerRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lb_listener_rules" {
+				continue
+			}
+
+			output, err := tfelbv2.FindListenerRulesByListenerARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("ELBv2 Listener Rules %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccListenerRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_baseWithListener(rName), `
+resource "aws_lb_listener_rules" "test" {
+  listener_arn = aws_lb_listener.test.arn
+
+  rule {
+    action {
+      type             = "forward"
+      target_group_arn = aws_lb_target_group.test.arn
+    }
+
+    condition {
+      path_pattern {
+        values = ["/static/*"]
+      }
+    }
+  }
+
+  rule {
+    action {
+      type = "fixed-response"
+
+      fixed_response {
+        content_type = "text/plain"
+        message_body = "Not Found"
+        status_code  = "404"
+      }
+    }
+
+    condition {
+      path_pattern {
+        values = ["/missing/*"]
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccListenerRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_baseWithListener(rName), `
+resource "aws_lb_listener_rules" "test" {
+  listener_arn = aws_lb_listener.test.arn
+
+  rule {
+    action {
+      type = "fixed-response"
+
+      fixed_response {
+        content_type = "text/plain"
+        message_body = "Down for maintenance"
+        status_code  = "503"
+      }
+    }
+
+    condition {
+      path_pattern {
+        values = ["/maintenance/*"]
+      }
+    }
+  }
+
+  rule {
+    action {
+      type             = "forward"
+      target_group_arn = aws_lb_target_group.test.arn
+    }
+
+    condition {
+      path_pattern {
+        values = ["/static/*"]
+      }
+    }
+  }
+
+  rule {
+    action {
+      type = "redirect"
+
+      redirect {
+        host        = "new.example.com"
+        status_code = "HTTP_301"
+      }
+    }
+
+    condition {
+      host_header {
+        values = ["old.example.com"]
+      }
+    }
+  }
+}
+`)
+}
@@ -130,6 +130,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceListenerRules,
+			TypeName: "aws_lb_listener_rules",
+			Name:     "Listener Rules",
+		},
 		{
 			Factory:  ResourceTargetGroup,
 			TypeName: "aws_lb_target_group",
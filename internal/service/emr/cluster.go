@@ -19,7 +19,9 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -48,7 +50,10 @@ func ResourceCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffConfigurationsJSON,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"additional_info": {
@@ -128,7 +133,6 @@ func ResourceCluster() *schema.Resource {
 			"configurations_json": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 				StateFunc: func(v interface{}) string {
@@ -1125,7 +1129,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if _, ok := d.GetOk("configurations_json"); ok {
-		configOut, err := flattenConfigurationJSON(cluster.Configurations)
+		configurations := cluster.Configurations
+
+		// Reconfiguring a running cluster updates the instance groups' configurations but not the cluster's.
+		if masterGroup := findMasterGroup(instanceGroups); masterGroup != nil && aws.Int64Value(masterGroup.ConfigurationsVersion) > 0 {
+			configurations = masterGroup.Configurations
+		}
+
+		configOut, err := flattenConfigurationJSON(configurations)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "reading EMR cluster configurations: %s", err)
 		}
@@ -1401,6 +1412,22 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("configurations_json") {
+		info, err := structure.NormalizeJsonString(d.Get("configurations_json"))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "configurations_json contains an invalid JSON: %v", err)
+		}
+
+		configurations, err := expandConfigurationJSON(info)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EMR configurations_json: %s", err)
+		}
+
+		if err := reconfigureClusterInstanceGroups(ctx, conn, d.Id(), configurations); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Cluster (%s): reconfiguring instance groups: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("step_concurrency_level") {
 		_, err := conn.ModifyClusterWithContext(ctx, &emr.ModifyClusterInput{
 			ClusterId:            aws.String(d.Id()),
@@ -1414,6 +1441,100 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// minReconfigurationReleaseVersion is the earliest Amazon EMR release whose running clusters can be reconfigured.
+var minReconfigurationReleaseVersion = gversion.Must(gversion.NewVersion("5.21.0"))
+
+// customizeDiffConfigurationsJSON forces replacement when configurations_json changes on a cluster
+// whose release doesn't support reconfiguration, or that uses instance fleets, as only instance groups can be reconfigured in place,
+// or when configurations_json is removed, as a reconfiguration can't remove all configurations.
+func customizeDiffConfigurationsJSON(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("configurations_json") {
+		return nil
+	}
+
+	if !releaseLabelSupportsReconfiguration(diff.Get("release_label").(string)) {
+		return diff.ForceNew("configurations_json")
+	}
+
+	if diff.Get("master_instance_fleet.#").(int) > 0 || diff.Get("core_instance_fleet.#").(int) > 0 {
+		return diff.ForceNew("configurations_json")
+	}
+
+	if diff.NewValueKnown("configurations_json") && diff.Get("configurations_json").(string) == "" {
+		return diff.ForceNew("configurations_json")
+	}
+
+	return nil
+}
+
+// releaseLabelSupportsReconfiguration returns whether running clusters of the specified Amazon EMR release,
+// e.g. "emr-5.21.0", can be reconfigured. Labels that aren't versioned releases are assumed to support it.
+func releaseLabelSupportsReconfiguration(releaseLabel string) bool {
+	v, err := gversion.NewVersion(strings.TrimPrefix(releaseLabel, "emr-"))
+
+	if err != nil {
+		return true
+	}
+
+	return !v.LessThan(minReconfigurationReleaseVersion)
+}
+
+// reconfigureClusterInstanceGroups applies the specified configurations to a running cluster's master and core instance groups
+// and waits for the reconfiguration to complete. Task instance groups are managed by aws_emr_instance_group with their own configurations.
+func reconfigureClusterInstanceGroups(ctx context.Context, conn *emr.EMR, clusterID string, configurations []*emr.Configuration) error {
+	instanceGroups, err := fetchAllInstanceGroups(ctx, conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("listing instance groups: %w", err)
+	}
+
+	input := &emr.ModifyInstanceGroupsInput{
+		ClusterId: aws.String(clusterID),
+	}
+
+	for _, instanceGroup := range instanceGroups {
+		switch aws.StringValue(instanceGroup.InstanceGroupType) {
+		case emr.InstanceGroupTypeMaster, emr.InstanceGroupTypeCore:
+		default:
+			continue
+		}
+
+		if instanceGroup.Status != nil {
+			switch aws.StringValue(instanceGroup.Status.State) {
+			case emr.InstanceGroupStateEnded, emr.InstanceGroupStateShuttingDown, emr.InstanceGroupStateTerminated, emr.InstanceGroupStateTerminating:
+				continue
+			}
+		}
+
+		input.InstanceGroups = append(input.InstanceGroups, &emr.InstanceGroupModifyConfig{
+			Configurations:  configurations,
+			InstanceGroupId: instanceGroup.Id,
+		})
+	}
+
+	if len(input.InstanceGroups) == 0 {
+		return nil
+	}
+
+	if _, err := conn.ModifyInstanceGroupsWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	for _, v := range input.InstanceGroups {
+		instanceGroupID := aws.StringValue(v.InstanceGroupId)
+
+		if _, err := waitInstanceGroupReconfigured(ctx, conn, clusterID, instanceGroupID); err != nil {
+			return fmt.Errorf("waiting for Instance Group (%s) reconfiguration: %w", instanceGroupID, err)
+		}
+	}
+
+	if _, err := waitClusterReconfigured(ctx, conn, clusterID); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRConn(ctx)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -23,6 +24,32 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestReleaseLabelSupportsReconfiguration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"emr-5.20.1": false,
+		"emr-5.21.0": true,
+		"emr-5.36.1": true,
+		"emr-6.10.0": true,
+		"emr-4.9.6":  false,
+		"custom":     true,
+		"":           true,
+	}
+
+	for releaseLabel, want := range testCases {
+		releaseLabel, want := releaseLabel, want
+
+		t.Run(releaseLabel, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfemr.ReleaseLabelSupportsReconfiguration(releaseLabel); got != want {
+				t.Errorf("ReleaseLabelSupportsReconfiguration(%q) = %t, want %t", releaseLabel, got, want)
+			}
+		})
+	}
+}
+
 func TestAccEMRCluster_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster emr.Cluster
@@ -238,6 +265,95 @@ func TestAccEMRCluster_sJSON(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_configurationsJSONUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2, cluster3 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONSparkDefaults(rName, "2g"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"2g"`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONSparkDefaults(rName, "4g"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"4g"`)),
+				),
+			},
+			{
+				// Removing the configurations replaces the cluster.
+				Config: testAccClusterConfig_configurationsJSONSparkDefaults(rName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster3),
+					testAccCheckClusterRecreated(&cluster2, &cluster3),
+					resource.TestCheckResourceAttr(resourceName, "configurations_json", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRCluster_configurationsJSONUpdateTaskInstanceGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	instanceGroupResourceName := "aws_emr_instance_group.task"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONSparkDefaultsTaskInstanceGroup(rName, "2g"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestMatchResourceAttr(instanceGroupResourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"1g"`)),
+				),
+			},
+			{
+				// The task instance group keeps its own configurations.
+				Config: testAccClusterConfig_configurationsJSONSparkDefaultsTaskInstanceGroup(rName, "4g"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"4g"`)),
+					resource.TestMatchResourceAttr(instanceGroupResourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"1g"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_CoreInstanceGroup_autoScalingPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2, cluster3 emr.Cluster
@@ -2486,6 +2602,78 @@ EOF
 `, rName))
 }
 
+func testAccClusterConfig_configurationsJSONSparkDefaults(rName, executorMemory string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.33.1"
+  applications  = ["Spark"]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+
+  master_instance_group {
+    instance_type = "c4.large"
+  }
+
+  core_instance_group {
+    instance_count = 1
+    instance_type  = "c4.large"
+  }
+
+  keep_job_flow_alive_when_no_steps = true
+  termination_protection            = false
+
+  # An empty executor memory removes the configurations.
+  configurations_json = %[2]q == "" ? null : jsonencode([
+    {
+      Classification = "spark-defaults"
+      Properties = {
+        "spark.executor.memory" = %[2]q
+      }
+    }
+  ])
+
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  service_role = aws_iam_role.emr_service.arn
+}
+`, rName, executorMemory))
+}
+
+func testAccClusterConfig_configurationsJSONSparkDefaultsTaskInstanceGroup(rName, executorMemory string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_configurationsJSONSparkDefaults(rName, executorMemory),
+		`
+resource "aws_emr_instance_group" "task" {
+  cluster_id     = aws_emr_cluster.test.id
+  instance_count = 1
+  instance_type  = "c4.large"
+
+  configurations_json = jsonencode([
+    {
+      Classification = "spark-defaults"
+      Properties = {
+        "spark.executor.memory" = "1g"
+      }
+    }
+  ])
+}
+`)
+}
+
 func testAccClusterConfig_coreInstanceGroupAutoScalingPolicy(rName, autoscalingPolicy string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
@@ -5,6 +5,7 @@ package emr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +23,7 @@ func ResourceManagedScalingPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceManagedScalingPolicyCreate,
 		ReadWithoutTimeout:   resourceManagedScalingPolicyRead,
+		UpdateWithoutTimeout: resourceManagedScalingPolicyUpdate,
 		DeleteWithoutTimeout: resourceManagedScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -36,34 +38,28 @@ func ResourceManagedScalingPolicy() *schema.Resource {
 			"compute_limits": {
 				Type:     schema.TypeSet,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"unit_type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(emr.ComputeLimitsUnitType_Values(), false),
 						},
 						"minimum_capacity_units": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"maximum_capacity_units": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"maximum_core_capacity_units": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 						"maximum_ondemand_capacity_units": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -76,38 +72,14 @@ func resourceManagedScalingPolicyCreate(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRConn(ctx)
 
-	if l := d.Get("compute_limits").(*schema.Set).List(); len(l) > 0 && l[0] != nil {
-		cl := l[0].(map[string]interface{})
-		computeLimits := &emr.ComputeLimits{
-			UnitType:             aws.String(cl["unit_type"].(string)),
-			MinimumCapacityUnits: aws.Int64(int64(cl["minimum_capacity_units"].(int))),
-			MaximumCapacityUnits: aws.Int64(int64(cl["maximum_capacity_units"].(int))),
-		}
-		if v, ok := cl["maximum_core_capacity_units"].(int); ok && v > 0 {
-			computeLimits.MaximumCoreCapacityUnits = aws.Int64(int64(v))
+	clusterID := d.Get("cluster_id").(string)
 
-			if v, ok := cl["maximum_ondemand_capacity_units"].(int); ok && v > 0 {
-				computeLimits.MaximumOnDemandCapacityUnits = aws.Int64(int64(v))
-			}
-		} else if v, ok := cl["maximum_ondemand_capacity_units"].(int); ok && v >= 0 {
-			computeLimits.MaximumOnDemandCapacityUnits = aws.Int64(int64(v))
-		}
-		managedScalingPolicy := &emr.ManagedScalingPolicy{
-			ComputeLimits: computeLimits,
-		}
-
-		_, err := conn.PutManagedScalingPolicyWithContext(ctx, &emr.PutManagedScalingPolicyInput{
-			ClusterId:            aws.String(d.Get("cluster_id").(string)),
-			ManagedScalingPolicy: managedScalingPolicy,
-		})
-
-		if err != nil {
-			log.Printf("[ERROR] EMR.PutManagedScalingPolicy %s", err)
-			return sdkdiag.AppendErrorf(diags, "putting EMR Managed Scaling Policy: %s", err)
-		}
+	if err := putManagedScalingPolicy(ctx, conn, clusterID, d.Get("compute_limits").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting EMR Managed Scaling Policy (%s): %s", clusterID, err)
 	}
 
-	d.SetId(d.Get("cluster_id").(string))
+	d.SetId(clusterID)
+
 	return diags
 }
 
@@ -151,6 +123,19 @@ func resourceManagedScalingPolicyRead(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func resourceManagedScalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	if d.HasChange("compute_limits") {
+		if err := putManagedScalingPolicy(ctx, conn, d.Id(), d.Get("compute_limits").(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Managed Scaling Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceManagedScalingPolicyRead(ctx, d, meta)...)
+}
+
 func resourceManagedScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRConn(ctx)
@@ -176,6 +161,43 @@ func resourceManagedScalingPolicyDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+// putManagedScalingPolicy creates or replaces a cluster's managed scaling policy.
+// The cluster must not be starting or bootstrapping, so wait for it to be ready first.
+func putManagedScalingPolicy(ctx context.Context, conn *emr.EMR, clusterID string, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	if _, err := waitClusterReconfigured(ctx, conn, clusterID); err != nil {
+		return fmt.Errorf("waiting for EMR Cluster (%s) to be ready: %w", clusterID, err)
+	}
+
+	cl := tfList[0].(map[string]interface{})
+	computeLimits := &emr.ComputeLimits{
+		UnitType:             aws.String(cl["unit_type"].(string)),
+		MinimumCapacityUnits: aws.Int64(int64(cl["minimum_capacity_units"].(int))),
+		MaximumCapacityUnits: aws.Int64(int64(cl["maximum_capacity_units"].(int))),
+	}
+	if v, ok := cl["maximum_core_capacity_units"].(int); ok && v > 0 {
+		computeLimits.MaximumCoreCapacityUnits = aws.Int64(int64(v))
+
+		if v, ok := cl["maximum_ondemand_capacity_units"].(int); ok && v > 0 {
+			computeLimits.MaximumOnDemandCapacityUnits = aws.Int64(int64(v))
+		}
+	} else if v, ok := cl["maximum_ondemand_capacity_units"].(int); ok && v >= 0 {
+		computeLimits.MaximumOnDemandCapacityUnits = aws.Int64(int64(v))
+	}
+
+	_, err := conn.PutManagedScalingPolicyWithContext(ctx, &emr.PutManagedScalingPolicyInput{
+		ClusterId: aws.String(clusterID),
+		ManagedScalingPolicy: &emr.ManagedScalingPolicy{
+			ComputeLimits: computeLimits,
+		},
+	})
+
+	return err
+}
+
 func flattenComputeLimits(apiObject *emr.ComputeLimits) []interface{} {
 	if apiObject == nil {
 		return nil
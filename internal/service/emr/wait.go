@@ -5,6 +5,7 @@ package emr
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -22,6 +23,10 @@ const (
 	ClusterDeletedTimeout    = 20 * time.Minute
 	ClusterDeletedMinTimeout = 10 * time.Second
 	ClusterDeletedDelay      = 30 * time.Second
+
+	ClusterReconfiguredTimeout    = 60 * time.Minute
+	ClusterReconfiguredMinTimeout = 10 * time.Second
+	ClusterReconfiguredDelay      = 30 * time.Second
 )
 
 func waitClusterCreated(ctx context.Context, conn *emr.EMR, id string) (*emr.Cluster, error) {
@@ -69,3 +74,53 @@ func waitClusterDeleted(ctx context.Context, conn *emr.EMR, id string) (*emr.Clu
 
 	return nil, err
 }
+
+func waitClusterReconfigured(ctx context.Context, conn *emr.EMR, id string) (*emr.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{emr.ClusterStateBootstrapping, emr.ClusterStateStarting},
+		Target:     []string{emr.ClusterStateRunning, emr.ClusterStateWaiting},
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    ClusterReconfiguredTimeout,
+		MinTimeout: ClusterReconfiguredMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emr.Cluster); ok {
+		if stateChangeReason := output.Status.StateChangeReason; stateChangeReason != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateChangeReason.Code), aws.StringValue(stateChangeReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInstanceGroupReconfigured(ctx context.Context, conn *emr.EMR, clusterID, instanceGroupID string) (*emr.InstanceGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{emr.InstanceGroupStateReconfiguring, emr.InstanceGroupStateResizing},
+		Target:     []string{emr.InstanceGroupStateRunning},
+		Refresh:    instanceGroupStateRefresh(ctx, conn, clusterID, instanceGroupID),
+		Timeout:    ClusterReconfiguredTimeout,
+		MinTimeout: ClusterReconfiguredMinTimeout,
+		Delay:      ClusterReconfiguredDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emr.InstanceGroup); ok {
+		// A failed reconfiguration is rolled back and the instance group returns to RUNNING.
+		if err == nil && aws.Int64Value(output.LastSuccessfullyAppliedConfigurationsVersion) != aws.Int64Value(output.ConfigurationsVersion) {
+			err = errors.New("reconfiguration failed and was rolled back")
+
+			if stateChangeReason := output.Status.StateChangeReason; stateChangeReason != nil {
+				err = fmt.Errorf("%w: %s: %s", err, aws.StringValue(stateChangeReason.Code), aws.StringValue(stateChangeReason.Message))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,617 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_matching_workflow", name="Matching Workflow")
+// @Tags(identifierAttribute="arn")
+func ResourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IncrementalRunType_Values(), false),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 750,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"intermediate_source_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"intermediate_s3_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"resolution_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.ResolutionType_Values(), false),
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"match_purpose": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.MatchPurpose_Values(), false),
+									},
+									"rules": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 15,
+										Elem:     ruleSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(validNamePattern, "must contain only alphanumeric, hyphen or underscore characters"),
+				),
+			},
+		},
+	}
+}
+
+func ruleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"matching_keys": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 15,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:  expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig: expandOutputSources(d.Get("output_source_config").([]interface{})),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		Tags:               getTagsIn(ctx),
+		WorkflowName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("resolution_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResolutionTechniques = expandResolutionTechniques(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateMatchingWorkflowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Matching Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkflowName))
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	output, err := FindMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("description", output.Description)
+	if output.IncrementalRunConfig != nil {
+		if err := d.Set("incremental_run_config", []interface{}{flattenIncrementalRunConfig(output.IncrementalRunConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting incremental_run_config: %s", err)
+		}
+	} else {
+		d.Set("incremental_run_config", nil)
+	}
+	if err := d.Set("input_source_config", flattenInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	if output.ResolutionTechniques != nil {
+		if err := d.Set("resolution_techniques", []interface{}{flattenResolutionTechniques(output.ResolutionTechniques)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resolution_techniques: %s", err)
+		}
+	} else {
+		d.Set("resolution_techniques", nil)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateMatchingWorkflowInput{
+			Description:        aws.String(d.Get("description").(string)),
+			InputSourceConfig:  expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig: expandOutputSources(d.Get("output_source_config").([]interface{})),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+			WorkflowName:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("resolution_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ResolutionTechniques = expandResolutionTechniques(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateMatchingWorkflowWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Matching Workflow: %s", d.Id())
+	_, err := conn.DeleteMatchingWorkflowWithContext(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindMatchingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIncrementalRunConfig(tfMap map[string]interface{}) *entityresolution.IncrementalRunConfig {
+	return &entityresolution.IncrementalRunConfig{
+		IncrementalRunType: aws.String(tfMap["incremental_run_type"].(string)),
+	}
+}
+
+func expandInputSources(tfList []interface{}) []*entityresolution.InputSource {
+	var apiObjects []*entityresolution.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.InputSource{
+			InputSourceARN: aws.String(tfMap["input_source_arn"].(string)),
+			SchemaName:     aws.String(tfMap["schema_name"].(string)),
+		}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []*entityresolution.OutputSource {
+	var apiObjects []*entityresolution.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputSource{
+			OutputS3Path: aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		for _, tfMapRaw := range tfMap["output"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Output = append(apiObject.Output, &entityresolution.OutputAttribute{
+				Hashed: aws.Bool(tfMap["hashed"].(bool)),
+				Name:   aws.String(tfMap["name"].(string)),
+			})
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfMap map[string]interface{}) *entityresolution.ResolutionTechniques {
+	apiObject := &entityresolution.ResolutionTechniques{
+		ResolutionType: aws.String(tfMap["resolution_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ProviderProperties = &entityresolution.ProviderProperties{
+			ProviderServiceArn: aws.String(tfMap["provider_service_arn"].(string)),
+		}
+
+		if v, ok := tfMap["intermediate_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ProviderProperties.IntermediateSourceConfiguration = &entityresolution.IntermediateSourceConfiguration{
+				IntermediateS3Path: aws.String(v[0].(map[string]interface{})["intermediate_s3_path"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RuleBasedProperties = &entityresolution.RuleBasedProperties{
+			AttributeMatchingModel: aws.String(tfMap["attribute_matching_model"].(string)),
+			Rules:                  expandRules(tfMap["rules"].([]interface{})),
+		}
+
+		if v, ok := tfMap["match_purpose"].(string); ok && v != "" {
+			apiObject.RuleBasedProperties.MatchPurpose = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandRules(tfList []interface{}) []*entityresolution.Rule {
+	var apiObjects []*entityresolution.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.Rule{
+			MatchingKeys: flex.ExpandStringList(tfMap["matching_keys"].([]interface{})),
+			RuleName:     aws.String(tfMap["rule_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenIncrementalRunConfig(apiObject *entityresolution.IncrementalRunConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"incremental_run_type": aws.StringValue(apiObject.IncrementalRunType),
+	}
+}
+
+func flattenInputSources(apiObjects []*entityresolution.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":         aws.StringValue(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []*entityresolution.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var output []interface{}
+		for _, v := range apiObject.Output {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, map[string]interface{}{
+				"hashed": aws.BoolValue(v.Hashed),
+				"name":   aws.StringValue(v.Name),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"kms_arn":             aws.StringValue(apiObject.KMSArn),
+			"output":              output,
+			"output_s3_path":      aws.StringValue(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *entityresolution.ResolutionTechniques) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"resolution_type": aws.StringValue(apiObject.ResolutionType),
+	}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		tfMapProvider := map[string]interface{}{
+			"provider_service_arn": aws.StringValue(v.ProviderServiceArn),
+		}
+
+		if v := v.IntermediateSourceConfiguration; v != nil {
+			tfMapProvider["intermediate_source_configuration"] = []interface{}{map[string]interface{}{
+				"intermediate_s3_path": aws.StringValue(v.IntermediateS3Path),
+			}}
+		}
+
+		tfMap["provider_properties"] = []interface{}{tfMapProvider}
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = []interface{}{map[string]interface{}{
+			"attribute_matching_model": aws.StringValue(v.AttributeMatchingModel),
+			"match_purpose":            aws.StringValue(v.MatchPurpose),
+			"rules":                    flattenRules(v.Rules),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenRules(apiObjects []*entityresolution.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"matching_keys": aws.StringValueSlice(apiObject.MatchingKeys),
+			"rule_name":     aws.StringValue(apiObject.RuleName),
+		})
+	}
+
+	return tfList
+}
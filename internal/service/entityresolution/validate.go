@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"github.com/YakDriver/regexache"
+)
+
+var validNamePattern = regexache.MustCompile(`^[0-9A-Za-z_-]+$`)
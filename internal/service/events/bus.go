@@ -6,16 +6,23 @@ package events
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	tfschemas "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,18 +47,50 @@ func ResourceBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dead_letter_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
 			"event_source_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validSourceName,
 			},
+			"kms_key_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validCustomEventBusName,
 			},
+			"schema_discoverer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_discovery_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -70,10 +109,22 @@ func resourceBusCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		Tags: getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 {
+		input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("event_source_name"); ok {
 		input.EventSourceName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("kms_key_identifier"); ok {
+		input.KmsKeyIdentifier = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateEventBusWithContext(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -103,6 +154,16 @@ func resourceBusCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.Get("schema_discovery_enabled").(bool) {
+		discovererID, err := enableBusSchemaDiscovery(ctx, meta.(*conns.AWSClient).SchemasConn(ctx), aws.StringValue(output.EventBusArn), "")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "enabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+		}
+
+		d.Set("schema_discoverer_id", discovererID)
+	}
+
 	return append(diags, resourceBusRead(ctx, d, meta)...)
 }
 
@@ -123,15 +184,85 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	d.Set("arn", output.Arn)
+	if output.DeadLetterConfig != nil && output.DeadLetterConfig.Arn != nil {
+		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(output.DeadLetterConfig)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting dead_letter_config: %s", err)
+		}
+	} else {
+		d.Set("dead_letter_config", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("kms_key_identifier", busKMSKeyIdentifier(ctx, meta.(*conns.AWSClient).KMSConn(ctx), d.Get("kms_key_identifier").(string), aws.StringValue(output.KmsKeyIdentifier)))
 	d.Set("name", output.Name)
 
+	// Only the schema discoverer created by this resource is read, so that discoverers managed elsewhere are ignored
+	// and buses not using schema discovery don't require Schemas permissions.
+	if discovererID := d.Get("schema_discoverer_id").(string); discovererID != "" {
+		discoverer, err := tfschemas.FindDiscovererByID(ctx, meta.(*conns.AWSClient).SchemasConn(ctx), discovererID)
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("schema_discoverer_id", "")
+			d.Set("schema_discovery_enabled", false)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EventBridge Event Bus (%s) schema discoverer (%s): %s", d.Id(), discovererID, err)
+		default:
+			d.Set("schema_discovery_enabled", aws.StringValue(discoverer.State) == schemas.DiscovererStateStarted)
+		}
+	} else {
+		d.Set("schema_discovery_enabled", false)
+	}
+
 	return diags
 }
 
 func resourceBusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsConn(ctx)
+
+	if d.HasChanges("dead_letter_config", "description", "kms_key_identifier") {
+		// Omitted values are reset, so send the complete configuration.
+		input := &eventbridge.UpdateEventBusInput{
+			DeadLetterConfig: &eventbridge.DeadLetterConfig{},
+			Description:      aws.String(d.Get("description").(string)),
+			Name:             aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 {
+			input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("kms_key_identifier"); ok {
+			input.KmsKeyIdentifier = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateEventBusWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EventBridge Event Bus (%s): %s", d.Id(), err)
+		}
+	}
 
-	// Tags only.
+	if d.HasChange("schema_discovery_enabled") {
+		schemasConn := meta.(*conns.AWSClient).SchemasConn(ctx)
+		discovererID := d.Get("schema_discoverer_id").(string)
+
+		if d.Get("schema_discovery_enabled").(bool) {
+			discovererID, err := enableBusSchemaDiscovery(ctx, schemasConn, d.Get("arn").(string), discovererID)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+			}
+
+			d.Set("schema_discoverer_id", discovererID)
+		} else if discovererID != "" {
+			if err := deleteBusSchemaDiscoverer(ctx, schemasConn, discovererID); err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+			}
+
+			d.Set("schema_discoverer_id", "")
+		}
+	}
 
 	return append(diags, resourceBusRead(ctx, d, meta)...)
 }
@@ -140,6 +271,12 @@ func resourceBusDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EventsConn(ctx)
 
+	if discovererID := d.Get("schema_discoverer_id").(string); discovererID != "" {
+		if err := deleteBusSchemaDiscoverer(ctx, meta.(*conns.AWSClient).SchemasConn(ctx), discovererID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EventBridge Event Bus (%s) schema discoverer (%s): %s", d.Id(), discovererID, err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting EventBridge Event Bus: %s", d.Id())
 	_, err := conn.DeleteEventBusWithContext(ctx, &eventbridge.DeleteEventBusInput{
 		Name: aws.String(d.Id()),
@@ -180,3 +317,77 @@ func FindEventBusByName(ctx context.Context, conn *eventbridge.EventBridge, name
 
 	return output, nil
 }
+
+// enableBusSchemaDiscovery starts the bus's schema discoverer with the specified ID,
+// or creates one if there is no such discoverer, and returns the discoverer's ID.
+// busKMSKeyIdentifier returns the configured KMS key identifier if it refers to the key returned by the API.
+// The API returns the key's ARN, whether the bus was configured with a key ID, key ARN, alias name or alias ARN.
+func busKMSKeyIdentifier(ctx context.Context, conn *kms.KMS, configured, keyARN string) string {
+	if configured == "" || keyARN == "" || configured == keyARN {
+		return keyARN
+	}
+
+	if v, err := arn.Parse(keyARN); err == nil && v.Resource == "key/"+configured {
+		return configured
+	}
+
+	if !strings.HasPrefix(configured, "alias/") && !strings.Contains(configured, ":alias/") {
+		return keyARN
+	}
+
+	key, err := tfkms.FindKeyByID(ctx, conn, configured)
+
+	if err != nil {
+		log.Printf("[WARN] resolving KMS alias (%s): %s", configured, err)
+
+		return keyARN
+	}
+
+	if aws.StringValue(key.Arn) == keyARN {
+		return configured
+	}
+
+	return keyARN
+}
+
+func enableBusSchemaDiscovery(ctx context.Context, conn *schemas.Schemas, arn, discovererID string) (string, error) {
+	if discovererID != "" {
+		discoverer, err := tfschemas.FindDiscovererByID(ctx, conn, discovererID)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return "", err
+		case aws.StringValue(discoverer.State) == schemas.DiscovererStateStarted:
+			return discovererID, nil
+		default:
+			_, err := conn.StartDiscovererWithContext(ctx, &schemas.StartDiscovererInput{
+				DiscovererId: aws.String(discovererID),
+			})
+
+			return discovererID, err
+		}
+	}
+
+	output, err := conn.CreateDiscovererWithContext(ctx, &schemas.CreateDiscovererInput{
+		SourceArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.DiscovererId), nil
+}
+
+func deleteBusSchemaDiscoverer(ctx context.Context, conn *schemas.Schemas, discovererID string) error {
+	_, err := conn.DeleteDiscovererWithContext(ctx, &schemas.DeleteDiscovererInput{
+		DiscovererId: aws.String(discovererID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	return err
+}
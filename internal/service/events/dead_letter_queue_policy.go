@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+const (
+	deadLetterQueueServicePrincipal = "events.amazonaws.com"
+	deadLetterQueueSendAction       = "sqs:SendMessage"
+)
+
+// newDeadLetterQueuePolicyDoc returns an SQS queue policy that allows EventBridge
+// to deliver failed events to the queue, optionally scoped to the specified rules.
+func newDeadLetterQueuePolicyDoc(queueARN string, sourceARNs []string) *tfiam.IAMPolicyDoc {
+	statement := &tfiam.IAMPolicyStatement{
+		Sid:    "AllowEventBridgeDeadLetterDelivery",
+		Effect: "Allow",
+		Principals: tfiam.IAMPolicyStatementPrincipalSet{
+			{Type: "Service", Identifiers: deadLetterQueueServicePrincipal},
+		},
+		Actions:   deadLetterQueueSendAction,
+		Resources: queueARN,
+	}
+
+	if len(sourceARNs) > 0 {
+		statement.Conditions = tfiam.IAMPolicyStatementConditionSet{
+			{Test: "ArnEquals", Variable: "aws:SourceArn", Values: sourceARNs},
+		}
+	}
+
+	return &tfiam.IAMPolicyDoc{
+		Version:    "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{statement},
+	}
+}
+
+// deadLetterQueuePolicyAllowsDelivery reports whether the specified SQS queue policy
+// grants EventBridge permission to send messages to the queue on behalf of the specified rule.
+// An empty rule ARN matches any aws:SourceArn condition.
+// Conditions on keys other than aws:SourceArn and aws:SourceAccount are assumed to be satisfied.
+// Allow statements with NotPrincipal, NotAction or NotResource elements can't be reliably evaluated and are assumed to grant access.
+func deadLetterQueuePolicyAllowsDelivery(policy, queueARN, ruleARN string) (bool, error) {
+	if policy == "" {
+		return false, nil
+	}
+
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	var ruleAccountID string
+	if ruleARN != "" {
+		if v, err := arn.Parse(ruleARN); err == nil {
+			ruleAccountID = v.AccountID
+		}
+	}
+
+	for _, statement := range doc.Statements {
+		if statement == nil || !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+
+		if statement.NotPrincipals != nil || statement.NotActions != nil || statement.NotResources != nil {
+			return true, nil
+		}
+
+		if !deadLetterQueuePolicyStatementMatchesPrincipal(statement.Principals) {
+			continue
+		}
+
+		if !policyValuesMatch(statement.Actions, deadLetterQueueSendAction, true) {
+			continue
+		}
+
+		if !policyValuesMatch(statement.Resources, queueARN, false) {
+			continue
+		}
+
+		if deadLetterQueuePolicyStatementMatchesConditions(statement.Conditions, ruleARN, ruleAccountID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func deadLetterQueuePolicyStatementMatchesPrincipal(principals tfiam.IAMPolicyStatementPrincipalSet) bool {
+	for _, principal := range principals {
+		switch principal.Type {
+		case "*":
+			return true
+		case "AWS":
+			if policyValuesMatch(principal.Identifiers, "*", false) {
+				return true
+			}
+		case "Service":
+			if policyValuesMatch(principal.Identifiers, deadLetterQueueServicePrincipal, false) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func deadLetterQueuePolicyStatementMatchesConditions(conditions tfiam.IAMPolicyStatementConditionSet, ruleARN, ruleAccountID string) bool {
+	for _, condition := range conditions {
+		var value string
+
+		switch strings.ToLower(condition.Variable) {
+		case "aws:sourcearn":
+			value = ruleARN
+		case "aws:sourceaccount":
+			value = ruleAccountID
+		default:
+			continue
+		}
+
+		// The value isn't known, so the condition can't be evaluated.
+		if value == "" {
+			continue
+		}
+
+		var matches bool
+		switch test := strings.TrimSuffix(condition.Test, "IfExists"); test {
+		case "ArnEquals", "ArnLike", "StringLike":
+			matches = policyValuesMatch(condition.Values, value, false)
+		case "StringEquals":
+			matches = policyValuesEqual(condition.Values, value)
+		case "ArnNotEquals", "ArnNotLike", "StringNotLike":
+			matches = !policyValuesMatch(condition.Values, value, false)
+		case "StringNotEquals":
+			matches = !policyValuesEqual(condition.Values, value)
+		default:
+			continue
+		}
+
+		if !matches {
+			return false
+		}
+	}
+
+	return true
+}
+
+// policyValuesMatch reports whether any of the policy values, which may contain the
+// '*' and '?' wildcards, match the specified value.
+func policyValuesMatch(values interface{}, value string, caseInsensitive bool) bool {
+	for _, v := range policyValuesToSlice(values) {
+		pattern := "^" + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(v)) + "$"
+		if caseInsensitive {
+			pattern = "(?i)" + pattern
+		}
+
+		if regexp.MustCompile(pattern).MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyValuesEqual(values interface{}, value string) bool {
+	for _, v := range policyValuesToSlice(values) {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyValuesToSlice(values interface{}) []string {
+	switch v := values.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// ruleARN returns the ARN of the specified EventBridge rule.
+func ruleARN(partition, region, accountID, busName, ruleName string) string {
+	resource := "rule/"
+
+	if v, err := arn.Parse(busName); err == nil {
+		region, accountID = v.Region, v.AccountID
+		busName = strings.TrimPrefix(v.Resource, "event-bus/")
+	}
+
+	if busName != "" && busName != DefaultEventBusName {
+		resource += busName + "/"
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   "events",
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource + ruleName,
+	}.String()
+}
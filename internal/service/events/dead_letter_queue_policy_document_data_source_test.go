@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsDeadLetterQueuePolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_dead_letter_queue_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeadLetterQueuePolicyDocumentDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowEventBridgeDeadLetterDelivery",
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq"
+  }]
+}`), //lintignore:AWSAT003,AWSAT005
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsDeadLetterQueuePolicyDocumentDataSource_ruleARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_dead_letter_queue_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeadLetterQueuePolicyDocumentDataSourceConfig_ruleARNs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "json", "data.aws_iam_policy_document.expected", "json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeadLetterQueuePolicyDocumentDataSourceConfig_basic() string {
+	return `
+data "aws_cloudwatch_event_dead_letter_queue_policy_document" "test" {
+  queue_arn = "arn:aws:sqs:us-west-2:123456789012:dlq" #lintignore:AWSAT003,AWSAT005
+}
+`
+}
+
+func testAccDeadLetterQueuePolicyDocumentDataSourceConfig_ruleARNs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_event_dead_letter_queue_policy_document" "test" {
+  queue_arn = aws_sqs_queue.test.arn
+  rule_arns = [aws_cloudwatch_event_rule.test.arn]
+}
+
+data "aws_iam_policy_document" "expected" {
+  statement {
+    sid       = "AllowEventBridgeDeadLetterDelivery"
+    effect    = "Allow"
+    actions   = ["sqs:SendMessage"]
+    resources = [aws_sqs_queue.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["events.amazonaws.com"]
+    }
+
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_cloudwatch_event_rule.test.arn]
+    }
+  }
+}
+`, rName)
+}
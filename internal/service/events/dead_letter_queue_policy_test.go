@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"testing"
+)
+
+func TestDeadLetterQueuePolicyAllowsDelivery(t *testing.T) {
+	t.Parallel()
+
+	const (
+		queueARN = "arn:aws:sqs:us-west-2:123456789012:dlq"               //lintignore:AWSAT003,AWSAT005
+		ruleARN  = "arn:aws:events:us-west-2:123456789012:rule/test-rule" //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		policy   string
+		ruleARN  string
+		expected bool
+	}{
+		"empty policy": {
+			policy:   "",
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"service principal": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: true,
+		},
+		"service principal list and action wildcard": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": ["lambda.amazonaws.com", "events.amazonaws.com"]},
+    "Action": ["sqs:Send*"],
+    "Resource": "*"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: true,
+		},
+		"wrong principal": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "sns.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"wrong action": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:ReceiveMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"wrong resource": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:other"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"deny": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Deny",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"source ARN matches": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq",
+    "Condition": {"ArnEquals": {"aws:SourceArn": "arn:aws:events:us-west-2:123456789012:rule/test-rule"}}
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: true,
+		},
+		"source ARN wildcard matches": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq",
+    "Condition": {"ArnLike": {"aws:SourceArn": "arn:aws:events:us-west-2:123456789012:rule/*"}}
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: true,
+		},
+		"source ARN does not match": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq",
+    "Condition": {"ArnEquals": {"aws:SourceArn": "arn:aws:events:us-west-2:123456789012:rule/other-rule"}}
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"source ARN unknown": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq",
+    "Condition": {"ArnEquals": {"aws:SourceArn": "arn:aws:events:us-west-2:123456789012:rule/other-rule"}}
+  }]
+}`,
+			ruleARN:  "",
+			expected: true,
+		},
+		"source account does not match": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq",
+    "Condition": {"StringEquals": {"aws:SourceAccount": "210987654321"}}
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: false,
+		},
+		"not action": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "NotAction": "sqs:DeleteQueue",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:dlq"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: true,
+		},
+		"not resource": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "NotResource": "arn:aws:sqs:us-west-2:123456789012:other"
+  }]
+}`,
+			ruleARN:  ruleARN,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := deadLetterQueuePolicyAllowsDelivery(testCase.policy, queueARN, testCase.ruleARN)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRuleARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		busName  string
+		expected string
+	}{
+		"default bus": {
+			busName:  DefaultEventBusName,
+			expected: "arn:aws:events:us-west-2:123456789012:rule/test-rule", //lintignore:AWSAT003,AWSAT005
+		},
+		"custom bus": {
+			busName:  "custom",
+			expected: "arn:aws:events:us-west-2:123456789012:rule/custom/test-rule", //lintignore:AWSAT003,AWSAT005
+		},
+		"bus ARN": {
+			busName:  "arn:aws:events:us-east-1:210987654321:event-bus/custom",      //lintignore:AWSAT003,AWSAT005
+			expected: "arn:aws:events:us-east-1:210987654321:rule/custom/test-rule", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ruleARN("aws", "us-west-2", "123456789012", testCase.busName, "test-rule"); got != testCase.expected { //lintignore:AWSAT003
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceConnection,
 			TypeName: "aws_cloudwatch_event_connection",
 		},
+		{
+			Factory:  DataSourceDeadLetterQueuePolicyDocument,
+			TypeName: "aws_cloudwatch_event_dead_letter_queue_policy_document",
+		},
 		{
 			Factory:  DataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
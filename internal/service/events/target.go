@@ -11,8 +11,10 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	multierror "github.com/hashicorp/go-multierror"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -39,6 +42,8 @@ func ResourceTarget() *schema.Resource {
 			StateContext: resourceTargetImport,
 		},
 
+		CustomizeDiff: resourceTargetCustomizeDiff,
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -469,6 +474,90 @@ func ResourceTarget() *schema.Resource {
 	}
 }
 
+func resourceTargetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("dead_letter_config.0.arn") {
+		return nil
+	}
+
+	queueARN := d.Get("dead_letter_config.0.arn").(string)
+
+	if queueARN == "" {
+		return nil
+	}
+
+	if parsedARN, err := arn.Parse(queueARN); err == nil && parsedARN.Service != sqs.ServiceName {
+		return fmt.Errorf("dead_letter_config.0.arn (%s) must be the ARN of an Amazon SQS queue", queueARN)
+	}
+
+	return nil
+}
+
+// deadLetterQueuePolicyWarnings warns when the dead-letter queue's policy doesn't allow EventBridge to deliver failed events to it.
+// Without the correct queue policy, events that can't be delivered to the target are silently dropped.
+// The queue policy may be managed in the same configuration, so this is only a warning.
+func deadLetterQueuePolicyWarnings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	queueARN := d.Get("dead_letter_config.0.arn").(string)
+
+	if queueARN == "" {
+		return diags
+	}
+
+	parsedARN, err := arn.Parse(queueARN)
+
+	if err != nil {
+		return diags
+	}
+
+	awsClient := meta.(*conns.AWSClient)
+
+	// The queue policy can only be read with this provider's credentials in its own Region.
+	if parsedARN.Region != awsClient.Region {
+		return diags
+	}
+
+	conn := awsClient.SQSConn(ctx)
+
+	output, err := conn.GetQueueUrlWithContext(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              aws.String(parsedARN.Resource),
+		QueueOwnerAWSAccountId: aws.String(parsedARN.AccountID),
+	})
+
+	if err != nil {
+		// The queue may be owned by an account whose queue we can't inspect.
+		log.Printf("[WARN] Unable to check EventBridge Target dead-letter queue (%s) policy: %s", queueARN, err)
+		return diags
+	}
+
+	policy, err := tfsqs.FindQueueAttributeByURL(ctx, conn, aws.StringValue(output.QueueUrl), sqs.QueueAttributeNamePolicy)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to check EventBridge Target dead-letter queue (%s) policy: %s", queueARN, err)
+		return diags
+	}
+
+	allowed, err := deadLetterQueuePolicyAllowsDelivery(policy, queueARN, ruleARN(awsClient.Partition, awsClient.Region, awsClient.AccountID, d.Get("event_bus_name").(string), d.Get("rule").(string)))
+
+	if err != nil {
+		log.Printf("[WARN] Unable to check EventBridge Target dead-letter queue (%s) policy: %s", queueARN, err)
+		return diags
+	}
+
+	if !allowed {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Dead-letter queue policy does not allow EventBridge delivery",
+			Detail: fmt.Sprintf("The policy of dead-letter queue (%s) does not allow EventBridge (%s) to send messages for this rule, so undeliverable events would be lost. "+
+				"Grant %s using the aws_cloudwatch_event_dead_letter_queue_policy_document data source. "+
+				"If the queue policy is managed in this configuration, add it to the target's depends_on.", queueARN, deadLetterQueueServicePrincipal, deadLetterQueueSendAction),
+			AttributePath: cty.GetAttrPath("dead_letter_config").IndexInt(0).GetAttr("arn"),
+		})
+	}
+
+	return diags
+}
+
 func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EventsConn(ctx)
 
@@ -502,7 +591,9 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(id)
 
-	return resourceTargetRead(ctx, d, meta)
+	diags := deadLetterQueuePolicyWarnings(ctx, d, meta)
+
+	return append(diags, resourceTargetRead(ctx, d, meta)...)
 }
 
 func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -616,7 +707,13 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("updating EventBridge Target (%s): %s", d.Id(), err)
 	}
 
-	return resourceTargetRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	if d.HasChange("dead_letter_config") {
+		diags = deadLetterQueuePolicyWarnings(ctx, d, meta)
+	}
+
+	return append(diags, resourceTargetRead(ctx, d, meta)...)
 }
 
 func resourceTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -247,6 +247,52 @@ func TestAccEventsTarget_RetryPolicy_deadLetter(t *testing.T) {
 	})
 }
 
+func TestAccEventsTarget_RetryPolicy_deadLetterQueuePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v eventbridge.Target
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_target.test"
+	queueResourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_deadLetterQueuePolicy(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", "0"),
+				),
+			},
+			{
+				// The missing queue policy is only reported as a warning.
+				Config: testAccTargetConfig_deadLetterQueuePolicy(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", queueResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccTargetConfig_deadLetterQueuePolicy(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccTargetConfig_deadLetterQueuePolicy(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", queueResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEventsTarget_full(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v eventbridge.Target
@@ -1099,6 +1145,56 @@ resource "aws_sns_topic" "test" {
 `, rName)
 }
 
+func testAccTargetConfig_deadLetterQueuePolicy(rName string, queuePolicy, deadLetterConfig bool) string {
+	var policy string
+	if queuePolicy {
+		policy = `
+data "aws_cloudwatch_event_dead_letter_queue_policy_document" "test" {
+  queue_arn = aws_sqs_queue.test.arn
+  rule_arns = [aws_cloudwatch_event_rule.test.arn]
+}
+
+resource "aws_sqs_queue_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+  policy    = data.aws_cloudwatch_event_dead_letter_queue_policy_document.test.json
+}
+`
+	}
+
+	var dlc string
+	if deadLetterConfig {
+		dlc = `
+  dead_letter_config {
+    arn = aws_sqs_queue.test.arn
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  sqs_managed_sse_enabled = true
+}
+%[2]s
+resource "aws_cloudwatch_event_target" "test" {
+  rule      = aws_cloudwatch_event_rule.test.name
+  target_id = %[1]q
+  arn       = aws_sns_topic.test.arn
+%[3]s
+}
+`, rName, policy, dlc)
+}
+
 func testAccTargetConfig_defaultBusName(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -35,6 +35,8 @@ const (
 	destinationTypeRedshift      = "redshift"
 	destinationTypeSplunk        = "splunk"
 	destinationTypeHTTPEndpoint  = "http_endpoint"
+	destinationTypeIceberg       = "iceberg"
+	destinationTypeSnowflake     = "snowflake"
 )
 
 func cloudWatchLoggingOptionsSchema() *schema.Schema {
@@ -437,6 +439,132 @@ func flattenSplunkConfiguration(description *firehose.SplunkDestinationDescripti
 	return []map[string]interface{}{m}
 }
 
+func flattenIcebergConfiguration(description *firehose.IcebergDestinationDescription) []map[string]interface{} {
+	if description == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"cloudwatch_logging_options":      flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
+		"destination_table_configuration": flattenDestinationTableConfigurations(description.DestinationTableConfigurationList),
+		"processing_configuration":        flattenProcessingConfiguration(description.ProcessingConfiguration, aws.StringValue(description.RoleARN)),
+		"role_arn":                        aws.StringValue(description.RoleARN),
+		"s3_backup_mode":                  aws.StringValue(description.S3BackupMode),
+		"s3_configuration":                flattenS3Configuration(description.S3DestinationDescription),
+	}
+
+	if description.CatalogConfiguration != nil {
+		m["catalog_arn"] = aws.StringValue(description.CatalogConfiguration.CatalogARN)
+	}
+
+	if description.BufferingHints != nil {
+		m["buffering_interval"] = int(aws.Int64Value(description.BufferingHints.IntervalInSeconds))
+		m["buffering_size"] = int(aws.Int64Value(description.BufferingHints.SizeInMBs))
+	}
+
+	if description.RetryOptions != nil {
+		m["retry_duration"] = int(aws.Int64Value(description.RetryOptions.DurationInSeconds))
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenDestinationTableConfigurations(apiObjects []*firehose.DestinationTableConfiguration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"database_name":          aws.StringValue(apiObject.DestinationDatabaseName),
+			"s3_error_output_prefix": aws.StringValue(apiObject.S3ErrorOutputPrefix),
+			"table_name":             aws.StringValue(apiObject.DestinationTableName),
+			"unique_keys":            aws.StringValueSlice(apiObject.UniqueKeys),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSnowflakeConfiguration(description *firehose.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string) []map[string]interface{} {
+	if description == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"account_url":                   aws.StringValue(description.AccountUrl),
+		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
+		"content_column_name":           aws.StringValue(description.ContentColumnName),
+		"data_loading_option":           aws.StringValue(description.DataLoadingOption),
+		"database":                      aws.StringValue(description.Database),
+		"key_passphrase":                configuredKeyPassphrase,
+		"metadata_column_name":          aws.StringValue(description.MetaDataColumnName),
+		"private_key":                   configuredPrivateKey,
+		"processing_configuration":      flattenProcessingConfiguration(description.ProcessingConfiguration, aws.StringValue(description.RoleARN)),
+		"role_arn":                      aws.StringValue(description.RoleARN),
+		"s3_backup_mode":                aws.StringValue(description.S3BackupMode),
+		"s3_configuration":              flattenS3Configuration(description.S3DestinationDescription),
+		"schema":                        aws.StringValue(description.Schema),
+		"secrets_manager_configuration": flattenSecretsManagerConfiguration(description.SecretsManagerConfiguration),
+		"snowflake_role_configuration":  flattenSnowflakeRoleConfiguration(description.SnowflakeRoleConfiguration),
+		"snowflake_vpc_configuration":   flattenSnowflakeVPCConfiguration(description.SnowflakeVpcConfiguration),
+		"table":                         aws.StringValue(description.Table),
+		"user":                          aws.StringValue(description.User),
+	}
+
+	if description.BufferingHints != nil {
+		m["buffering_interval"] = int(aws.Int64Value(description.BufferingHints.IntervalInSeconds))
+		m["buffering_size"] = int(aws.Int64Value(description.BufferingHints.SizeInMBs))
+	}
+
+	if description.RetryOptions != nil {
+		m["retry_duration"] = int(aws.Int64Value(description.RetryOptions.DurationInSeconds))
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSecretsManagerConfiguration(smc *firehose.SecretsManagerConfiguration) []map[string]interface{} {
+	if smc == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":    aws.BoolValue(smc.Enabled),
+		"role_arn":   aws.StringValue(smc.RoleARN),
+		"secret_arn": aws.StringValue(smc.SecretARN),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSnowflakeRoleConfiguration(src *firehose.SnowflakeRoleConfiguration) []map[string]interface{} {
+	if src == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":        aws.BoolValue(src.Enabled),
+		"snowflake_role": aws.StringValue(src.SnowflakeRole),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSnowflakeVPCConfiguration(svc *firehose.SnowflakeVpcConfiguration) []map[string]interface{} {
+	if svc == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"private_link_vpce_id": aws.StringValue(svc.PrivateLinkVpceId),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenS3Configuration(description *firehose.S3DestinationDescription) []map[string]interface{} {
 	if description == nil {
 		return []map[string]interface{}{}
@@ -848,6 +976,18 @@ func flattenDeliveryStream(d *schema.ResourceData, s *firehose.DeliveryStreamDes
 			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointConfiguration(destination.HttpEndpointDestinationDescription, configuredAccessKey)); err != nil {
 				return fmt.Errorf("setting http_endpoint_configuration: %s", err)
 			}
+		} else if destination.IcebergDestinationDescription != nil {
+			d.Set("destination", destinationTypeIceberg)
+			if err := d.Set("iceberg_configuration", flattenIcebergConfiguration(destination.IcebergDestinationDescription)); err != nil {
+				return fmt.Errorf("setting iceberg_configuration: %s", err)
+			}
+		} else if destination.SnowflakeDestinationDescription != nil {
+			d.Set("destination", destinationTypeSnowflake)
+			configuredKeyPassphrase := d.Get("snowflake_configuration.0.key_passphrase").(string)
+			configuredPrivateKey := d.Get("snowflake_configuration.0.private_key").(string)
+			if err := d.Set("snowflake_configuration", flattenSnowflakeConfiguration(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey)); err != nil {
+				return fmt.Errorf("setting snowflake_configuration: %s", err)
+			}
 		} else {
 			d.Set("destination", destinationTypeExtendedS3)
 			if err := d.Set("extended_s3_configuration", flattenExtendedS3Configuration(destination.ExtendedS3DestinationDescription)); err != nil {
@@ -1007,6 +1147,8 @@ func ResourceDeliveryStream() *schema.Resource {
 					destinationTypeOpensearch,
 					destinationTypeSplunk,
 					destinationTypeHTTPEndpoint,
+					destinationTypeIceberg,
+					destinationTypeSnowflake,
 				}, false),
 			},
 
@@ -1756,6 +1898,273 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			"iceberg_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"buffering_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(0, 900),
+						},
+
+						"buffering_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(1, 128),
+						},
+
+						"catalog_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
+
+						"destination_table_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"s3_error_output_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+
+									"table_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"unique_keys": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"processing_configuration": processingConfigurationSchema(),
+
+						"retry_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(0, 7200),
+						},
+
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+
+						"s3_backup_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      firehose.IcebergS3BackupModeFailedDataOnly,
+							ValidateFunc: validation.StringInSlice(firehose.IcebergS3BackupMode_Values(), false),
+						},
+
+						"s3_configuration": s3ConfigurationSchema(),
+					},
+				},
+			},
+
+			"snowflake_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(24, 2048),
+								validation.StringMatch(regexache.MustCompile(`^https://.*$`), ""),
+							),
+						},
+
+						"buffering_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 900),
+						},
+
+						"buffering_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 128),
+						},
+
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
+
+						"content_column_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+
+						"data_loading_option": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      firehose.SnowflakeDataLoadingOptionJsonMapping,
+							ValidateFunc: validation.StringInSlice(firehose.SnowflakeDataLoadingOption_Values(), false),
+						},
+
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+
+						"key_passphrase": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(7, 255),
+						},
+
+						"metadata_column_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+
+						"private_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(256, 4096),
+						},
+
+						"processing_configuration": processingConfigurationSchema(),
+
+						"retry_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(0, 7200),
+						},
+
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+
+						"s3_backup_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      firehose.SnowflakeS3BackupModeFailedDataOnly,
+							ValidateFunc: validation.StringInSlice(firehose.SnowflakeS3BackupMode_Values(), false),
+						},
+
+						"s3_configuration": s3ConfigurationSchema(),
+
+						"schema": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+
+						"secrets_manager_configuration": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+
+									"secret_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+
+						"snowflake_role_configuration": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"snowflake_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+
+						"snowflake_vpc_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"private_link_vpce_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(47, 255),
+									},
+								},
+							},
+						},
+
+						"table": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+
+						"user": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -2706,6 +3115,285 @@ func updateHTTPEndpointConfig(d *schema.ResourceData) (*firehose.HttpEndpointDes
 	return configuration, nil
 }
 
+func createIcebergConfig(d *schema.ResourceData) (*firehose.IcebergDestinationConfiguration, error) {
+	icebergRaw, ok := d.GetOk("iceberg_configuration")
+	if !ok {
+		return nil, elasticsearchDestinationRequiredParamErr("iceberg_configuration", destinationTypeIceberg)
+	}
+	il := icebergRaw.([]interface{})
+
+	iceberg := il[0].(map[string]interface{})
+
+	configuration := &firehose.IcebergDestinationConfiguration{
+		BufferingHints: &firehose.BufferingHints{
+			IntervalInSeconds: aws.Int64(int64(iceberg["buffering_interval"].(int))),
+			SizeInMBs:         aws.Int64(int64(iceberg["buffering_size"].(int))),
+		},
+		CatalogConfiguration: &firehose.CatalogConfiguration{
+			CatalogARN: aws.String(iceberg["catalog_arn"].(string)),
+		},
+		RetryOptions:    extractIcebergRetryOptions(iceberg),
+		RoleARN:         aws.String(iceberg["role_arn"].(string)),
+		S3Configuration: createS3Config(iceberg["s3_configuration"].([]interface{})),
+	}
+
+	if v, ok := iceberg["destination_table_configuration"].([]interface{}); ok && len(v) > 0 {
+		configuration.DestinationTableConfigurationList = expandDestinationTableConfigurations(v)
+	}
+
+	if _, ok := iceberg["processing_configuration"]; ok {
+		configuration.ProcessingConfiguration = extractProcessingConfiguration(iceberg)
+	}
+
+	if _, ok := iceberg["cloudwatch_logging_options"]; ok {
+		configuration.CloudWatchLoggingOptions = extractCloudWatchLoggingConfiguration(iceberg)
+	}
+
+	if s3BackupMode, ok := iceberg["s3_backup_mode"]; ok {
+		configuration.S3BackupMode = aws.String(s3BackupMode.(string))
+	}
+
+	return configuration, nil
+}
+
+func updateIcebergConfig(d *schema.ResourceData) (*firehose.IcebergDestinationUpdate, error) {
+	icebergRaw, ok := d.GetOk("iceberg_configuration")
+	if !ok {
+		return nil, elasticsearchDestinationRequiredParamErr("iceberg_configuration", destinationTypeIceberg)
+	}
+	il := icebergRaw.([]interface{})
+
+	iceberg := il[0].(map[string]interface{})
+
+	configuration := &firehose.IcebergDestinationUpdate{
+		BufferingHints: &firehose.BufferingHints{
+			IntervalInSeconds: aws.Int64(int64(iceberg["buffering_interval"].(int))),
+			SizeInMBs:         aws.Int64(int64(iceberg["buffering_size"].(int))),
+		},
+		CatalogConfiguration: &firehose.CatalogConfiguration{
+			CatalogARN: aws.String(iceberg["catalog_arn"].(string)),
+		},
+		RetryOptions: extractIcebergRetryOptions(iceberg),
+		RoleARN:      aws.String(iceberg["role_arn"].(string)),
+		// The Iceberg destination update takes a full S3 destination configuration.
+		S3Configuration: createS3Config(iceberg["s3_configuration"].([]interface{})),
+	}
+
+	if v, ok := iceberg["destination_table_configuration"].([]interface{}); ok {
+		configuration.DestinationTableConfigurationList = expandDestinationTableConfigurations(v)
+	}
+
+	if _, ok := iceberg["processing_configuration"]; ok {
+		configuration.ProcessingConfiguration = extractProcessingConfiguration(iceberg)
+	}
+
+	if _, ok := iceberg["cloudwatch_logging_options"]; ok {
+		configuration.CloudWatchLoggingOptions = extractCloudWatchLoggingConfiguration(iceberg)
+	}
+
+	if s3BackupMode, ok := iceberg["s3_backup_mode"]; ok {
+		configuration.S3BackupMode = aws.String(s3BackupMode.(string))
+	}
+
+	return configuration, nil
+}
+
+func expandDestinationTableConfigurations(tfList []interface{}) []*firehose.DestinationTableConfiguration {
+	apiObjects := make([]*firehose.DestinationTableConfiguration, 0, len(tfList))
+
+	for _, raw := range tfList {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &firehose.DestinationTableConfiguration{
+			DestinationDatabaseName: aws.String(tfMap["database_name"].(string)),
+			DestinationTableName:    aws.String(tfMap["table_name"].(string)),
+		}
+
+		if v, ok := tfMap["s3_error_output_prefix"].(string); ok && v != "" {
+			apiObject.S3ErrorOutputPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["unique_keys"].([]interface{}); ok && len(v) > 0 {
+			apiObject.UniqueKeys = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func createSnowflakeConfig(d *schema.ResourceData) (*firehose.SnowflakeDestinationConfiguration, error) {
+	snowflakeRaw, ok := d.GetOk("snowflake_configuration")
+	if !ok {
+		return nil, elasticsearchDestinationRequiredParamErr("snowflake_configuration", destinationTypeSnowflake)
+	}
+	sl := snowflakeRaw.([]interface{})
+
+	snowflake := sl[0].(map[string]interface{})
+
+	configuration := &firehose.SnowflakeDestinationConfiguration{
+		AccountUrl: aws.String(snowflake["account_url"].(string)),
+		BufferingHints: &firehose.SnowflakeBufferingHints{
+			IntervalInSeconds: aws.Int64(int64(snowflake["buffering_interval"].(int))),
+			SizeInMBs:         aws.Int64(int64(snowflake["buffering_size"].(int))),
+		},
+		DataLoadingOption: aws.String(snowflake["data_loading_option"].(string)),
+		Database:          aws.String(snowflake["database"].(string)),
+		RetryOptions:      extractSnowflakeRetryOptions(snowflake),
+		RoleARN:           aws.String(snowflake["role_arn"].(string)),
+		S3Configuration:   createS3Config(snowflake["s3_configuration"].([]interface{})),
+		Schema:            aws.String(snowflake["schema"].(string)),
+		Table:             aws.String(snowflake["table"].(string)),
+	}
+
+	if v, ok := snowflake["content_column_name"].(string); ok && v != "" {
+		configuration.ContentColumnName = aws.String(v)
+	}
+
+	if v, ok := snowflake["key_passphrase"].(string); ok && v != "" {
+		configuration.KeyPassphrase = aws.String(v)
+	}
+
+	if v, ok := snowflake["metadata_column_name"].(string); ok && v != "" {
+		configuration.MetaDataColumnName = aws.String(v)
+	}
+
+	if v, ok := snowflake["private_key"].(string); ok && v != "" {
+		configuration.PrivateKey = aws.String(v)
+	}
+
+	if v, ok := snowflake["user"].(string); ok && v != "" {
+		configuration.User = aws.String(v)
+	}
+
+	if v, ok := snowflake["secrets_manager_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.SecretsManagerConfiguration = expandSecretsManagerConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := snowflake["snowflake_role_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.SnowflakeRoleConfiguration = expandSnowflakeRoleConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := snowflake["snowflake_vpc_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.SnowflakeVpcConfiguration = &firehose.SnowflakeVpcConfiguration{
+			PrivateLinkVpceId: aws.String(v[0].(map[string]interface{})["private_link_vpce_id"].(string)),
+		}
+	}
+
+	if _, ok := snowflake["processing_configuration"]; ok {
+		configuration.ProcessingConfiguration = extractProcessingConfiguration(snowflake)
+	}
+
+	if _, ok := snowflake["cloudwatch_logging_options"]; ok {
+		configuration.CloudWatchLoggingOptions = extractCloudWatchLoggingConfiguration(snowflake)
+	}
+
+	if s3BackupMode, ok := snowflake["s3_backup_mode"]; ok {
+		configuration.S3BackupMode = aws.String(s3BackupMode.(string))
+	}
+
+	return configuration, nil
+}
+
+func updateSnowflakeConfig(d *schema.ResourceData) (*firehose.SnowflakeDestinationUpdate, error) {
+	snowflakeRaw, ok := d.GetOk("snowflake_configuration")
+	if !ok {
+		return nil, elasticsearchDestinationRequiredParamErr("snowflake_configuration", destinationTypeSnowflake)
+	}
+	sl := snowflakeRaw.([]interface{})
+
+	snowflake := sl[0].(map[string]interface{})
+
+	configuration := &firehose.SnowflakeDestinationUpdate{
+		AccountUrl: aws.String(snowflake["account_url"].(string)),
+		BufferingHints: &firehose.SnowflakeBufferingHints{
+			IntervalInSeconds: aws.Int64(int64(snowflake["buffering_interval"].(int))),
+			SizeInMBs:         aws.Int64(int64(snowflake["buffering_size"].(int))),
+		},
+		DataLoadingOption: aws.String(snowflake["data_loading_option"].(string)),
+		Database:          aws.String(snowflake["database"].(string)),
+		RetryOptions:      extractSnowflakeRetryOptions(snowflake),
+		RoleARN:           aws.String(snowflake["role_arn"].(string)),
+		S3Update:          updateS3Config(snowflake["s3_configuration"].([]interface{})),
+		Schema:            aws.String(snowflake["schema"].(string)),
+		Table:             aws.String(snowflake["table"].(string)),
+	}
+
+	if v, ok := snowflake["content_column_name"].(string); ok && v != "" {
+		configuration.ContentColumnName = aws.String(v)
+	}
+
+	if v, ok := snowflake["key_passphrase"].(string); ok && v != "" {
+		configuration.KeyPassphrase = aws.String(v)
+	}
+
+	if v, ok := snowflake["metadata_column_name"].(string); ok && v != "" {
+		configuration.MetaDataColumnName = aws.String(v)
+	}
+
+	if v, ok := snowflake["private_key"].(string); ok && v != "" {
+		configuration.PrivateKey = aws.String(v)
+	}
+
+	if v, ok := snowflake["user"].(string); ok && v != "" {
+		configuration.User = aws.String(v)
+	}
+
+	if v, ok := snowflake["secrets_manager_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.SecretsManagerConfiguration = expandSecretsManagerConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := snowflake["snowflake_role_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.SnowflakeRoleConfiguration = expandSnowflakeRoleConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if _, ok := snowflake["processing_configuration"]; ok {
+		configuration.ProcessingConfiguration = extractProcessingConfiguration(snowflake)
+	}
+
+	if _, ok := snowflake["cloudwatch_logging_options"]; ok {
+		configuration.CloudWatchLoggingOptions = extractCloudWatchLoggingConfiguration(snowflake)
+	}
+
+	if s3BackupMode, ok := snowflake["s3_backup_mode"]; ok {
+		configuration.S3BackupMode = aws.String(s3BackupMode.(string))
+	}
+
+	return configuration, nil
+}
+
+func expandSecretsManagerConfiguration(tfMap map[string]interface{}) *firehose.SecretsManagerConfiguration {
+	apiObject := &firehose.SecretsManagerConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleARN = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSnowflakeRoleConfiguration(tfMap map[string]interface{}) *firehose.SnowflakeRoleConfiguration {
+	apiObject := &firehose.SnowflakeRoleConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["snowflake_role"].(string); ok && v != "" {
+		apiObject.SnowflakeRole = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func extractCommonAttributes(ca []interface{}) []*firehose.HttpEndpointCommonAttribute {
 	CommonAttributes := make([]*firehose.HttpEndpointCommonAttribute, 0, len(ca))
 
@@ -2834,6 +3522,26 @@ func extractSplunkRetryOptions(splunk map[string]interface{}) *firehose.SplunkRe
 	return retryOptions
 }
 
+func extractIcebergRetryOptions(iceberg map[string]interface{}) *firehose.RetryOptions {
+	retryOptions := &firehose.RetryOptions{}
+
+	if retryDuration, ok := iceberg["retry_duration"].(int); ok {
+		retryOptions.DurationInSeconds = aws.Int64(int64(retryDuration))
+	}
+
+	return retryOptions
+}
+
+func extractSnowflakeRetryOptions(snowflake map[string]interface{}) *firehose.SnowflakeRetryOptions {
+	retryOptions := &firehose.SnowflakeRetryOptions{}
+
+	if retryDuration, ok := snowflake["retry_duration"].(int); ok {
+		retryOptions.DurationInSeconds = aws.Int64(int64(retryDuration))
+	}
+
+	return retryOptions
+}
+
 func extractCopyCommandConfiguration(redshift map[string]interface{}) *firehose.CopyCommand {
 	cmd := &firehose.CopyCommand{
 		DataTableName: aws.String(redshift["data_table_name"].(string)),
@@ -2905,6 +3613,18 @@ func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, m
 				return sdkdiag.AppendErrorf(diags, "creating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
 			}
 			input.HttpEndpointDestinationConfiguration = httpConfig
+		} else if d.Get("destination").(string) == destinationTypeIceberg {
+			icebergConfig, err := createIcebergConfig(d)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
+			}
+			input.IcebergDestinationConfiguration = icebergConfig
+		} else if d.Get("destination").(string) == destinationTypeSnowflake {
+			snowflakeConfig, err := createSnowflakeConfig(d)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
+			}
+			input.SnowflakeDestinationConfiguration = snowflakeConfig
 		}
 	}
 
@@ -3040,6 +3760,18 @@ func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, m
 					return sdkdiag.AppendErrorf(diags, "updating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
 				}
 				updateInput.HttpEndpointDestinationUpdate = rc
+			} else if d.Get("destination").(string) == destinationTypeIceberg {
+				rc, err := updateIcebergConfig(d)
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
+				}
+				updateInput.IcebergDestinationUpdate = rc
+			} else if d.Get("destination").(string) == destinationTypeSnowflake {
+				rc, err := updateSnowflakeConfig(d)
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
+				}
+				updateInput.SnowflakeDestinationUpdate = rc
 			}
 		}
 
@@ -18,10 +18,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	tffirehose "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	envVarSnowflakeAccountURL   = "AWS_FIREHOSE_SNOWFLAKE_ACCOUNT_URL"
+	envVarSnowflakePrivateKey   = "AWS_FIREHOSE_SNOWFLAKE_PRIVATE_KEY"
+	envVarSnowflakeUser         = "AWS_FIREHOSE_SNOWFLAKE_USER"
+	envVarSnowflakeMessageError = "Environment variables AWS_FIREHOSE_SNOWFLAKE_ACCOUNT_URL, AWS_FIREHOSE_SNOWFLAKE_PRIVATE_KEY and AWS_FIREHOSE_SNOWFLAKE_USER must be set " +
+		"to the URL, private key and user of a Snowflake account configured for Kinesis Firehose key pair authentication."
+)
+
 func TestAccFirehoseDeliveryStream_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stream firehose.DeliveryStreamDescription
@@ -1133,6 +1142,107 @@ func TestAccFirehoseDeliveryStream_HTTPEndpoint_retryDuration(t *testing.T) {
 	})
 }
 
+func TestAccFirehoseDeliveryStream_icebergUpdates(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stream firehose.DeliveryStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, firehose.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryStreamConfig_iceberg(rName, 300, 5, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "destination", "iceberg"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_interval", "300"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_size", "5"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "iceberg_configuration.0.catalog_arn", "glue", "catalog"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.destination_table_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.destination_table_configuration.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.destination_table_configuration.0.table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.retry_duration", "300"),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.role_arn", "aws_iam_role.firehose", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.s3_backup_mode", "FailedDataOnly"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.s3_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeliveryStreamConfig_iceberg(rName, 600, 64, 900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_interval", "600"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_size", "64"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.retry_duration", "900"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFirehoseDeliveryStream_snowflakeUpdates(t *testing.T) {
+	ctx := acctest.Context(t)
+	accountURL := envvar.SkipIfEmpty(t, envVarSnowflakeAccountURL, envVarSnowflakeMessageError)
+	privateKey := envvar.SkipIfEmpty(t, envVarSnowflakePrivateKey, envVarSnowflakeMessageError)
+	user := envvar.SkipIfEmpty(t, envVarSnowflakeUser, envVarSnowflakeMessageError)
+	var stream firehose.DeliveryStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, firehose.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryStreamConfig_snowflake(rName, accountURL, privateKey, user, 0, 1, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "destination", "snowflake"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.account_url", accountURL),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.buffering_interval", "0"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.buffering_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.data_loading_option", "JSON_MAPPING"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.database", "test-db"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.retry_duration", "60"),
+					resource.TestCheckResourceAttrPair(resourceName, "snowflake_configuration.0.role_arn", "aws_iam_role.firehose", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.s3_backup_mode", "FailedDataOnly"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.schema", "test-schema"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.table", "test-table"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.user", user),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snowflake_configuration.0.private_key"},
+			},
+			{
+				Config: testAccDeliveryStreamConfig_snowflake(rName, accountURL, privateKey, user, 300, 64, 900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.buffering_interval", "300"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.buffering_size", "64"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.retry_duration", "900"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFirehoseDeliveryStream_elasticSearchUpdates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stream firehose.DeliveryStreamDescription
@@ -3173,6 +3283,107 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName, retryDuration))
 }
 
+func testAccDeliveryStreamConfig_iceberg(rName string, bufferingInterval, bufferingSize, retryDuration int) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_base(rName), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  open_table_format_input {
+    iceberg_input {
+      metadata_operation = "CREATE"
+      version            = 2
+    }
+  }
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.bucket.id}/iceberg/"
+
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "iceberg" {
+  name = "%[1]s-iceberg"
+  role = aws_iam_role.firehose.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "glue:GetDatabase",
+        "glue:UpdateTable",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose, aws_iam_role_policy.iceberg]
+  name        = %[1]q
+  destination = "iceberg"
+
+  iceberg_configuration {
+    buffering_interval = %[2]d
+    buffering_size     = %[3]d
+    catalog_arn        = "arn:${data.aws_partition.current.partition}:glue:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:catalog"
+    retry_duration     = %[4]d
+    role_arn           = aws_iam_role.firehose.arn
+
+    destination_table_configuration {
+      database_name = aws_glue_catalog_database.test.name
+      table_name    = aws_glue_catalog_table.test.name
+    }
+
+    s3_configuration {
+      role_arn   = aws_iam_role.firehose.arn
+      bucket_arn = aws_s3_bucket.bucket.arn
+    }
+  }
+}
+`, rName, bufferingInterval, bufferingSize, retryDuration))
+}
+
+func testAccDeliveryStreamConfig_snowflake(rName, accountURL, privateKey, user string, bufferingInterval, bufferingSize, retryDuration int) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose]
+  name        = %[1]q
+  destination = "snowflake"
+
+  snowflake_configuration {
+    account_url        = %[2]q
+    buffering_interval = %[5]d
+    buffering_size     = %[6]d
+    database           = "test-db"
+    private_key        = %[3]q
+    retry_duration     = %[7]d
+    role_arn           = aws_iam_role.firehose.arn
+    schema             = "test-schema"
+    table              = "test-table"
+    user               = %[4]q
+
+    s3_configuration {
+      role_arn   = aws_iam_role.firehose.arn
+      bucket_arn = aws_s3_bucket.bucket.arn
+    }
+  }
+}
+`, rName, accountURL, privateKey, user, bufferingInterval, bufferingSize, retryDuration))
+}
+
 func testAccDeliveryStreamConfig_httpEndpointUpdates(rName string) string {
 	return acctest.ConfigCompose(
 		testAccDeliveryStreamConfig_baseLambda(rName),
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_glue_data_quality_rule_recommendation")
+func DataSourceDataQualityRuleRecommendation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDataQualityRuleRecommendationRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_ruleset_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"table_name"},
+				ConflictsWith: []string{"run_id"},
+				ValidateFunc:  validation.StringLenBetween(1, 255),
+			},
+			"recommended_ruleset": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"run_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"run_id", "table_name"},
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"database_name"},
+				ConflictsWith: []string{"run_id"},
+				ValidateFunc:  validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func dataSourceDataQualityRuleRecommendationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	runID := d.Get("run_id").(string)
+
+	if runID == "" {
+		table := &glue.Table{
+			DatabaseName: aws.String(d.Get("database_name").(string)),
+			TableName:    aws.String(d.Get("table_name").(string)),
+		}
+
+		if v, ok := d.GetOk("catalog_id"); ok {
+			table.CatalogId = aws.String(v.(string))
+		}
+
+		run, err := findLatestSucceededDataQualityRuleRecommendationRun(ctx, conn, table)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Glue Data Quality Rule Recommendation Run", err))
+		}
+
+		runID = aws.StringValue(run.RunId)
+	}
+
+	run, err := FindDataQualityRuleRecommendationRunByID(ctx, conn, runID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Rule Recommendation Run (%s): %s", runID, err)
+	}
+
+	d.SetId(runID)
+	if run.CompletedOn != nil {
+		d.Set("completed_on", aws.TimeValue(run.CompletedOn).Format(time.RFC3339))
+	}
+	d.Set("created_ruleset_name", run.CreatedRulesetName)
+	if v := run.DataSource; v != nil && v.GlueTable != nil {
+		d.Set("catalog_id", v.GlueTable.CatalogId)
+		d.Set("database_name", v.GlueTable.DatabaseName)
+		d.Set("table_name", v.GlueTable.TableName)
+	}
+	d.Set("recommended_ruleset", run.RecommendedRuleset)
+	d.Set("run_id", run.RunId)
+	if run.StartedOn != nil {
+		d.Set("started_on", aws.TimeValue(run.StartedOn).Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func findLatestSucceededDataQualityRuleRecommendationRun(ctx context.Context, conn *glue.Glue, table *glue.Table) (*glue.DataQualityRuleRecommendationRunDescription, error) {
+	input := &glue.ListDataQualityRuleRecommendationRunsInput{
+		Filter: &glue.DataQualityRuleRecommendationRunFilter{
+			DataSource: &glue.DataSource{
+				GlueTable: table,
+			},
+		},
+	}
+	var latest *glue.DataQualityRuleRecommendationRunDescription
+
+	err := conn.ListDataQualityRuleRecommendationRunsPagesWithContext(ctx, input, func(page *glue.ListDataQualityRuleRecommendationRunsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Runs {
+			if v == nil || aws.StringValue(v.Status) != glue.TaskStatusTypeSucceeded {
+				continue
+			}
+
+			if latest == nil || aws.TimeValue(v.StartedOn).After(aws.TimeValue(latest.StartedOn)) {
+				latest = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if latest == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return latest, nil
+}
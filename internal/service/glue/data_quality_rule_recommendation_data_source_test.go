@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlueDataQualityRuleRecommendationDataSource_noRuns(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataQualityRuleRecommendationDataSourceConfig_table(rName),
+				ExpectError: regexache.MustCompile(`no matching Glue Data Quality Rule Recommendation Run found`),
+			},
+		},
+	})
+}
+
+func testAccDataQualityRuleRecommendationDataSourceConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccDataQualityRulesetConfigTargetTableConfigBasic(rName, rName), `
+data "aws_glue_data_quality_rule_recommendation" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+}
+`)
+}
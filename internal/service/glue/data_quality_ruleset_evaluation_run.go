@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_glue_data_quality_ruleset_evaluation_run", name="Data Quality Ruleset Evaluation Run")
+func ResourceDataQualityRulesetEvaluationRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataQualityRulesetEvaluationRunCreate,
+		ReadWithoutTimeout:   resourceDataQualityRulesetEvaluationRunRead,
+		DeleteWithoutTimeout: resourceDataQualityRulesetEvaluationRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_run_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"composite_rule_evaluation_method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(glue.DQCompositeRuleEvaluationMethod_Values(), false),
+						},
+						"results_s3_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_table": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"additional_options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"connection_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"database_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"table_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"error_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_workers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"result_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ruleset_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDataQualityRulesetEvaluationRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	input := &glue.StartDataQualityRulesetEvaluationRunInput{
+		DataSource:   expandDataQualityDataSource(d.Get("data_source").([]interface{})),
+		Role:         aws.String(d.Get("role_arn").(string)),
+		RulesetNames: flex.ExpandStringList(d.Get("ruleset_names").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("additional_run_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AdditionalRunOptions = expandDataQualityEvaluationRunAdditionalRunOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("timeout"); ok {
+		input.Timeout = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.StartDataQualityRulesetEvaluationRunWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Glue Data Quality Ruleset Evaluation Run: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.RunId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitDataQualityRulesetEvaluationRunCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Glue Data Quality Ruleset Evaluation Run (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataQualityRulesetEvaluationRunRead(ctx, d, meta)...)
+}
+
+func resourceDataQualityRulesetEvaluationRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	run, err := FindDataQualityRulesetEvaluationRunByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Data Quality Ruleset Evaluation Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Ruleset Evaluation Run (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("additional_run_options", flattenDataQualityEvaluationRunAdditionalRunOptions(run.AdditionalRunOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_run_options: %s", err)
+	}
+	if run.CompletedOn != nil {
+		d.Set("completed_on", aws.TimeValue(run.CompletedOn).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	if err := d.Set("data_source", flattenDataQualityDataSource(run.DataSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_source: %s", err)
+	}
+	d.Set("error_string", run.ErrorString)
+	d.Set("number_of_workers", run.NumberOfWorkers)
+	d.Set("result_ids", aws.StringValueSlice(run.ResultIds))
+	d.Set("role_arn", run.Role)
+	d.Set("ruleset_names", aws.StringValueSlice(run.RulesetNames))
+	if run.StartedOn != nil {
+		d.Set("started_on", aws.TimeValue(run.StartedOn).Format(time.RFC3339))
+	} else {
+		d.Set("started_on", nil)
+	}
+	d.Set("status", run.Status)
+	d.Set("timeout", run.Timeout)
+
+	return diags
+}
+
+func resourceDataQualityRulesetEvaluationRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	// Evaluation runs can't be deleted. Cancel the run if it's still in progress.
+	switch status := d.Get("status").(string); status {
+	case glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Glue Data Quality Ruleset Evaluation Run: %s", d.Id())
+	_, err := conn.CancelDataQualityRulesetEvaluationRunWithContext(ctx, &glue.CancelDataQualityRulesetEvaluationRunInput{
+		RunId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Glue Data Quality Ruleset Evaluation Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataQualityRulesetEvaluationRunStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Glue Data Quality Ruleset Evaluation Run (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandDataQualityDataSource(tfList []interface{}) *glue.DataSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &glue.DataSource{}
+
+	if v, ok := tfMap["glue_table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GlueTable = expandDataQualityGlueTable(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDataQualityGlueTable(tfMap map[string]interface{}) *glue.Table {
+	apiObject := &glue.Table{
+		DatabaseName: aws.String(tfMap["database_name"].(string)),
+		TableName:    aws.String(tfMap["table_name"].(string)),
+	}
+
+	if v, ok := tfMap["additional_options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AdditionalOptions = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["connection_name"].(string); ok && v != "" {
+		apiObject.ConnectionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataQualityEvaluationRunAdditionalRunOptions(tfMap map[string]interface{}) *glue.DataQualityEvaluationRunAdditionalRunOptions {
+	apiObject := &glue.DataQualityEvaluationRunAdditionalRunOptions{}
+
+	if v, ok := tfMap["cloud_watch_metrics_enabled"].(bool); ok {
+		apiObject.CloudWatchMetricsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["composite_rule_evaluation_method"].(string); ok && v != "" {
+		apiObject.CompositeRuleEvaluationMethod = aws.String(v)
+	}
+
+	if v, ok := tfMap["results_s3_prefix"].(string); ok && v != "" {
+		apiObject.ResultsS3Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataQualityDataSource(apiObject *glue.DataSource) []interface{} {
+	if apiObject == nil || apiObject.GlueTable == nil {
+		return nil
+	}
+
+	table := apiObject.GlueTable
+	tfMap := map[string]interface{}{
+		"additional_options": aws.StringValueMap(table.AdditionalOptions),
+		"catalog_id":         aws.StringValue(table.CatalogId),
+		"connection_name":    aws.StringValue(table.ConnectionName),
+		"database_name":      aws.StringValue(table.DatabaseName),
+		"table_name":         aws.StringValue(table.TableName),
+	}
+
+	return []interface{}{map[string]interface{}{
+		"glue_table": []interface{}{tfMap},
+	}}
+}
+
+func flattenDataQualityEvaluationRunAdditionalRunOptions(apiObject *glue.DataQualityEvaluationRunAdditionalRunOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cloud_watch_metrics_enabled":      aws.BoolValue(apiObject.CloudWatchMetricsEnabled),
+		"composite_rule_evaluation_method": aws.StringValue(apiObject.CompositeRuleEvaluationMethod),
+		"results_s3_prefix":                aws.StringValue(apiObject.ResultsS3Prefix),
+	}
+
+	return []interface{}{tfMap}
+}
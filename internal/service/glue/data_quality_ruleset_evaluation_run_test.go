@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueDataQualityRulesetEvaluationRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v glue.GetDataQualityRulesetEvaluationRunOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset_evaluation_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataQualityRulesetEvaluationRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetEvaluationRunConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataQualityRulesetEvaluationRunExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_source.0.glue_table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source.0.glue_table.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source.0.glue_table.0.table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "ruleset_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "ruleset_names.0", "aws_glue_data_quality_ruleset.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_on"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueDataQualityRulesetEvaluationRun_additionalRunOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v glue.GetDataQualityRulesetEvaluationRunOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset_evaluation_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataQualityRulesetEvaluationRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetEvaluationRunConfig_additionalRunOptions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataQualityRulesetEvaluationRunExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "additional_run_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_run_options.0.cloud_watch_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "additional_run_options.0.results_s3_prefix", fmt.Sprintf("s3://%s/results/", rName)),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataQualityRulesetEvaluationRunExists(ctx context.Context, n string, v *glue.GetDataQualityRulesetEvaluationRunOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+		output, err := tfglue.FindDataQualityRulesetEvaluationRunByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDataQualityRulesetEvaluationRunDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glue_data_quality_ruleset_evaluation_run" {
+				continue
+			}
+
+			output, err := tfglue.FindDataQualityRulesetEvaluationRunByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Evaluation runs are retained by the service once finished.
+			switch aws.StringValue(output.Status) {
+			case glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning, glue.TaskStatusTypeStopping:
+				return fmt.Errorf("Glue Data Quality Ruleset Evaluation Run %s still running", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataQualityRulesetEvaluationRunConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDataQualityRulesetConfigTargetTableConfigBasic(rName, rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+}
+
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [RowCount > 0]"
+
+  target_table {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName))
+}
+
+func testAccDataQualityRulesetEvaluationRunConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataQualityRulesetEvaluationRunConfig_base(rName), `
+resource "aws_glue_data_quality_ruleset_evaluation_run" "test" {
+  role_arn            = aws_iam_role.test.arn
+  ruleset_names       = [aws_glue_data_quality_ruleset.test.name]
+  wait_for_completion = false
+
+  data_source {
+    glue_table {
+      database_name = aws_glue_catalog_database.test.name
+      table_name    = aws_glue_catalog_table.test.name
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`)
+}
+
+func testAccDataQualityRulesetEvaluationRunConfig_additionalRunOptions(rName string) string {
+	return acctest.ConfigCompose(testAccDataQualityRulesetEvaluationRunConfig_base(rName), `
+resource "aws_s3_bucket" "test" {
+  bucket        = aws_glue_data_quality_ruleset.test.name
+  force_destroy = true
+}
+
+resource "aws_glue_data_quality_ruleset_evaluation_run" "test" {
+  role_arn            = aws_iam_role.test.arn
+  ruleset_names       = [aws_glue_data_quality_ruleset.test.name]
+  number_of_workers   = 2
+  timeout             = 30
+  wait_for_completion = false
+
+  additional_run_options {
+    cloud_watch_metrics_enabled = true
+    results_s3_prefix           = "s3://${aws_s3_bucket.test.bucket}/results/"
+  }
+
+  data_source {
+    glue_table {
+      database_name = aws_glue_catalog_database.test.name
+      table_name    = aws_glue_catalog_table.test.name
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`)
+}
@@ -112,6 +112,78 @@ func FindDataQualityRulesetByName(ctx context.Context, conn *glue.Glue, name str
 	return output, nil
 }
 
+func FindDataQualityRulesetEvaluationRunByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	input := &glue.GetDataQualityRulesetEvaluationRunInput{
+		RunId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityRulesetEvaluationRunWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataQualityRuleRecommendationRunByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityRuleRecommendationRunOutput, error) {
+	input := &glue.GetDataQualityRuleRecommendationRunInput{
+		RunId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityRuleRecommendationRunWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindUsageProfileByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetUsageProfileOutput, error) {
+	input := &glue.GetUsageProfileInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetUsageProfileWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 // FindTriggerByName returns the Trigger corresponding to the specified name.
 func FindTriggerByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetTriggerOutput, error) {
 	input := &glue.GetTriggerInput{
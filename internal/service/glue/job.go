@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -22,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_glue_job", name="Job")
@@ -36,7 +40,10 @@ func ResourceJob() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceJobCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -64,7 +71,7 @@ func ResourceJob() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"Ray2.4"}, true),
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^Ray\d+\.\d+$`), "must be a Ray runtime, e.g. Ray2.4"),
 						},
 						"script_location": {
 							Type:     schema.TypeString,
@@ -156,6 +163,10 @@ func ResourceJob() *schema.Resource {
 				ConflictsWith: []string{"max_capacity"},
 				ValidateFunc:  validation.IntAtLeast(2),
 			},
+			"profile_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -309,6 +320,7 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting notification_property: %s", err)
 	}
 	d.Set("number_of_workers", job.NumberOfWorkers)
+	d.Set("profile_name", job.ProfileName)
 	d.Set("role_arn", job.Role)
 	d.Set("security_configuration", job.SecurityConfiguration)
 	d.Set("timeout", job.Timeout)
@@ -420,6 +432,55 @@ func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+// jobWorkerTypeRequirements lists, for each worker type, the job commands it
+// can run and the earliest Glue version that supports it. The Glue API only
+// rejects mismatches when the job is created or updated.
+var jobWorkerTypeRequirements = map[string]struct {
+	commands       []string
+	minGlueVersion float64
+}{
+	glue.WorkerTypeStandard: {commands: []string{"glueetl", "gluestreaming"}},
+	glue.WorkerTypeG1x:      {commands: []string{"glueetl", "gluestreaming"}},
+	glue.WorkerTypeG2x:      {commands: []string{"glueetl", "gluestreaming"}},
+	glue.WorkerTypeG025x:    {commands: []string{"gluestreaming"}, minGlueVersion: 3.0},
+	glue.WorkerTypeG4x:      {commands: []string{"glueetl", "gluestreaming"}, minGlueVersion: 3.0},
+	glue.WorkerTypeG8x:      {commands: []string{"glueetl", "gluestreaming"}, minGlueVersion: 3.0},
+	glue.WorkerTypeZ2x:      {commands: []string{"glueray"}, minGlueVersion: 4.0},
+}
+
+func resourceJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("command") || !diff.NewValueKnown("worker_type") {
+		return nil
+	}
+
+	commandName := diff.Get("command.0.name").(string)
+
+	if runtime := diff.Get("command.0.runtime").(string); runtime != "" && commandName != "glueray" {
+		return fmt.Errorf("command runtime %q is only supported by glueray jobs", runtime)
+	}
+
+	workerType := diff.Get("worker_type").(string)
+	requirements, ok := jobWorkerTypeRequirements[workerType]
+
+	if !ok {
+		return nil
+	}
+
+	if !slices.Contains(requirements.commands, commandName) {
+		return fmt.Errorf("worker_type %q is not supported by %s jobs", workerType, commandName)
+	}
+
+	if v := diff.Get("glue_version").(string); v != "" && diff.NewValueKnown("glue_version") && requirements.minGlueVersion > 0 {
+		glueVersion, err := strconv.ParseFloat(v, 64)
+
+		if err == nil && glueVersion < requirements.minGlueVersion {
+			return fmt.Errorf("worker_type %q requires glue_version %.1f or later, got %q", workerType, requirements.minGlueVersion, v)
+		}
+	}
+
+	return nil
+}
+
 func expandExecutionProperty(l []interface{}) *glue.ExecutionProperty {
 	m := l[0].(map[string]interface{})
 
@@ -660,6 +660,49 @@ func TestAccGlueJob_workerType(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_workerTypeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job glue.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "glueetl", "Z.2X", "4.0"),
+				ExpectError: regexache.MustCompile(`worker_type "Z.2X" is not supported by glueetl jobs`),
+			},
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "glueetl", "G.025X", "4.0"),
+				ExpectError: regexache.MustCompile(`worker_type "G.025X" is not supported by glueetl jobs`),
+			},
+			{
+				Config:      testAccJobConfig_workerTypeGlueVersion(rName, "glueetl", "G.8X", "2.0"),
+				ExpectError: regexache.MustCompile(`worker_type "G.8X" requires glue_version 3.0 or later`),
+			},
+			{
+				Config: testAccJobConfig_workerTypeGlueVersion(rName, "glueetl", "G.4X", "4.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "glue_version", "4.0"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.4X"),
+				),
+			},
+			{
+				Config: testAccJobConfig_workerTypeGlueVersion(rName, "glueetl", "G.8X", "4.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.8X"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_pythonShell(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job glue.Job
@@ -1191,6 +1234,25 @@ resource "aws_glue_job" "test" {
 `, rName, workerType))
 }
 
+func testAccJobConfig_workerTypeGlueVersion(rName, commandName, workerType, glueVersion string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  glue_version      = %[4]q
+  worker_type       = %[3]q
+  number_of_workers = 2
+
+  command {
+    name            = %[2]q
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, commandName, workerType, glueVersion))
+}
+
 func testAccJobConfig_pythonShell(rName string) string {
 	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
 resource "aws_glue_job" "test" {
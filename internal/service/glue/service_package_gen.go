@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDataCatalogEncryptionSettings,
 			TypeName: "aws_glue_data_catalog_encryption_settings",
 		},
+		{
+			Factory:  DataSourceDataQualityRuleRecommendation,
+			TypeName: "aws_glue_data_quality_rule_recommendation",
+		},
 		{
 			Factory:  DataSourceScript,
 			TypeName: "aws_glue_script",
@@ -90,6 +94,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceDataQualityRulesetEvaluationRun,
+			TypeName: "aws_glue_data_quality_ruleset_evaluation_run",
+			Name:     "Data Quality Ruleset Evaluation Run",
+		},
 		{
 			Factory:  ResourceDevEndpoint,
 			TypeName: "aws_glue_dev_endpoint",
@@ -154,6 +163,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceUsageProfile,
+			TypeName: "aws_glue_usage_profile",
+			Name:     "Usage Profile",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceUserDefinedFunction,
 			TypeName: "aws_glue_user_defined_function",
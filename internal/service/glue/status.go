@@ -141,3 +141,19 @@ func statusPartitionIndex(ctx context.Context, conn *glue.Glue, id string) retry
 		return output, aws.StringValue(output.IndexStatus), nil
 	}
 }
+
+func statusDataQualityRulesetEvaluationRun(ctx context.Context, conn *glue.Glue, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataQualityRulesetEvaluationRunByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
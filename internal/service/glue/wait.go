@@ -233,3 +233,41 @@ func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Glue, id string,
 
 	return nil, err
 }
+
+func waitDataQualityRulesetEvaluationRunCompleted(ctx context.Context, conn *glue.Glue, id string, timeout time.Duration) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning},
+		Target:  []string{glue.TaskStatusTypeSucceeded},
+		Refresh: statusDataQualityRulesetEvaluationRun(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glue.GetDataQualityRulesetEvaluationRunOutput); ok {
+		if v := aws.StringValue(output.ErrorString); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataQualityRulesetEvaluationRunStopped(ctx context.Context, conn *glue.Glue, id string, timeout time.Duration) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning, glue.TaskStatusTypeStopping},
+		Target:  []string{glue.TaskStatusTypeStopped, glue.TaskStatusTypeSucceeded, glue.TaskStatusTypeFailed, glue.TaskStatusTypeTimeout},
+		Refresh: statusDataQualityRulesetEvaluationRun(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glue.GetDataQualityRulesetEvaluationRunOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
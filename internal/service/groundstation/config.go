@@ -0,0 +1,742 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	configResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_groundstation_config", name="Config")
+// @Tags(identifierAttribute="arn")
+func ResourceConfig() *schema.Resource {
+	frequencySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"units": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(groundstation.FrequencyUnits_Values(), false),
+					},
+					"value": {
+						Type:     schema.TypeFloat,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+	polarizationSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(groundstation.Polarization_Values(), false),
+		}
+	}
+	spectrumConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bandwidth": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"units": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(groundstation.BandwidthUnits_Values(), false),
+								},
+								"value": {
+									Type:     schema.TypeFloat,
+									Required: true,
+								},
+							},
+						},
+					},
+					"center_frequency": frequencySchema(),
+					"polarization":     polarizationSchema(),
+				},
+			},
+		}
+	}
+	unvalidatedJSONSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"unvalidated_json": {
+						Type:                  schema.TypeString,
+						Required:              true,
+						ValidateFunc:          validation.StringIsJSON,
+						DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+						DiffSuppressOnRefresh: true,
+						StateFunc: func(v interface{}) string {
+							json, _ := structure.NormalizeJsonString(v)
+							return json
+						},
+					},
+				},
+			},
+		}
+	}
+	configDataKeys := []string{
+		"config_data.0.antenna_downlink_config",
+		"config_data.0.antenna_downlink_demod_decode_config",
+		"config_data.0.antenna_uplink_config",
+		"config_data.0.dataflow_endpoint_config",
+		"config_data.0.s3_recording_config",
+		"config_data.0.tracking_config",
+		"config_data.0.uplink_echo_config",
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigCreate,
+		ReadWithoutTimeout:   resourceConfigRead,
+		UpdateWithoutTimeout: resourceConfigUpdate,
+		DeleteWithoutTimeout: resourceConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"antenna_downlink_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_downlink_demod_decode_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decode_config":       unvalidatedJSONSchema(),
+									"demodulation_config": unvalidatedJSONSchema(),
+									"spectrum_config":     spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_uplink_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"center_frequency": frequencySchema(),
+												"polarization":     polarizationSchema(),
+											},
+										},
+									},
+									"target_eirp": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"units": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(groundstation.EirpUnits_Values(), false),
+												},
+												"value": {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+											},
+										},
+									},
+									"transmit_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"dataflow_endpoint_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dataflow_endpoint_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"dataflow_endpoint_region": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"s3_recording_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 900),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"tracking_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"autotrack": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(groundstation.Criticality_Values(), false),
+									},
+								},
+							},
+						},
+						"uplink_echo_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"antenna_uplink_config_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &groundstation.CreateConfigInput{
+		ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})),
+		Name:       aws.String(name),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateConfigWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Config (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.StringValue(output.ConfigId), aws.StringValue(output.ConfigType)}, configResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceConfigRead(ctx, d, meta)...)
+}
+
+func resourceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindConfigByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ConfigArn)
+	if err := d.Set("config_data", flattenConfigTypeData(output.ConfigData)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting config_data: %s", err)
+	}
+	d.Set("config_id", output.ConfigId)
+	d.Set("config_type", output.ConfigType)
+	d.Set("name", output.Name)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), configResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})),
+			ConfigId:   aws.String(parts[0]),
+			ConfigType: aws.String(parts[1]),
+			Name:       aws.String(d.Get("name").(string)),
+		}
+
+		_, err = conn.UpdateConfigWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Ground Station Config (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfigRead(ctx, d, meta)...)
+}
+
+func resourceConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Ground Station Config: %s", d.Id())
+	_, err = conn.DeleteConfigWithContext(ctx, &groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(parts[0]),
+		ConfigType: aws.String(parts[1]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Config (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfigByTwoPartKey(ctx context.Context, conn *groundstation.GroundStation, configID, configType string) (*groundstation.GetConfigOutput, error) {
+	input := &groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: aws.String(configType),
+	}
+
+	output, err := conn.GetConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigData == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandConfigTypeData(tfList []interface{}) *groundstation.ConfigTypeData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &groundstation.ConfigTypeData{}
+
+	if v, ok := tfMap["antenna_downlink_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AntennaDownlinkConfig = &groundstation.AntennaDownlinkConfig{
+			SpectrumConfig: expandSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["antenna_downlink_demod_decode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AntennaDownlinkDemodDecodeConfig = &groundstation.AntennaDownlinkDemodDecodeConfig{
+			DecodeConfig: &groundstation.DecodeConfig{
+				UnvalidatedJSON: expandUnvalidatedJSON(tfMap["decode_config"].([]interface{})),
+			},
+			DemodulationConfig: &groundstation.DemodulationConfig{
+				UnvalidatedJSON: expandUnvalidatedJSON(tfMap["demodulation_config"].([]interface{})),
+			},
+			SpectrumConfig: expandSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["antenna_uplink_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AntennaUplinkConfig = expandAntennaUplinkConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dataflow_endpoint_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DataflowEndpointConfig = &groundstation.DataflowEndpointConfig{
+			DataflowEndpointName: aws.String(tfMap["dataflow_endpoint_name"].(string)),
+		}
+
+		if v, ok := tfMap["dataflow_endpoint_region"].(string); ok && v != "" {
+			apiObject.DataflowEndpointConfig.DataflowEndpointRegion = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3_recording_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3RecordingConfig = &groundstation.S3RecordingConfig{
+			BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3RecordingConfig.Prefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["tracking_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TrackingConfig = &groundstation.TrackingConfig{
+			Autotrack: aws.String(tfMap["autotrack"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["uplink_echo_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.UplinkEchoConfig = &groundstation.UplinkEchoConfig{
+			AntennaUplinkConfigArn: aws.String(tfMap["antenna_uplink_config_arn"].(string)),
+			Enabled:                aws.Bool(tfMap["enabled"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSpectrumConfig(tfList []interface{}) *groundstation.SpectrumConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &groundstation.SpectrumConfig{
+		CenterFrequency: expandFrequency(tfMap["center_frequency"].([]interface{})),
+	}
+
+	if v, ok := tfMap["bandwidth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Bandwidth = &groundstation.FrequencyBandwidth{
+			Units: aws.String(tfMap["units"].(string)),
+			Value: aws.Float64(tfMap["value"].(float64)),
+		}
+	}
+
+	if v, ok := tfMap["polarization"].(string); ok && v != "" {
+		apiObject.Polarization = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAntennaUplinkConfig(tfMap map[string]interface{}) *groundstation.AntennaUplinkConfig {
+	apiObject := &groundstation.AntennaUplinkConfig{}
+
+	if v, ok := tfMap["spectrum_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SpectrumConfig = &groundstation.UplinkSpectrumConfig{
+			CenterFrequency: expandFrequency(tfMap["center_frequency"].([]interface{})),
+		}
+
+		if v, ok := tfMap["polarization"].(string); ok && v != "" {
+			apiObject.SpectrumConfig.Polarization = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["target_eirp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TargetEirp = &groundstation.Eirp{
+			Units: aws.String(tfMap["units"].(string)),
+			Value: aws.Float64(tfMap["value"].(float64)),
+		}
+	}
+
+	if v, ok := tfMap["transmit_disabled"].(bool); ok {
+		apiObject.TransmitDisabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandFrequency(tfList []interface{}) *groundstation.Frequency {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &groundstation.Frequency{
+		Units: aws.String(tfMap["units"].(string)),
+		Value: aws.Float64(tfMap["value"].(float64)),
+	}
+}
+
+func expandUnvalidatedJSON(tfList []interface{}) *string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return aws.String(tfList[0].(map[string]interface{})["unvalidated_json"].(string))
+}
+
+func flattenConfigTypeData(apiObject *groundstation.ConfigTypeData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AntennaDownlinkConfig; v != nil {
+		tfMap["antenna_downlink_config"] = []interface{}{map[string]interface{}{
+			"spectrum_config": flattenSpectrumConfig(v.SpectrumConfig),
+		}}
+	}
+
+	if v := apiObject.AntennaDownlinkDemodDecodeConfig; v != nil {
+		tfMapDemodDecode := map[string]interface{}{
+			"spectrum_config": flattenSpectrumConfig(v.SpectrumConfig),
+		}
+
+		if v := v.DecodeConfig; v != nil {
+			tfMapDemodDecode["decode_config"] = flattenUnvalidatedJSON(v.UnvalidatedJSON)
+		}
+
+		if v := v.DemodulationConfig; v != nil {
+			tfMapDemodDecode["demodulation_config"] = flattenUnvalidatedJSON(v.UnvalidatedJSON)
+		}
+
+		tfMap["antenna_downlink_demod_decode_config"] = []interface{}{tfMapDemodDecode}
+	}
+
+	if v := apiObject.AntennaUplinkConfig; v != nil {
+		tfMap["antenna_uplink_config"] = []interface{}{flattenAntennaUplinkConfig(v)}
+	}
+
+	if v := apiObject.DataflowEndpointConfig; v != nil {
+		tfMap["dataflow_endpoint_config"] = []interface{}{map[string]interface{}{
+			"dataflow_endpoint_name":   aws.StringValue(v.DataflowEndpointName),
+			"dataflow_endpoint_region": aws.StringValue(v.DataflowEndpointRegion),
+		}}
+	}
+
+	if v := apiObject.S3RecordingConfig; v != nil {
+		tfMap["s3_recording_config"] = []interface{}{map[string]interface{}{
+			"bucket_arn": aws.StringValue(v.BucketArn),
+			"prefix":     aws.StringValue(v.Prefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if v := apiObject.TrackingConfig; v != nil {
+		tfMap["tracking_config"] = []interface{}{map[string]interface{}{
+			"autotrack": aws.StringValue(v.Autotrack),
+		}}
+	}
+
+	if v := apiObject.UplinkEchoConfig; v != nil {
+		tfMap["uplink_echo_config"] = []interface{}{map[string]interface{}{
+			"antenna_uplink_config_arn": aws.StringValue(v.AntennaUplinkConfigArn),
+			"enabled":                   aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSpectrumConfig(apiObject *groundstation.SpectrumConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"center_frequency": flattenFrequency(apiObject.CenterFrequency),
+		"polarization":     aws.StringValue(apiObject.Polarization),
+	}
+
+	if v := apiObject.Bandwidth; v != nil {
+		tfMap["bandwidth"] = []interface{}{map[string]interface{}{
+			"units": aws.StringValue(v.Units),
+			"value": aws.Float64Value(v.Value),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAntennaUplinkConfig(apiObject *groundstation.AntennaUplinkConfig) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"transmit_disabled": aws.BoolValue(apiObject.TransmitDisabled),
+	}
+
+	if v := apiObject.SpectrumConfig; v != nil {
+		tfMap["spectrum_config"] = []interface{}{map[string]interface{}{
+			"center_frequency": flattenFrequency(v.CenterFrequency),
+			"polarization":     aws.StringValue(v.Polarization),
+		}}
+	}
+
+	if v := apiObject.TargetEirp; v != nil {
+		tfMap["target_eirp"] = []interface{}{map[string]interface{}{
+			"units": aws.StringValue(v.Units),
+			"value": aws.Float64Value(v.Value),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenFrequency(apiObject *groundstation.Frequency) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"units": aws.StringValue(apiObject.Units),
+		"value": aws.Float64Value(apiObject.Value),
+	}}
+}
+
+func flattenUnvalidatedJSON(v *string) []interface{} {
+	if v == nil {
+		return nil
+	}
+
+	json, _ := structure.NormalizeJsonString(aws.StringValue(v))
+
+	return []interface{}{map[string]interface{}{
+		"unvalidated_json": json,
+	}}
+}
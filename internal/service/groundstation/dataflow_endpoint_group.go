@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_groundstation_dataflow_endpoint_group", name="Dataflow Endpoint Group")
+// @Tags(identifierAttribute="arn")
+func ResourceDataflowEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataflowEndpointGroupCreate,
+		ReadWithoutTimeout:   resourceDataflowEndpointGroupRead,
+		UpdateWithoutTimeout: resourceDataflowEndpointGroupUpdate,
+		DeleteWithoutTimeout: resourceDataflowEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_post_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(120, 480),
+			},
+			"contact_pre_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(120, 480),
+			},
+			"endpoint_details": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"mtu": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1400, 1500),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"security_details": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDataflowEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	input := &groundstation.CreateDataflowEndpointGroupInput{
+		EndpointDetails: expandEndpointDetailsList(d.Get("endpoint_details").(*schema.Set).List()),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_post_pass_duration_seconds"); ok {
+		input.ContactPostPassDurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("contact_pre_pass_duration_seconds"); ok {
+		input.ContactPrePassDurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.CreateDataflowEndpointGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Dataflow Endpoint Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DataflowEndpointGroupId))
+
+	return append(diags, resourceDataflowEndpointGroupRead(ctx, d, meta)...)
+}
+
+func resourceDataflowEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	output, err := FindDataflowEndpointGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Dataflow Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Dataflow Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.DataflowEndpointGroupArn)
+	d.Set("contact_post_pass_duration_seconds", output.ContactPostPassDurationSeconds)
+	d.Set("contact_pre_pass_duration_seconds", output.ContactPrePassDurationSeconds)
+	if err := d.Set("endpoint_details", flattenEndpointDetailsList(output.EndpointsDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint_details: %s", err)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceDataflowEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDataflowEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceDataflowEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Ground Station Dataflow Endpoint Group: %s", d.Id())
+	_, err := conn.DeleteDataflowEndpointGroupWithContext(ctx, &groundstation.DeleteDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Dataflow Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDataflowEndpointGroupByID(ctx context.Context, conn *groundstation.GroundStation, id string) (*groundstation.GetDataflowEndpointGroupOutput, error) {
+	input := &groundstation.GetDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetDataflowEndpointGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandEndpointDetailsList(tfList []interface{}) []*groundstation.EndpointDetails {
+	var apiObjects []*groundstation.EndpointDetails
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &groundstation.EndpointDetails{}
+
+		if v, ok := tfMap["endpoint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Endpoint = expandDataflowEndpoint(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["security_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SecurityDetails = &groundstation.SecurityDetails{
+				RoleArn:          aws.String(tfMap["role_arn"].(string)),
+				SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+				SubnetIds:        flex.ExpandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDataflowEndpoint(tfMap map[string]interface{}) *groundstation.DataflowEndpoint {
+	apiObject := &groundstation.DataflowEndpoint{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["address"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Address = &groundstation.SocketAddress{
+			Name: aws.String(tfMap["name"].(string)),
+			Port: aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["mtu"].(int); ok && v != 0 {
+		apiObject.Mtu = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenEndpointDetailsList(apiObjects []*groundstation.EndpointDetails) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Endpoint; v != nil {
+			tfMapEndpoint := map[string]interface{}{
+				"mtu":  aws.Int64Value(v.Mtu),
+				"name": aws.StringValue(v.Name),
+			}
+
+			if v := v.Address; v != nil {
+				tfMapEndpoint["address"] = []interface{}{map[string]interface{}{
+					"name": aws.StringValue(v.Name),
+					"port": aws.Int64Value(v.Port),
+				}}
+			}
+
+			tfMap["endpoint"] = []interface{}{tfMapEndpoint}
+		}
+
+		if v := apiObject.SecurityDetails; v != nil {
+			tfMap["security_details"] = []interface{}{map[string]interface{}{
+				"role_arn":           aws.StringValue(v.RoleArn),
+				"security_group_ids": aws.StringValueSlice(v.SecurityGroupIds),
+				"subnet_ids":         aws.StringValueSlice(v.SubnetIds),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_groundstation_mission_profile", name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+func ResourceMissionProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMissionProfileCreate,
+		ReadWithoutTimeout:   resourceMissionProfileRead,
+		UpdateWithoutTimeout: resourceMissionProfileUpdate,
+		DeleteWithoutTimeout: resourceMissionProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_post_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 21600),
+			},
+			"contact_pre_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 21600),
+			},
+			"dataflow_edge": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"minimum_viable_contact_duration_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 21600),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"streams_kms_key": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"streams_kms_role"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_alias_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"streams_kms_key.0.kms_alias_arn", "streams_kms_key.0.kms_key_arn"},
+						},
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"streams_kms_key.0.kms_alias_arn", "streams_kms_key.0.kms_key_arn"},
+						},
+					},
+				},
+			},
+			"streams_kms_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"streams_kms_key"},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tracking_config_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceMissionProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &groundstation.CreateMissionProfileInput{
+		DataflowEdges:                       expandDataflowEdges(d.Get("dataflow_edge").([]interface{})),
+		MinimumViableContactDurationSeconds: aws.Int64(int64(d.Get("minimum_viable_contact_duration_seconds").(int))),
+		Name:                                aws.String(name),
+		Tags:                                getTagsIn(ctx),
+		TrackingConfigArn:                   aws.String(d.Get("tracking_config_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("contact_post_pass_duration_seconds"); ok {
+		input.ContactPostPassDurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("contact_pre_pass_duration_seconds"); ok {
+		input.ContactPrePassDurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("streams_kms_key"); ok {
+		input.StreamsKmsKey = expandKMSKey(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("streams_kms_role"); ok {
+		input.StreamsKmsRole = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateMissionProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Mission Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MissionProfileId))
+
+	return append(diags, resourceMissionProfileRead(ctx, d, meta)...)
+}
+
+func resourceMissionProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	output, err := FindMissionProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Mission Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Mission Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.MissionProfileArn)
+	d.Set("contact_post_pass_duration_seconds", output.ContactPostPassDurationSeconds)
+	d.Set("contact_pre_pass_duration_seconds", output.ContactPrePassDurationSeconds)
+	if err := d.Set("dataflow_edge", flattenDataflowEdges(output.DataflowEdges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dataflow_edge: %s", err)
+	}
+	d.Set("minimum_viable_contact_duration_seconds", output.MinimumViableContactDurationSeconds)
+	d.Set("name", output.Name)
+	if err := d.Set("streams_kms_key", flattenKMSKey(output.StreamsKmsKey)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting streams_kms_key: %s", err)
+	}
+	d.Set("streams_kms_role", output.StreamsKmsRole)
+	d.Set("tracking_config_arn", output.TrackingConfigArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceMissionProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &groundstation.UpdateMissionProfileInput{
+			ContactPostPassDurationSeconds:      aws.Int64(int64(d.Get("contact_post_pass_duration_seconds").(int))),
+			ContactPrePassDurationSeconds:       aws.Int64(int64(d.Get("contact_pre_pass_duration_seconds").(int))),
+			DataflowEdges:                       expandDataflowEdges(d.Get("dataflow_edge").([]interface{})),
+			MinimumViableContactDurationSeconds: aws.Int64(int64(d.Get("minimum_viable_contact_duration_seconds").(int))),
+			MissionProfileId:                    aws.String(d.Id()),
+			Name:                                aws.String(d.Get("name").(string)),
+			TrackingConfigArn:                   aws.String(d.Get("tracking_config_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("streams_kms_key"); ok {
+			input.StreamsKmsKey = expandKMSKey(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("streams_kms_role"); ok {
+			input.StreamsKmsRole = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateMissionProfileWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Ground Station Mission Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMissionProfileRead(ctx, d, meta)...)
+}
+
+func resourceMissionProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Ground Station Mission Profile: %s", d.Id())
+	_, err := conn.DeleteMissionProfileWithContext(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Mission Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindMissionProfileByID(ctx context.Context, conn *groundstation.GroundStation, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDataflowEdges(tfList []interface{}) [][]*string {
+	var apiObjects [][]*string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, aws.StringSlice([]string{tfMap["source"].(string), tfMap["destination"].(string)}))
+	}
+
+	return apiObjects
+}
+
+func flattenDataflowEdges(apiObjects [][]*string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if len(apiObject) != 2 {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"destination": aws.StringValue(apiObject[1]),
+			"source":      aws.StringValue(apiObject[0]),
+		})
+	}
+
+	return tfList
+}
+
+func expandKMSKey(tfList []interface{}) *groundstation.KmsKey {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &groundstation.KmsKey{}
+
+	if v, ok := tfMap["kms_alias_arn"].(string); ok && v != "" {
+		apiObject.KmsAliasArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenKMSKey(apiObject *groundstation.KmsKey) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"kms_alias_arn": aws.StringValue(apiObject.KmsAliasArn),
+		"kms_key_arn":   aws.StringValue(apiObject.KmsKeyArn),
+	}}
+}
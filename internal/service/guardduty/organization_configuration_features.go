@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// getMemberDetectorsMaxAccountIDs is the maximum number of account IDs in a GetMemberDetectors request.
+	getMemberDetectorsMaxAccountIDs = 50
+
+	memberRelationshipStatusEnabled = "Enabled"
+)
+
+// @SDKResource("aws_guardduty_organization_configuration_features", name="Organization Configuration Features")
+func ResourceOrganizationConfigurationFeatures() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationFeaturesPut,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationFeaturesRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationFeaturesPut,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationFeaturesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"feature": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_enable": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+									},
+								},
+							},
+						},
+						"auto_enable": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeature_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationFeaturesPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID := d.Get("detector_id").(string)
+	features := expandOrganizationFeatureConfigurations(d.Get("feature").(*schema.Set).List())
+
+	// Features removed from configuration are no longer auto-enabled.
+	if !d.IsNewResource() {
+		o, n := d.GetChange("feature")
+		configured := make(map[string]bool)
+		for _, tfMapRaw := range n.(*schema.Set).List() {
+			configured[tfMapRaw.(map[string]interface{})["name"].(string)] = true
+		}
+
+		for _, tfMapRaw := range o.(*schema.Set).List() {
+			if name := tfMapRaw.(map[string]interface{})["name"].(string); !configured[name] {
+				features = append(features, &guardduty.OrganizationFeatureConfiguration{
+					AutoEnable: aws.String(guardduty.OrgFeatureStatusNone),
+					Name:       aws.String(name),
+				})
+			}
+		}
+	}
+
+	if err := updateOrganizationFeatures(ctx, conn, detectorID, features); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Organization Configuration Features (%s): %s", detectorID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(detectorID)
+	}
+
+	return append(diags, resourceOrganizationConfigurationFeaturesRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationConfigurationFeaturesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	output, err := FindOrganizationConfigurationByDetectorID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Organization Configuration Features (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration Features (%s): %s", d.Id(), err)
+	}
+
+	members, err := findMemberDetectors(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration Features (%s) member detectors: %s", d.Id(), err)
+	}
+
+	// Only the configured features and additional configurations are managed. On import, all are.
+	configured := make(map[string]map[string]bool)
+	for _, tfMapRaw := range d.Get("feature").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		additional := make(map[string]bool)
+		for _, v := range tfMap["additional_configuration"].(*schema.Set).List() {
+			additional[v.(map[string]interface{})["name"].(string)] = true
+		}
+		configured[tfMap["name"].(string)] = additional
+	}
+
+	var tfList []interface{}
+	for _, apiObject := range output.Features {
+		if apiObject == nil {
+			continue
+		}
+
+		name := aws.StringValue(apiObject.Name)
+		additional, ok := configured[name]
+		if len(configured) > 0 && !ok {
+			continue
+		}
+
+		tfMap := flattenOrganizationFeatureConfigurationResult(apiObject, additional)
+
+		// A feature that is auto-enabled for ALL members but is disabled in
+		// an associated member account has diverged; record it as NEW so that
+		// the next apply enables it in all member accounts again.
+		if aws.StringValue(apiObject.AutoEnable) == guardduty.OrgFeatureStatusAll {
+			if accountIDs := memberAccountIDsWithFeatureDisabled(members, name); len(accountIDs) > 0 {
+				log.Printf("[WARN] GuardDuty Organization Configuration Features (%s) feature %s is disabled in member accounts: %s", d.Id(), name, strings.Join(accountIDs, ", "))
+				tfMap["auto_enable"] = guardduty.OrgFeatureStatusNew
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.Set("detector_id", d.Id())
+	if err := d.Set("feature", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting feature: %s", err)
+	}
+
+	return diags
+}
+
+func resourceOrganizationConfigurationFeaturesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	var features []*guardduty.OrganizationFeatureConfiguration
+	for _, tfMapRaw := range d.Get("feature").(*schema.Set).List() {
+		features = append(features, &guardduty.OrganizationFeatureConfiguration{
+			AutoEnable: aws.String(guardduty.OrgFeatureStatusNone),
+			Name:       aws.String(tfMapRaw.(map[string]interface{})["name"].(string)),
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Organization Configuration Features: %s", d.Id())
+	err := updateOrganizationFeatures(ctx, conn, d.Id(), features)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GuardDuty Organization Configuration Features (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateOrganizationFeatures updates the organization's feature configuration,
+// leaving the organization's member auto-enablement unchanged.
+func updateOrganizationFeatures(ctx context.Context, conn *guardduty.GuardDuty, detectorID string, features []*guardduty.OrganizationFeatureConfiguration) error {
+	output, err := FindOrganizationConfigurationByDetectorID(ctx, conn, detectorID)
+
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.UpdateOrganizationConfigurationInput{
+		AutoEnableOrganizationMembers: output.AutoEnableOrganizationMembers,
+		DetectorId:                    aws.String(detectorID),
+		Features:                      features,
+	}
+
+	_, err = conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	return err
+}
+
+func FindOrganizationConfigurationByDetectorID(ctx context.Context, conn *guardduty.GuardDuty, detectorID string) (*guardduty.DescribeOrganizationConfigurationOutput, error) {
+	input := &guardduty.DescribeOrganizationConfigurationInput{
+		DetectorId: aws.String(detectorID),
+	}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findMemberDetectors returns the detector configuration of each member account associated with the administrator's detector.
+func findMemberDetectors(ctx context.Context, conn *guardduty.GuardDuty, detectorID string) ([]*guardduty.MemberDataSourceConfiguration, error) {
+	input := &guardduty.ListMembersInput{
+		DetectorId:     aws.String(detectorID),
+		OnlyAssociated: aws.String("true"),
+	}
+	var accountIDs []*string
+
+	err := conn.ListMembersPagesWithContext(ctx, input, func(page *guardduty.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Members {
+			if v != nil && aws.StringValue(v.RelationshipStatus) == memberRelationshipStatusEnabled {
+				accountIDs = append(accountIDs, v.AccountId)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*guardduty.MemberDataSourceConfiguration
+
+	for _, chunk := range tfslices.Chunks(accountIDs, getMemberDetectorsMaxAccountIDs) {
+		page, err := conn.GetMemberDetectorsWithContext(ctx, &guardduty.GetMemberDetectorsInput{
+			AccountIds: chunk,
+			DetectorId: aws.String(detectorID),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MemberDataSourceConfigurations...)
+	}
+
+	return output, nil
+}
+
+func memberAccountIDsWithFeatureDisabled(members []*guardduty.MemberDataSourceConfiguration, name string) []string {
+	var accountIDs []string
+
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+
+		for _, feature := range member.Features {
+			if aws.StringValue(feature.Name) == name && aws.StringValue(feature.Status) == guardduty.FeatureStatusDisabled {
+				accountIDs = append(accountIDs, aws.StringValue(member.AccountId))
+			}
+		}
+	}
+
+	return accountIDs
+}
+
+func expandOrganizationFeatureConfigurations(tfList []interface{}) []*guardduty.OrganizationFeatureConfiguration {
+	var apiObjects []*guardduty.OrganizationFeatureConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.OrganizationFeatureConfiguration{
+			AutoEnable: aws.String(tfMap["auto_enable"].(string)),
+			Name:       aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["additional_configuration"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AdditionalConfiguration = expandOrganizationAdditionalConfigurations(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOrganizationAdditionalConfigurations(tfList []interface{}) []*guardduty.OrganizationAdditionalConfiguration {
+	var apiObjects []*guardduty.OrganizationAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &guardduty.OrganizationAdditionalConfiguration{
+			AutoEnable: aws.String(tfMap["auto_enable"].(string)),
+			Name:       aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenOrganizationFeatureConfigurationResult flattens the feature's configuration.
+// If additional is not empty, only the named additional configurations are included.
+func flattenOrganizationFeatureConfigurationResult(apiObject *guardduty.OrganizationFeatureConfigurationResult, additional map[string]bool) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"auto_enable": aws.StringValue(apiObject.AutoEnable),
+		"name":        aws.StringValue(apiObject.Name),
+	}
+
+	var tfList []interface{}
+	for _, v := range apiObject.AdditionalConfiguration {
+		if v == nil || (len(additional) > 0 && !additional[aws.StringValue(v.Name)]) {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"auto_enable": aws.StringValue(v.AutoEnable),
+			"name":        aws.StringValue(v.Name),
+		})
+	}
+	tfMap["additional_configuration"] = tfList
+
+	return tfMap
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccOrganizationConfigurationFeatures_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	detectorResourceName := "aws_guardduty_detector.test"
+	resourceName := "aws_guardduty_organization_configuration_features.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeaturesConfig_basic("ALL", "NEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", detectorResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":        "S3_DATA_EVENTS",
+						"auto_enable": "ALL",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":        "EKS_AUDIT_LOGS",
+						"auto_enable": "NEW",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":                       "RUNTIME_MONITORING",
+						"auto_enable":                "NEW",
+						"additional_configuration.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*.additional_configuration.*", map[string]string{
+						"name":        "EKS_ADDON_MANAGEMENT",
+						"auto_enable": "NEW",
+					}),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationFeaturesConfig_basic("NONE", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":        "S3_DATA_EVENTS",
+						"auto_enable": "NONE",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":        "EKS_AUDIT_LOGS",
+						"auto_enable": "ALL",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationFeaturesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		_, err := tfguardduty.FindOrganizationConfigurationByDetectorID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccOrganizationConfigurationFeaturesConfig_basic(s3AutoEnable, eksAuditLogsAutoEnable string) string {
+	return acctest.ConfigCompose(
+		testAccOrganizationConfigurationConfigBase,
+		fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable_organization_members = "NEW"
+  detector_id                      = aws_guardduty_detector.test.id
+}
+
+resource "aws_guardduty_organization_configuration_features" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+
+  feature {
+    name        = "S3_DATA_EVENTS"
+    auto_enable = %[1]q
+  }
+
+  feature {
+    name        = "EKS_AUDIT_LOGS"
+    auto_enable = %[2]q
+  }
+
+  feature {
+    name        = "EBS_MALWARE_PROTECTION"
+    auto_enable = "NEW"
+  }
+
+  feature {
+    name        = "RDS_LOGIN_EVENTS"
+    auto_enable = "NEW"
+  }
+
+  feature {
+    name        = "RUNTIME_MONITORING"
+    auto_enable = "NEW"
+
+    additional_configuration {
+      name        = "EKS_ADDON_MANAGEMENT"
+      auto_enable = "NEW"
+    }
+  }
+}
+`, s3AutoEnable, eksAuditLogsAutoEnable))
+}
@@ -79,7 +79,7 @@ func resourcePublishingDestinationCreate(ctx context.Context, d *schema.Resource
 
 	d.SetId(fmt.Sprintf("%s:%s", d.Get("detector_id"), aws.StringValue(output.DestinationId)))
 
-	_, err = waitPublishingDestinationCreated(ctx, conn, aws.StringValue(output.DestinationId), detectorID)
+	err = waitPublishingDestinationVerified(ctx, conn, aws.StringValue(output.DestinationId), detectorID, input.DestinationProperties)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty PublishingDestination status to be \"%s\": %s",
@@ -144,6 +144,10 @@ func resourcePublishingDestinationUpdate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
 	}
 
+	if err := waitPublishingDestinationVerified(ctx, conn, destinationId, detectorId, input.DestinationProperties); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Publishing Destination (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourcePublishingDestinationRead(ctx, d, meta)...)
 }
 
@@ -79,6 +79,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOrganizationConfiguration,
 			TypeName: "aws_guardduty_organization_configuration",
 		},
+		{
+			Factory:  ResourceOrganizationConfigurationFeatures,
+			TypeName: "aws_guardduty_organization_configuration_features",
+			Name:     "Organization Configuration Features",
+		},
 		{
 			Factory:  ResourcePublishingDestination,
 			TypeName: "aws_guardduty_publishing_destination",
@@ -5,10 +5,13 @@ package guardduty
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,6 +24,11 @@ const (
 	// Maximum amount of time to wait for a PublishingDestination to return Publishing
 	publishingDestinationCreatedTimeout = 5 * time.Minute
 
+	// Maximum amount of time to retry verification of a PublishingDestination
+	// GuardDuty verifies access to the destination bucket and KMS key once, so a
+	// bucket or key policy that has not yet propagated leaves the destination unable to publish.
+	publishingDestinationVerifiedTimeout = 10 * time.Minute
+
 	// Maximum amount of time to wait for membership to propagate
 	// When removing Organization Admin Accounts, there is eventual
 	// consistency even after the account is no longer listed.
@@ -82,3 +90,33 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Guard
 
 	return nil, err
 }
+
+// waitPublishingDestinationVerified waits for GuardDuty to return Publishing,
+// resubmitting the destination properties to request another verification while the destination is unable to publish.
+func waitPublishingDestinationVerified(ctx context.Context, conn *guardduty.GuardDuty, destinationID, detectorID string, properties *guardduty.DestinationProperties) error {
+	_, err := tfresource.RetryWhen(ctx, publishingDestinationVerifiedTimeout,
+		func() (interface{}, error) {
+			return waitPublishingDestinationCreated(ctx, conn, destinationID, detectorID)
+		},
+		func(err error) (bool, error) {
+			var e *retry.UnexpectedStateError
+			if !errors.As(err, &e) || e.State != guardduty.PublishingStatusUnableToPublishFixDestinationProperty {
+				return false, err
+			}
+
+			input := &guardduty.UpdatePublishingDestinationInput{
+				DestinationId:         aws.String(destinationID),
+				DestinationProperties: properties,
+				DetectorId:            aws.String(detectorID),
+			}
+
+			if _, err := conn.UpdatePublishingDestinationWithContext(ctx, input); err != nil {
+				return false, err
+			}
+
+			return true, err
+		},
+	)
+
+	return err
+}
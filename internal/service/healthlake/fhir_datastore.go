@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFHIRDatastoreCreate,
+		ReadWithoutTimeout:   resourceFHIRDatastoreRead,
+		UpdateWithoutTimeout: resourceFHIRDatastoreUpdate,
+		DeleteWithoutTimeout: resourceFHIRDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FHIRVersion](),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_provider_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_strategy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AuthorizationStrategy](),
+						},
+						"fine_grained_authorization_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"idp_lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"metadata": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PreloadDataType](),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.CmkType](),
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceFHIRDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		DatastoreTypeVersion: awstypes.FHIRVersion(d.Get("datastore_type_version").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("identity_provider_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IdentityProviderConfiguration = expandIdentityProviderConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PreloadDataConfig = &awstypes.PreloadDataConfig{
+			PreloadDataType: awstypes.PreloadDataType(v.([]interface{})[0].(map[string]interface{})["preload_data_type"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfiguration = expandSseConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateFHIRDatastore(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating HealthLake FHIR Datastore: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DatastoreId))
+
+	if _, err := waitFHIRDatastoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for HealthLake FHIR Datastore (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFHIRDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	datastore, err := FindFHIRDatastoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", datastore.DatastoreArn)
+	if datastore.CreatedAt != nil {
+		d.Set("created_at", aws.ToTime(datastore.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("datastore_type_version", datastore.DatastoreTypeVersion)
+	d.Set("endpoint", datastore.DatastoreEndpoint)
+	if datastore.IdentityProviderConfiguration != nil {
+		if err := d.Set("identity_provider_configuration", []interface{}{flattenIdentityProviderConfiguration(datastore.IdentityProviderConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting identity_provider_configuration: %s", err)
+		}
+	} else {
+		d.Set("identity_provider_configuration", nil)
+	}
+	d.Set("name", datastore.DatastoreName)
+	if datastore.PreloadDataConfig != nil {
+		if err := d.Set("preload_data_config", []interface{}{map[string]interface{}{
+			"preload_data_type": datastore.PreloadDataConfig.PreloadDataType,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting preload_data_config: %s", err)
+		}
+	} else {
+		d.Set("preload_data_config", nil)
+	}
+	if datastore.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenSseConfiguration(datastore.SseConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting sse_configuration: %s", err)
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+	d.Set("status", datastore.DatastoreStatus)
+
+	return diags
+}
+
+func resourceFHIRDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient(ctx)
+
+	log.Printf("[DEBUG] Deleting HealthLake FHIR Datastore: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastore(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for HealthLake FHIR Datastore (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*awstypes.DatastoreProperties, error) {
+	input := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeFHIRDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.DatastoreProperties.DatastoreStatus; status == awstypes.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DatastoreStatusCreating),
+		Target:  enum.Slice(awstypes.DatastoreStatusActive),
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DatastoreStatusActive, awstypes.DatastoreStatusDeleting),
+		Target:  []string{},
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandIdentityProviderConfiguration(tfMap map[string]interface{}) *awstypes.IdentityProviderConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.IdentityProviderConfiguration{
+		AuthorizationStrategy: awstypes.AuthorizationStrategy(tfMap["authorization_strategy"].(string)),
+	}
+
+	if v, ok := tfMap["fine_grained_authorization_enabled"].(bool); ok {
+		apiObject.FineGrainedAuthorizationEnabled = v
+	}
+
+	if v, ok := tfMap["idp_lambda_arn"].(string); ok && v != "" {
+		apiObject.IdpLambdaArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata"].(string); ok && v != "" {
+		apiObject.Metadata = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIdentityProviderConfiguration(apiObject *awstypes.IdentityProviderConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authorization_strategy":             apiObject.AuthorizationStrategy,
+		"fine_grained_authorization_enabled": apiObject.FineGrainedAuthorizationEnabled,
+	}
+
+	if v := apiObject.IdpLambdaArn; v != nil {
+		tfMap["idp_lambda_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Metadata; v != nil {
+		tfMap["metadata"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandSseConfiguration(tfMap map[string]interface{}) *awstypes.SseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KmsEncryptionConfig = &awstypes.KmsEncryptionConfig{
+			CmkType: awstypes.CmkType(tfMap["cmk_type"].(string)),
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			apiObject.KmsEncryptionConfig.KmsKeyId = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSseConfiguration(apiObject *awstypes.SseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{map[string]interface{}{
+			"cmk_type":   v.CmkType,
+			"kms_key_id": aws.ToString(v.KmsKeyId),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_credential_report")
+func DataSourceCredentialReport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCredentialReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"generated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_1_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"access_key_1_age_in_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"access_key_1_last_rotated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_1_last_used_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_1_last_used_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_1_last_used_service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_2_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"access_key_2_age_in_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"access_key_2_last_rotated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_2_last_used_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_2_last_used_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_key_2_last_used_service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_1_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cert_1_last_rotated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_2_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cert_2_last_rotated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mfa_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"password_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"password_last_changed": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"password_last_used": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"password_next_rotation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameCredentialReport = "Credential Report Data Source"
+)
+
+func dataSourceCredentialReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+	timeout := d.Timeout(schema.TimeoutRead)
+
+	if err := waitCredentialReportGenerated(ctx, conn, timeout); err != nil {
+		return create.DiagError(names.IAM, create.ErrActionWaitingForCreation, DSNameCredentialReport, "", err)
+	}
+
+	// A report can be reported as generated before it is available for download.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.GetCredentialReportWithContext(ctx, &iam.GetCredentialReportInput{})
+	}, iam.ErrCodeCredentialReportNotReadyException)
+
+	if err != nil {
+		return create.DiagError(names.IAM, create.ErrActionReading, DSNameCredentialReport, "", err)
+	}
+
+	output := outputRaw.(*iam.GetCredentialReportOutput)
+
+	if format := aws.StringValue(output.ReportFormat); format != iam.ReportFormatTypeTextCsv {
+		return create.DiagError(names.IAM, create.ErrActionReading, DSNameCredentialReport, "", fmt.Errorf("unsupported report format: %s", format))
+	}
+
+	users, err := parseCredentialReport(output.Content, time.Now())
+
+	if err != nil {
+		return create.DiagError(names.IAM, create.ErrActionReading, DSNameCredentialReport, "", err)
+	}
+
+	generatedTime := aws.TimeValue(output.GeneratedTime)
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("generated_time", generatedTime.Format(time.RFC3339))
+
+	if err := d.Set("users", users); err != nil {
+		return create.DiagError(names.IAM, create.ErrActionSetting, DSNameCredentialReport, d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitCredentialReportGenerated(ctx context.Context, conn *iam.IAM, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iam.ReportStateTypeStarted, iam.ReportStateTypeInprogress},
+		Target:  []string{iam.ReportStateTypeComplete},
+		Refresh: statusCredentialReport(ctx, conn),
+		Timeout: timeout,
+		Delay:   1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// statusCredentialReport starts generation of a credential report if one is not already in progress.
+// A report generated within the last four hours is reused by IAM.
+func statusCredentialReport(ctx context.Context, conn *iam.IAM) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GenerateCredentialReportWithContext(ctx, &iam.GenerateCredentialReportInput{})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+const (
+	credentialReportValueNotApplicable = "N/A"
+	credentialReportValueNotSupported  = "not_supported"
+	credentialReportValueNoInformation = "no_information"
+)
+
+// credentialReportStringAttributes lists the credential report CSV columns exposed as string attributes.
+var credentialReportStringAttributes = []string{
+	"access_key_1_last_rotated",
+	"access_key_1_last_used_date",
+	"access_key_1_last_used_region",
+	"access_key_1_last_used_service",
+	"access_key_2_last_rotated",
+	"access_key_2_last_used_date",
+	"access_key_2_last_used_region",
+	"access_key_2_last_used_service",
+	"arn",
+	"cert_1_last_rotated",
+	"cert_2_last_rotated",
+	"password_last_changed",
+	"password_last_used",
+	"password_next_rotation",
+	"user",
+	"user_creation_time",
+}
+
+// credentialReportBoolAttributes lists the credential report CSV columns exposed as bool attributes.
+var credentialReportBoolAttributes = []string{
+	"access_key_1_active",
+	"access_key_2_active",
+	"cert_1_active",
+	"cert_2_active",
+	"mfa_active",
+	"password_enabled",
+}
+
+func parseCredentialReport(content []byte, now time.Time) ([]interface{}, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+
+	header, err := r.Read()
+
+	if err != nil {
+		return nil, fmt.Errorf("reading credential report header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, v := range header {
+		columns[v] = i
+	}
+
+	var tfList []interface{}
+
+	for {
+		record, err := r.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading credential report: %w", err)
+		}
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				switch v := record[i]; v {
+				case credentialReportValueNotApplicable, credentialReportValueNotSupported, credentialReportValueNoInformation:
+					return ""
+				default:
+					return v
+				}
+			}
+
+			return ""
+		}
+
+		tfMap := map[string]interface{}{}
+
+		for _, k := range credentialReportStringAttributes {
+			tfMap[k] = value(k)
+		}
+
+		for _, k := range credentialReportBoolAttributes {
+			tfMap[k] = value(k) == "true"
+		}
+
+		for _, k := range []string{"access_key_1", "access_key_2"} {
+			tfMap[k+"_age_in_days"] = credentialReportAgeInDays(value(k+"_last_rotated"), now)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+// credentialReportAgeInDays returns the number of whole days between the specified
+// credential report timestamp and now, or 0 if the timestamp is not set.
+func credentialReportAgeInDays(v string, now time.Time) int {
+	t, err := time.Parse(time.RFC3339, v)
+
+	if err != nil {
+		return 0
+	}
+
+	return int(math.Floor(now.Sub(t).Hours() / 24))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestParseCredentialReport(t *testing.T) {
+	t.Parallel()
+
+	//lintignore:AWSAT005
+	content := `user,arn,user_creation_time,password_enabled,password_last_used,password_last_changed,password_next_rotation,mfa_active,access_key_1_active,access_key_1_last_rotated,access_key_1_last_used_date,access_key_1_last_used_region,access_key_1_last_used_service,access_key_2_active,access_key_2_last_rotated,access_key_2_last_used_date,access_key_2_last_used_region,access_key_2_last_used_service,cert_1_active,cert_1_last_rotated,cert_2_active,cert_2_last_rotated
+<root_account>,arn:aws:iam::123456789012:root,2020-01-01T00:00:00+00:00,not_supported,2023-09-30T10:00:00+00:00,not_supported,not_supported,true,false,N/A,N/A,N/A,N/A,false,N/A,N/A,N/A,N/A,false,N/A,false,N/A
+alice,arn:aws:iam::123456789012:user/alice,2021-06-01T00:00:00+00:00,true,no_information,2021-06-01T00:00:00+00:00,N/A,false,true,2023-06-03T12:00:00+00:00,2023-09-30T08:00:00+00:00,us-east-1,s3,false,N/A,N/A,N/A,N/A,false,N/A,false,N/A
+`
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	users, err := tfiam.ParseCredentialReport([]byte(content), now)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(users), 2; got != want {
+		t.Fatalf("got %d users, want %d", got, want)
+	}
+
+	root := users[0].(map[string]interface{})
+
+	if got, want := root["user"], "<root_account>"; got != want {
+		t.Errorf("root user: got %q, want %q", got, want)
+	}
+	if got, want := root["password_enabled"], false; got != want {
+		t.Errorf("root password_enabled: got %t, want %t", got, want)
+	}
+	if got, want := root["mfa_active"], true; got != want {
+		t.Errorf("root mfa_active: got %t, want %t", got, want)
+	}
+	if got, want := root["access_key_1_last_rotated"], ""; got != want {
+		t.Errorf("root access_key_1_last_rotated: got %q, want %q", got, want)
+	}
+	if got, want := root["access_key_1_age_in_days"], 0; got != want {
+		t.Errorf("root access_key_1_age_in_days: got %d, want %d", got, want)
+	}
+
+	alice := users[1].(map[string]interface{})
+
+	if got, want := alice["password_enabled"], true; got != want {
+		t.Errorf("alice password_enabled: got %t, want %t", got, want)
+	}
+	if got, want := alice["password_last_used"], ""; got != want {
+		t.Errorf("alice password_last_used: got %q, want %q", got, want)
+	}
+	if got, want := alice["access_key_1_active"], true; got != want {
+		t.Errorf("alice access_key_1_active: got %t, want %t", got, want)
+	}
+	if got, want := alice["access_key_1_age_in_days"], 120; got != want {
+		t.Errorf("alice access_key_1_age_in_days: got %d, want %d", got, want)
+	}
+	if got, want := alice["access_key_1_last_used_service"], "s3"; got != want {
+		t.Errorf("alice access_key_1_last_used_service: got %q, want %q", got, want)
+	}
+}
+
+func TestAccIAMCredentialReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_credential_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialReportDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "generated_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.user", "<root_account>"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCredentialReportDataSourceConfig_basic = `
+data "aws_iam_credential_report" "test" {}
+`
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_iam_group_policy_attachment")
@@ -53,6 +54,8 @@ func resourceGroupPolicyAttachmentCreate(ctx context.Context, d *schema.Resource
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
 
+	diags = append(diags, verify.ARNPartitionWarnings(d, meta, "policy_arn")...)
+
 	err := attachPolicyToGroup(ctx, conn, group, arn)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "attaching policy %s to IAM group %s: %v", arn, group, err)
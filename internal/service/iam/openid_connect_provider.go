@@ -6,6 +6,7 @@ package iam
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -53,9 +54,16 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"thumbprint_auto_refresh": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"thumbprint_list"},
+			},
 			"thumbprint_list": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"thumbprint_auto_refresh"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
@@ -85,6 +93,17 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 		Url:            aws.String(d.Get("url").(string)),
 	}
 
+	if d.Get("thumbprint_auto_refresh").(bool) {
+		thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, aws.StringValue(input.Url), nil)
+
+		// IAM retrieves the thumbprint itself when none is supplied.
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "resolving IAM OIDC Provider (%s) thumbprint, leaving it to IAM: %s", aws.StringValue(input.Url), err)
+		} else {
+			input.ThumbprintList = aws.StringSlice([]string{thumbprint})
+		}
+	}
+
 	output, err := conn.CreateOpenIDConnectProviderWithContext(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -147,7 +166,25 @@ func resourceOpenIDConnectProviderUpdate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMConn(ctx)
 
-	if d.HasChange("thumbprint_list") {
+	if d.Get("thumbprint_auto_refresh").(bool) {
+		issuerURL := d.Get("url").(string)
+		thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, issuerURL, nil)
+
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "resolving IAM OIDC Provider (%s) thumbprint, keeping thumbprint_list: %s", issuerURL, err)
+		} else if !thumbprintListContains(d.Get("thumbprint_list").([]interface{}), thumbprint) {
+			input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
+				OpenIDConnectProviderArn: aws.String(d.Id()),
+				ThumbprintList:           aws.StringSlice([]string{thumbprint}),
+			}
+
+			_, err := conn.UpdateOpenIDConnectProviderThumbprintWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM OIDC Provider (%s) thumbprint: %s", d.Id(), err)
+			}
+		}
+	} else if d.HasChange("thumbprint_list") {
 		input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
 			OpenIDConnectProviderArn: aws.String(d.Id()),
 			ThumbprintList:           flex.ExpandStringList(d.Get("thumbprint_list").([]interface{})),
@@ -198,6 +235,16 @@ func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func thumbprintListContains(thumbprints []interface{}, thumbprint string) bool {
+	for _, v := range thumbprints {
+		if v, ok := v.(string); ok && strings.EqualFold(v, thumbprint) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func FindOpenIDConnectProviderByARN(ctx context.Context, conn *iam.IAM, arn string) (*iam.GetOpenIDConnectProviderOutput, error) {
 	input := &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(arn),
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // IAM OIDC provider thumbprints are SHA-1 fingerprints.
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	thumbprintRequestTimeout = 30 * time.Second
+)
+
+// findOpenIDConnectProviderThumbprint returns the thumbprint IAM expects for an OIDC identity provider:
+// the SHA-1 fingerprint of the last certificate in the chain served by the provider's JWKS endpoint.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func findOpenIDConnectProviderThumbprint(ctx context.Context, issuerURL string, tlsConfig *tls.Config) (string, error) {
+	if !strings.Contains(issuerURL, "://") {
+		issuerURL = "https://" + issuerURL
+	}
+
+	u, err := url.Parse(issuerURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	client := &http.Client{
+		Timeout: thumbprintRequestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	response, err := getOpenIDConnectProviderURL(ctx, client, strings.TrimSuffix(u.String(), "/")+"/.well-known/openid-configuration")
+
+	if err != nil {
+		return "", fmt.Errorf("retrieving OpenID configuration: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&configuration); err != nil {
+		return "", fmt.Errorf("decoding OpenID configuration: %w", err)
+	}
+
+	// The certificate chain is taken from the TLS connection of an HTTP request so that proxy settings are honored.
+	state := response.TLS
+
+	if configuration.JWKSURI != "" {
+		response, err := getOpenIDConnectProviderURL(ctx, client, configuration.JWKSURI)
+
+		if err != nil {
+			return "", fmt.Errorf("retrieving JWKS (%s): %w", configuration.JWKSURI, err)
+		}
+
+		response.Body.Close()
+
+		state = response.TLS
+	}
+
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return "", fmt.Errorf("no certificates presented by %s", issuerURL)
+	}
+
+	certificates := state.PeerCertificates
+	sum := sha1.Sum(certificates[len(certificates)-1].Raw) //nolint:gosec // IAM OIDC provider thumbprints are SHA-1 fingerprints.
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func getOpenIDConnectProviderURL(ctx context.Context, client *http.Client, rawURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+
+		return nil, fmt.Errorf("unexpected HTTP status %s", response.Status)
+	}
+
+	return response, nil
+}
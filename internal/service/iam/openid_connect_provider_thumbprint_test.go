@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // IAM OIDC provider thumbprints are SHA-1 fingerprints.
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFindOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	var jwksURI string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			fmt.Fprintf(w, `{"issuer":"%[1]s","jwks_uri":"%[2]s"}`, "https://"+r.Host, jwksURI)
+		case "/.well-known/jwks":
+			fmt.Fprint(w, `{"keys":[]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	jwksURI = server.URL + "/.well-known/jwks"
+
+	certificate := server.Certificate()
+	sum := sha1.Sum(certificate.Raw) //nolint:gosec // IAM OIDC provider thumbprints are SHA-1 fingerprints.
+	want := hex.EncodeToString(sum[:])
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+	tlsConfig := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	testCases := map[string]struct {
+		issuerURL string
+	}{
+		"with scheme": {
+			issuerURL: server.URL,
+		},
+		"without scheme": {
+			issuerURL: strings.TrimPrefix(server.URL, "https://"),
+		},
+		"trailing slash": {
+			issuerURL: server.URL + "/",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findOpenIDConnectProviderThumbprint(context.Background(), testCase.issuerURL, tlsConfig)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestFindOpenIDConnectProviderThumbprint_untrusted(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+
+	if _, err := findOpenIDConnectProviderThumbprint(context.Background(), server.URL, nil); err == nil {
+		t.Fatal("expected error for untrusted certificate")
+	}
+}
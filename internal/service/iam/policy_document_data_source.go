@@ -106,6 +106,20 @@ func DataSourcePolicyDocument() *schema.Resource {
 					},
 				},
 			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size_quota": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"customer_managed",
+					"group_inline",
+					"role_inline",
+					"user_inline",
+				}, false),
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -268,9 +282,25 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	size, err := policyDocumentSize(jsonString)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: %s", err)
+	}
+
 	d.Set("json", jsonString)
+	d.Set("size", size)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
+	if v, ok := d.GetOk("size_quota"); ok {
+		if warning := policySizeWarning(size, v.(string)); warning != "" {
+			diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document %s", warning)
+		}
+	}
+
+	for _, warning := range policyConditionWarnings(mergedDoc) {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document %s", warning)
+	}
+
 	return diags
 }
 
@@ -30,6 +30,7 @@ func TestAccIAMPolicyDocumentDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "json",
 						testAccPolicyDocumentExpectedJSON(),
 					),
+					resource.TestCheckResourceAttrSet("data.aws_iam_policy_document.test", "size"),
 				),
 			},
 		},
@@ -4,10 +4,13 @@
 package iam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -18,6 +21,26 @@ const (
 	policyModelMarshallJSONStartSliceSize = 2
 )
 
+// IAM policy character quotas.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+const (
+	policySizeLimitManaged     = 6144
+	policySizeLimitRoleInline  = 10240
+	policySizeLimitGroupInline = 5120
+	policySizeLimitUserInline  = 2048
+)
+
+// policySizeQuotas maps each policy type accepted by aws_iam_policy_document's size_quota argument to its IAM character quota.
+var policySizeQuotas = map[string]struct {
+	name  string
+	limit int
+}{
+	"customer_managed": {"customer managed policies", policySizeLimitManaged},
+	"group_inline":     {"group inline policies", policySizeLimitGroupInline},
+	"role_inline":      {"role inline policies", policySizeLimitRoleInline},
+	"user_inline":      {"user inline policies", policySizeLimitUserInline},
+}
+
 type IAMPolicyDoc struct {
 	Version    string                `json:",omitempty"`
 	Id         string                `json:",omitempty"`
@@ -298,3 +321,285 @@ func isValidPolicyAWSPrincipal(principal string) bool { // nosemgrep:ci.aws-in-f
 	}
 	return false
 }
+
+// policyDocumentSize returns the number of characters in a JSON policy document as counted against IAM quotas.
+// IAM does not count whitespace outside of string values.
+func policyDocumentSize(policy string) (int, error) {
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, []byte(policy)); err != nil {
+		return 0, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	return utf8.RuneCount(buf.Bytes()), nil
+}
+
+var (
+	// policyConditionOperators are the condition operators supported by IAM, keyed by lowercase name.
+	policyConditionOperators = map[string]struct{}{
+		"arnequals":                 {},
+		"arnlike":                   {},
+		"arnnotequals":              {},
+		"arnnotlike":                {},
+		"binaryequals":              {},
+		"bool":                      {},
+		"dateequals":                {},
+		"dategreaterthan":           {},
+		"dategreaterthanequals":     {},
+		"datelessthan":              {},
+		"datelessthanequals":        {},
+		"datenotequals":             {},
+		"ipaddress":                 {},
+		"notipaddress":              {},
+		"null":                      {},
+		"numericequals":             {},
+		"numericgreaterthan":        {},
+		"numericgreaterthanequals":  {},
+		"numericlessthan":           {},
+		"numericlessthanequals":     {},
+		"numericnotequals":          {},
+		"stringequals":              {},
+		"stringequalsignorecase":    {},
+		"stringlike":                {},
+		"stringnotequals":           {},
+		"stringnotequalsignorecase": {},
+		"stringnotlike":             {},
+	}
+
+	// policyGlobalConditionKeys are the AWS global condition context keys, keyed by lowercase name.
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+	policyGlobalConditionKeys = map[string]struct{}{
+		"aws:assumedroot":                  {},
+		"aws:calledvia":                    {},
+		"aws:calledviafirst":               {},
+		"aws:calledvialast":                {},
+		"aws:chatbotsourcearn":             {},
+		"aws:currenttime":                  {},
+		"aws:ec2instancesourceprivateipv4": {},
+		"aws:ec2instancesourcevpc":         {},
+		"aws:epochtime":                    {},
+		"aws:federatedprovider":            {},
+		"aws:multifactorauthage":           {},
+		"aws:multifactorauthpresent":       {},
+		"aws:principalaccount":             {},
+		"aws:principalarn":                 {},
+		"aws:principalisawsservice":        {},
+		"aws:principalorgid":               {},
+		"aws:principalorgpaths":            {},
+		"aws:principalservicename":         {},
+		"aws:principalservicenameslist":    {},
+		"aws:principaltype":                {},
+		"aws:referer":                      {},
+		"aws:requestedregion":              {},
+		"aws:resourceaccount":              {},
+		"aws:resourceorgid":                {},
+		"aws:resourceorgpaths":             {},
+		"aws:securetransport":              {},
+		"aws:sourceaccount":                {},
+		"aws:sourcearn":                    {},
+		"aws:sourceidentity":               {},
+		"aws:sourceip":                     {},
+		"aws:sourceorgid":                  {},
+		"aws:sourceorgpaths":               {},
+		"aws:sourceowner":                  {},
+		"aws:sourcevpc":                    {},
+		"aws:sourcevpcarn":                 {},
+		"aws:sourcevpce":                   {},
+		"aws:tagkeys":                      {},
+		"aws:tokenissuetime":               {},
+		"aws:useragent":                    {},
+		"aws:userid":                       {},
+		"aws:username":                     {},
+		"aws:viaawsservice":                {},
+		"aws:vpceaccount":                  {},
+		"aws:vpceorgid":                    {},
+		"aws:vpceorgpaths":                 {},
+		"aws:vpcsourceip":                  {},
+	}
+
+	// policyGlobalConditionKeyPrefixes are the AWS global condition context keys that take a tag key suffix.
+	policyGlobalConditionKeyPrefixes = []string{
+		"aws:principaltag/",
+		"aws:requesttag/",
+		"aws:resourcetag/",
+	}
+
+	// policyServiceConditionKeys are the published condition keys of services whose keys are checked, keyed by lowercase service prefix.
+	// Keys ending in "/" or ":" take a suffix, such as a tag key or an encryption context key.
+	// Keys of services not listed here are only checked for a service prefix.
+	// See https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
+	policyServiceConditionKeys = map[string][]string{
+		"iam": {
+			"iam:awsservicename",
+			"iam:associatedresourcearn",
+			"iam:fido-certification",
+			"iam:fido-fips-140-2-certification",
+			"iam:fido-fips-140-3-certification",
+			"iam:organizationspolicyid",
+			"iam:passedtoservice",
+			"iam:permissionsboundary",
+			"iam:policyarn",
+			"iam:registersecuritykey",
+			"iam:resourcetag/",
+			"iam:servicespecificcredentialagedays",
+			"iam:servicespecificcredentialservicename",
+		},
+		"kms": {
+			"kms:bypasspolicylockoutsafetycheck",
+			"kms:calleraccount",
+			"kms:customermasterkeyspec",
+			"kms:customermasterkeyusage",
+			"kms:datakeypairspec",
+			"kms:encryptionalgorithm",
+			"kms:encryptioncontext:",
+			"kms:encryptioncontextkeys",
+			"kms:expirationmodel",
+			"kms:grantconstrainttype",
+			"kms:grantisforawsresource",
+			"kms:grantoperations",
+			"kms:granteeprincipal",
+			"kms:keyagreementalgorithm",
+			"kms:keyorigin",
+			"kms:keyspec",
+			"kms:keyusage",
+			"kms:macalgorithm",
+			"kms:messagetype",
+			"kms:multiregion",
+			"kms:multiregionkeytype",
+			"kms:primaryregion",
+			"kms:reencryptonsamekey",
+			"kms:recipientattestation:",
+			"kms:replicaregion",
+			"kms:requestalias",
+			"kms:resourcealiases",
+			"kms:retiringprincipal",
+			"kms:rotationperiodindays",
+			"kms:schedulekeydeletionpendingwindowindays",
+			"kms:signingalgorithm",
+			"kms:validto",
+			"kms:viaservice",
+			"kms:wrappingalgorithm",
+			"kms:wrappingkeyspec",
+		},
+		"sns": {
+			"sns:endpoint",
+			"sns:protocol",
+		},
+		"sts": {
+			"sts:awsservicename",
+			"sts:durationseconds",
+			"sts:externalid",
+			"sts:requestcontext/",
+			"sts:requestcontextproviders",
+			"sts:rolesessionname",
+			"sts:sourceidentity",
+			"sts:taskpolicyarn",
+			"sts:transitivetagkeys",
+		},
+	}
+)
+
+// policySizeWarning returns a warning if a policy document's size exceeds the IAM character quota for the specified policy type,
+// or an empty string if it does not or the policy type is unknown.
+func policySizeWarning(size int, policyType string) string {
+	quota, ok := policySizeQuotas[policyType]
+	if !ok || size <= quota.limit {
+		return ""
+	}
+
+	warning := fmt.Sprintf("is %d characters, which exceeds the IAM character quota for %s (%d)", size, quota.name, quota.limit)
+	if policyType != "customer_managed" {
+		warning += ". Inline policy quotas apply to the total of all inline policies on a principal"
+	}
+
+	return warning
+}
+
+// policyConditionWarnings returns a description of each condition in a policy document whose operator is not supported by IAM,
+// or whose key is not a known global condition key or a service-prefixed key.
+func policyConditionWarnings(doc *IAMPolicyDoc) []string {
+	var warnings []string
+
+	for i, stmt := range doc.Statements {
+		name := strconv.Itoa(i)
+		if stmt.Sid != "" {
+			name = stmt.Sid
+		}
+
+		for _, c := range stmt.Conditions {
+			if !isValidPolicyConditionOperator(c.Test) {
+				warnings = append(warnings, fmt.Sprintf("statement (%s): unknown condition operator (%s)", name, c.Test))
+			}
+
+			if !isValidPolicyConditionKey(c.Variable) {
+				warnings = append(warnings, fmt.Sprintf("statement (%s): unknown condition key (%s)", name, c.Variable))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// isValidPolicyConditionOperator returns true if a string is a condition operator supported by IAM,
+// including the ForAllValues/ForAnyValue set operator qualifiers and the IfExists suffix.
+func isValidPolicyConditionOperator(operator string) bool {
+	operator = strings.ToLower(operator)
+
+	for _, prefix := range []string{"forallvalues:", "foranyvalue:"} {
+		if strings.HasPrefix(operator, prefix) {
+			operator = strings.TrimPrefix(operator, prefix)
+			break
+		}
+	}
+
+	if operator != "nullifexists" {
+		operator = strings.TrimSuffix(operator, "ifexists")
+	}
+
+	_, ok := policyConditionOperators[operator]
+
+	return ok
+}
+
+// isValidPolicyConditionKey returns true if a string is a known AWS global condition key or a service-prefixed condition key.
+// Service-specific keys are checked against the service's published condition keys when they are known,
+// and otherwise only for a non-empty prefix and name.
+func isValidPolicyConditionKey(key string) bool {
+	key = strings.ToLower(key)
+
+	prefix, name, ok := strings.Cut(key, ":")
+	if !ok || prefix == "" || name == "" {
+		return false
+	}
+
+	if prefix != "aws" {
+		keys, ok := policyServiceConditionKeys[prefix]
+		if !ok {
+			return true
+		}
+
+		for _, v := range keys {
+			if v == key {
+				return true
+			}
+
+			if (strings.HasSuffix(v, "/") || strings.HasSuffix(v, ":")) && strings.HasPrefix(key, v) && len(key) > len(v) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if _, ok := policyGlobalConditionKeys[key]; ok {
+		return true
+	}
+
+	for _, prefix := range policyGlobalConditionKeyPrefixes {
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
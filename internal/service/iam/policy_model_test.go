@@ -390,3 +390,192 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 		})
 	}
 }
+
+func TestPolicyDocumentSize(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		json     string
+		expected int
+	}{
+		"compact": {
+			json:     `{"Version":"2012-10-17"}`,
+			expected: 24,
+		},
+		"indented": {
+			json: `{
+  "Version": "2012-10-17",
+  "Statement": []
+}`,
+			expected: 39,
+		},
+		"whitespace in values": {
+			json:     `{ "Sid": "a b" }`,
+			expected: 13,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			size, err := policyDocumentSize(testcase.json)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if size != testcase.expected {
+				t.Errorf("expected %d, got %d", testcase.expected, size)
+			}
+		})
+	}
+}
+
+func TestPolicySizeWarning(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		size       int
+		policyType string
+		expected   string
+	}{
+		"no policy type": {
+			size: 20000,
+		},
+		"within quota": {
+			size:       2048,
+			policyType: "user_inline",
+		},
+		"user inline": {
+			size:       2049,
+			policyType: "user_inline",
+			expected:   "is 2049 characters, which exceeds the IAM character quota for user inline policies (2048). Inline policy quotas apply to the total of all inline policies on a principal",
+		},
+		"role inline": {
+			size:       6145,
+			policyType: "role_inline",
+		},
+		"customer managed": {
+			size:       6145,
+			policyType: "customer_managed",
+			expected:   "is 6145 characters, which exceeds the IAM character quota for customer managed policies (6144)",
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policySizeWarning(testcase.size, testcase.policyType); got != testcase.expected {
+				t.Errorf("expected %q, got %q", testcase.expected, got)
+			}
+		})
+	}
+}
+
+func TestPolicyConditionWarnings(t *testing.T) {
+	t.Parallel()
+
+	doc := &IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{
+				Sid: "Valid",
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "aws:SourceAccount"},
+					{Test: "ForAnyValue:StringLike", Variable: "s3:prefix"},
+				},
+			},
+			{
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringMatches", Variable: "kms:ViaService"},
+					{Test: "StringEquals", Variable: "aws:SourceArns"},
+					{Test: "StringEquals", Variable: "SourceArn"},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		"statement (1): unknown condition operator (StringMatches)",
+		"statement (1): unknown condition key (aws:SourceArns)",
+		"statement (1): unknown condition key (SourceArn)",
+	}
+
+	if got := policyConditionWarnings(doc); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestIsValidPolicyConditionOperator(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]bool{
+		"StringEquals":                   true,
+		"stringlike":                     true,
+		"ArnLikeIfExists":                true,
+		"ForAllValues:StringEquals":      true,
+		"ForAnyValue:StringLikeIfExists": true,
+		"Null":                           true,
+		"NullIfExists":                   false,
+		"StringMatches":                  false,
+		"ForEachValue:StringEquals":      false,
+		"":                               false,
+	}
+
+	for operator, expected := range testcases {
+		operator, expected := operator, expected
+
+		t.Run(operator, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isValidPolicyConditionOperator(operator); got != expected {
+				t.Errorf("expected %t, got %t", expected, got)
+			}
+		})
+	}
+}
+
+func TestIsValidPolicyConditionKey(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]bool{
+		"aws:SourceArn":                      true,
+		"aws:sourcearn":                      true,
+		"aws:PrincipalTag/team":              true,
+		"aws:ResourceTag/":                   false,
+		"aws:SourceArns":                     false,
+		"aws:SourceOwner":                    true,
+		"aws:SourceVpcArn":                   true,
+		"aws:VpceAccount":                    true,
+		"aws:VpceOrgID":                      true,
+		"iam:PassedToService":                true,
+		"iam:PassedToServices":               false,
+		"iam:ResourceTag/team":               true,
+		"kms:ViaService":                     true,
+		"kms:EncryptionContext:aws:s3:arn":   true,
+		"kms:EncryptionContext":              false,
+		"sts:ExternalId":                     true,
+		"sts:ExternalID2":                    false,
+		"s3:prefix":                          true,
+		"cognito-identity.amazonaws.com:aud": true,
+		"SourceArn":                          false,
+		":prefix":                            false,
+		"s3:":                                false,
+	}
+
+	for key, expected := range testcases {
+		key, expected := key, expected
+
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isValidPolicyConditionKey(key); got != expected {
+				t.Errorf("expected %t, got %t", expected, got)
+			}
+		})
+	}
+}
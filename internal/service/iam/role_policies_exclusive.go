@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRolePoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policies": {
+				Type:                  schema.TypeMap,
+				Optional:              true,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	roleName := d.Get("role_name").(string)
+
+	// Inline policies that exist on the role but aren't configured, including any added out of band, are removed.
+	existingNames, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	o, n := d.GetChange("policies")
+	oldPolicies, newPolicies := o.(map[string]interface{}), n.(map[string]interface{})
+
+	var puts []*iam.PutRolePolicyInput
+	for name, v := range newPolicies {
+		policy, err := verify.LegacyPolicyNormalize(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", name, err)
+		}
+
+		// Only policies that have semantically changed are put.
+		if old, ok := oldPolicies[name].(string); ok && !d.IsNewResource() {
+			if equivalent, err := awspolicy.PoliciesAreEquivalent(old, policy); err == nil && equivalent {
+				continue
+			}
+		}
+
+		puts = append(puts, &iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(policy),
+			PolicyName:     aws.String(name),
+			RoleName:       aws.String(roleName),
+		})
+	}
+
+	var deletes []string
+	for _, name := range existingNames {
+		if _, ok := newPolicies[name]; !ok {
+			deletes = append(deletes, name)
+		}
+	}
+
+	if err := errors.Join(addRoleInlinePolicies(ctx, puts, meta), deleteRoleInlinePolicies(ctx, conn, roleName, deletes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRolePoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policies, err := readRoleInlinePolicies(ctx, d.Id(), meta)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing IAM Role Policies Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", d.Id(), err)
+	}
+
+	configured := d.Get("policies").(map[string]interface{})
+	tfMap := make(map[string]interface{}, len(policies))
+
+	for _, apiObject := range policies {
+		name := aws.StringValue(apiObject.PolicyName)
+		existing, _ := configured[name].(string)
+
+		policyToSet, err := verify.LegacyPolicyToSet(existing, aws.StringValue(apiObject.PolicyDocument))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policy (%s): %s", d.Id(), name, err)
+		}
+
+		tfMap[name] = policyToSet
+	}
+
+	d.Set("policies", tfMap)
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+func resourceRolePoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	var names []string
+	for name := range d.Get("policies").(map[string]interface{}) {
+		names = append(names, name)
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Role Policies Exclusive: %s", d.Id())
+	if err := deleteRoleInlinePolicies(ctx, conn, d.Id(), names); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IAM Role (%s) inline policies: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_iam_role_policy_attachment")
@@ -53,6 +54,8 @@ func resourceRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
+	diags = append(diags, verify.ARNPartitionWarnings(d, meta, "policy_arn")...)
+
 	err := attachPolicyToRole(ctx, conn, role, arn)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "attaching policy %s to IAM Role %s: %v", arn, role, err)
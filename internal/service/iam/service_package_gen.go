@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceAccountAlias,
 			TypeName: "aws_iam_account_alias",
 		},
+		{
+			Factory:  DataSourceCredentialReport,
+			TypeName: "aws_iam_credential_report",
+		},
 		{
 			Factory:  DataSourceGroup,
 			TypeName: "aws_iam_group",
@@ -154,6 +158,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+			Name:     "Role Policies Exclusive",
+		},
 		{
 			Factory:  ResourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_cis_scan_configuration", name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func ResourceCISScanConfiguration() *schema.Resource {
+	startTimeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time_of_day": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be in HH:MM format"),
+					},
+					"timezone": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 50),
+					},
+				},
+			},
+		}
+	}
+	scheduleKeys := []string{
+		"schedule.0.daily",
+		"schedule.0.monthly",
+		"schedule.0.one_time",
+		"schedule.0.weekly",
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCISScanConfigurationCreate,
+		ReadWithoutTimeout:   resourceCISScanConfigurationRead,
+		UpdateWithoutTimeout: resourceCISScanConfigurationUpdate,
+		DeleteWithoutTimeout: resourceCISScanConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": startTimeSchema(),
+								},
+							},
+						},
+						"monthly": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(inspector2.Day_Values(), false),
+									},
+									"start_time": startTimeSchema(),
+								},
+							},
+						},
+						"one_time": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ExactlyOneOf: scheduleKeys,
+						},
+						"weekly": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: scheduleKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(inspector2.Day_Values(), false),
+										},
+									},
+									"start_time": startTimeSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"security_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(inspector2.CisSecurityLevel_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"targets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.Any(verify.ValidAccountID, validation.StringInSlice([]string{"SELF"}, false)),
+							},
+						},
+						"target_resource_tags": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCISScanConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Conn(ctx)
+
+	name := d.Get("scan_name").(string)
+	input := &inspector2.CreateCisScanConfigurationInput{
+		ScanName:      aws.String(name),
+		Schedule:      expandCISSchedule(d.Get("schedule").([]interface{})),
+		SecurityLevel: aws.String(d.Get("security_level").(string)),
+		Tags:          aws.StringMap(getTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.Targets = &inspector2.CreateCisTargets{
+			AccountIds:         flex.ExpandStringSet(tfMap["account_ids"].(*schema.Set)),
+			TargetResourceTags: expandCISTargetResourceTags(tfMap["target_resource_tags"].(*schema.Set).List()),
+		}
+	}
+
+	output, err := conn.CreateCisScanConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector CIS Scan Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ScanConfigurationArn))
+
+	return append(diags, resourceCISScanConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCISScanConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Conn(ctx)
+
+	output, err := FindCISScanConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Amazon Inspector CIS Scan Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector CIS Scan Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ScanConfigurationArn)
+	d.Set("owner_id", output.OwnerId)
+	d.Set("scan_name", output.ScanName)
+	if err := d.Set("schedule", flattenCISSchedule(output.Schedule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
+	}
+	d.Set("security_level", output.SecurityLevel)
+	if err := d.Set("targets", flattenCISTargets(output.Targets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting targets: %s", err)
+	}
+
+	setTagsOut(ctx, aws.StringValueMap(output.Tags))
+
+	return diags
+}
+
+func resourceCISScanConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Conn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &inspector2.UpdateCisScanConfigurationInput{
+			ScanConfigurationArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("scan_name") {
+			input.ScanName = aws.String(d.Get("scan_name").(string))
+		}
+
+		if d.HasChange("schedule") {
+			input.Schedule = expandCISSchedule(d.Get("schedule").([]interface{}))
+		}
+
+		if d.HasChange("security_level") {
+			input.SecurityLevel = aws.String(d.Get("security_level").(string))
+		}
+
+		if d.HasChange("targets") {
+			if v := d.Get("targets").([]interface{}); len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				input.Targets = &inspector2.UpdateCisTargets{
+					AccountIds:         flex.ExpandStringSet(tfMap["account_ids"].(*schema.Set)),
+					TargetResourceTags: expandCISTargetResourceTags(tfMap["target_resource_tags"].(*schema.Set).List()),
+				}
+			}
+		}
+
+		_, err := conn.UpdateCisScanConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Amazon Inspector CIS Scan Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCISScanConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCISScanConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting Amazon Inspector CIS Scan Configuration: %s", d.Id())
+	_, err := conn.DeleteCisScanConfigurationWithContext(ctx, &inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector CIS Scan Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Inspector2, arn string) (*inspector2.CisScanConfiguration, error) {
+	input := &inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &inspector2.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []*inspector2.CisStringFilter{{
+				Comparison: aws.String(inspector2.CisStringComparisonEquals),
+				Value:      aws.String(arn),
+			}},
+		},
+	}
+	var output []*inspector2.CisScanConfiguration
+
+	err := conn.ListCisScanConfigurationsPagesWithContext(ctx, input, func(page *inspector2.ListCisScanConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ScanConfigurations {
+			if v != nil && aws.StringValue(v.ScanConfigurationArn) == arn {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func expandCISSchedule(tfList []interface{}) *inspector2.Schedule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &inspector2.Schedule{}
+
+	if v, ok := tfMap["daily"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Daily = &inspector2.DailySchedule{
+			StartTime: expandCISTime(tfMap["start_time"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["monthly"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Monthly = &inspector2.MonthlySchedule{
+			Day:       aws.String(tfMap["day"].(string)),
+			StartTime: expandCISTime(tfMap["start_time"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["one_time"].(bool); ok && v {
+		apiObject.OneTime = &inspector2.OneTimeSchedule{}
+	}
+
+	if v, ok := tfMap["weekly"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Weekly = &inspector2.WeeklySchedule{
+			Days:      flex.ExpandStringSet(tfMap["days"].(*schema.Set)),
+			StartTime: expandCISTime(tfMap["start_time"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCISTime(tfList []interface{}) *inspector2.Time {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &inspector2.Time{
+		TimeOfDay: aws.String(tfMap["time_of_day"].(string)),
+		Timezone:  aws.String(tfMap["timezone"].(string)),
+	}
+}
+
+func expandCISTargetResourceTags(tfList []interface{}) map[string][]*string {
+	apiObject := make(map[string][]*string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap["key"].(string)] = flex.ExpandStringSet(tfMap["values"].(*schema.Set))
+	}
+
+	return apiObject
+}
+
+func flattenCISSchedule(apiObject *inspector2.Schedule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"one_time": apiObject.OneTime != nil,
+	}
+
+	if v := apiObject.Daily; v != nil {
+		tfMap["daily"] = []interface{}{map[string]interface{}{
+			"start_time": flattenCISTime(v.StartTime),
+		}}
+	}
+
+	if v := apiObject.Monthly; v != nil {
+		tfMap["monthly"] = []interface{}{map[string]interface{}{
+			"day":        aws.StringValue(v.Day),
+			"start_time": flattenCISTime(v.StartTime),
+		}}
+	}
+
+	if v := apiObject.Weekly; v != nil {
+		tfMap["weekly"] = []interface{}{map[string]interface{}{
+			"days":       aws.StringValueSlice(v.Days),
+			"start_time": flattenCISTime(v.StartTime),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCISTime(apiObject *inspector2.Time) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"time_of_day": aws.StringValue(apiObject.TimeOfDay),
+		"timezone":    aws.StringValue(apiObject.Timezone),
+	}}
+}
+
+func flattenCISTargets(apiObject *inspector2.CisTargets) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfListTags []interface{}
+
+	for k, v := range apiObject.TargetResourceTags {
+		tfListTags = append(tfListTags, map[string]interface{}{
+			"key":    k,
+			"values": aws.StringValueSlice(v),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"account_ids":          aws.StringValueSlice(apiObject.AccountIds),
+		"target_resource_tags": tfListTags,
+	}}
+}
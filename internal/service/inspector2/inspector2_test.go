@@ -13,6 +13,10 @@ func TestAccInspector2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"CISScanConfiguration": {
+			"basic":      testAccCISScanConfiguration_basic,
+			"disappears": testAccCISScanConfiguration_disappears,
+		},
 		"Enabler": {
 			"basic":                              testAccEnabler_basic,
 			"accountID":                          testAccEnabler_accountID,
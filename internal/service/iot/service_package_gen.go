@@ -82,6 +82,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceThingGroupHierarchy,
+			TypeName: "aws_iot_thing_group_hierarchy",
+			Name:     "Thing Group Hierarchy",
+		},
 		{
 			Factory:  ResourceThingGroupMembership,
 			TypeName: "aws_iot_thing_group_membership",
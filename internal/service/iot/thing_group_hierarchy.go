@@ -0,0 +1,606 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum number of concurrent thing group API calls.
+	thingGroupHierarchyConcurrency = 10
+)
+
+// @SDKResource("aws_iot_thing_group_hierarchy", name="Thing Group Hierarchy")
+func ResourceThingGroupHierarchy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceThingGroupHierarchyCreate,
+		ReadWithoutTimeout:   resourceThingGroupHierarchyRead,
+		UpdateWithoutTimeout: resourceThingGroupHierarchyUpdate,
+		DeleteWithoutTimeout: resourceThingGroupHierarchyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"root_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"thing_group": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"parent_group_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			"thing_group_arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: resourceThingGroupHierarchyCustomizeDiff,
+	}
+}
+
+func resourceThingGroupHierarchyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	rootGroupName := d.Get("root_group_name").(string)
+	groups := expandThingGroupHierarchyGroups(d.Get("thing_group").(*schema.Set).List())
+
+	d.SetId(rootGroupName)
+
+	if err := createThingGroupHierarchyGroups(ctx, conn, rootGroupName, groups, keysOf(groups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Thing Group Hierarchy (%s): %s", rootGroupName, err)
+	}
+
+	return append(diags, resourceThingGroupHierarchyRead(ctx, d, meta)...)
+}
+
+func resourceThingGroupHierarchyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	groups, err := FindThingGroupHierarchyByRootGroupName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Thing Group Hierarchy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Thing Group Hierarchy (%s): %s", d.Id(), err)
+	}
+
+	tfList := make([]interface{}, 0, len(groups))
+	arns := make(map[string]interface{}, len(groups))
+	for _, group := range groups {
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(group.ThingGroupName),
+		}
+
+		if v := group.ThingGroupMetadata; v != nil && aws.StringValue(v.ParentGroupName) != d.Id() {
+			tfMap["parent_group_name"] = aws.StringValue(v.ParentGroupName)
+		}
+
+		if v := group.ThingGroupProperties; v != nil {
+			tfMap["description"] = aws.StringValue(v.ThingGroupDescription)
+
+			if v.AttributePayload != nil {
+				tfMap["attributes"] = aws.StringValueMap(v.AttributePayload.Attributes)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+		arns[aws.StringValue(group.ThingGroupName)] = aws.StringValue(group.ThingGroupArn)
+	}
+
+	d.Set("root_group_name", d.Id())
+	if err := d.Set("thing_group", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting thing_group: %s", err)
+	}
+	d.Set("thing_group_arns", arns)
+
+	return diags
+}
+
+func resourceThingGroupHierarchyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	o, n := d.GetChange("thing_group")
+	oldGroups := expandThingGroupHierarchyGroups(o.(*schema.Set).List())
+	newGroups := expandThingGroupHierarchyGroups(n.(*schema.Set).List())
+
+	// A thing group's parent cannot be changed, so a group whose parent changes is
+	// deleted and created again, together with all of its descendants.
+	recreate := make(map[string]bool)
+	for name, newGroup := range newGroups {
+		if oldGroup, ok := oldGroups[name]; ok && oldGroup.parentGroupName != newGroup.parentGroupName {
+			recreate[name] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for name, newGroup := range newGroups {
+			if _, ok := oldGroups[name]; ok && !recreate[name] && recreate[newGroup.parentGroupName] {
+				recreate[name] = true
+				changed = true
+			}
+		}
+	}
+
+	var del, add, update []string
+	for name := range oldGroups {
+		if _, ok := newGroups[name]; !ok || recreate[name] {
+			del = append(del, name)
+		}
+	}
+	for name, newGroup := range newGroups {
+		oldGroup, ok := oldGroups[name]
+		switch {
+		case !ok || recreate[name]:
+			add = append(add, name)
+		case oldGroup.description != newGroup.description || !attributesEqual(oldGroup.attributes, newGroup.attributes):
+			update = append(update, name)
+		}
+	}
+
+	if err := deleteThingGroupHierarchyGroups(ctx, conn, oldGroups, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IoT Thing Group Hierarchy (%s): %s", d.Id(), err)
+	}
+
+	err := forEachThingGroup(ctx, update, func(ctx context.Context, name string) error {
+		group := newGroups[name]
+		input := &iot.UpdateThingGroupInput{
+			ThingGroupName:       aws.String(name),
+			ThingGroupProperties: group.properties(),
+		}
+
+		// An empty description removes the existing description.
+		input.ThingGroupProperties.ThingGroupDescription = aws.String(group.description)
+
+		// https://docs.aws.amazon.com/iot/latest/apireference/API_AttributePayload.html#API_AttributePayload_Contents:
+		// "To remove an attribute, call UpdateThing with an empty attribute value."
+		if input.ThingGroupProperties.AttributePayload == nil {
+			input.ThingGroupProperties.AttributePayload = &iot.AttributePayload{
+				Attributes: map[string]*string{},
+			}
+		}
+
+		if _, err := conn.UpdateThingGroupWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating thing group (%s): %w", name, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IoT Thing Group Hierarchy (%s): %s", d.Id(), err)
+	}
+
+	if err := createThingGroupHierarchyGroups(ctx, conn, d.Id(), newGroups, add); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IoT Thing Group Hierarchy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceThingGroupHierarchyRead(ctx, d, meta)...)
+}
+
+func resourceThingGroupHierarchyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	groups := expandThingGroupHierarchyGroups(d.Get("thing_group").(*schema.Set).List())
+
+	log.Printf("[DEBUG] Deleting IoT Thing Group Hierarchy: %s", d.Id())
+	if err := deleteThingGroupHierarchyGroups(ctx, conn, groups, keysOf(groups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Thing Group Hierarchy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceThingGroupHierarchyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("root_group_name") || !d.NewValueKnown("thing_group") {
+		return nil
+	}
+
+	rootGroupName := d.Get("root_group_name").(string)
+	groups := make(map[string]thingGroupHierarchyGroup)
+	for _, tfMapRaw := range d.Get("thing_group").(*schema.Set).List() {
+		group := expandThingGroupHierarchyGroup(tfMapRaw.(map[string]interface{}))
+
+		if group.name == "" {
+			return nil
+		}
+
+		if group.name == rootGroupName {
+			return fmt.Errorf("thing_group %q: must not be the root group", group.name)
+		}
+
+		if _, ok := groups[group.name]; ok {
+			return fmt.Errorf("thing_group %q: duplicate name", group.name)
+		}
+
+		groups[group.name] = group
+	}
+
+	for name, group := range groups {
+		if group.parentGroupName == "" {
+			continue
+		}
+
+		if _, ok := groups[group.parentGroupName]; !ok {
+			return fmt.Errorf("thing_group %q: parent_group_name %q must be the name of another thing_group", name, group.parentGroupName)
+		}
+
+		if _, err := thingGroupDepth(groups, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type thingGroupHierarchyGroup struct {
+	attributes      map[string]string
+	description     string
+	name            string
+	parentGroupName string
+}
+
+func (g thingGroupHierarchyGroup) properties() *iot.ThingGroupProperties {
+	apiObject := &iot.ThingGroupProperties{}
+
+	if g.description != "" {
+		apiObject.ThingGroupDescription = aws.String(g.description)
+	}
+
+	if len(g.attributes) > 0 {
+		apiObject.AttributePayload = &iot.AttributePayload{
+			Attributes: aws.StringMap(g.attributes),
+		}
+	}
+
+	return apiObject
+}
+
+// createThingGroupHierarchyGroups creates the named groups, parents before children.
+// Groups at the same depth are created concurrently.
+func createThingGroupHierarchyGroups(ctx context.Context, conn *iot.IoT, rootGroupName string, groups map[string]thingGroupHierarchyGroup, names []string) error {
+	levels, err := thingGroupLevels(groups, names)
+
+	if err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		err := forEachThingGroup(ctx, level, func(ctx context.Context, name string) error {
+			group := groups[name]
+			input := &iot.CreateThingGroupInput{
+				ParentGroupName: aws.String(rootGroupName),
+				ThingGroupName:  aws.String(name),
+			}
+
+			if group.parentGroupName != "" {
+				input.ParentGroupName = aws.String(group.parentGroupName)
+			}
+
+			if v := group.properties(); v.AttributePayload != nil || v.ThingGroupDescription != nil {
+				input.ThingGroupProperties = v
+			}
+
+			if _, err := conn.CreateThingGroupWithContext(ctx, input); err != nil {
+				return fmt.Errorf("creating thing group (%s): %w", name, err)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteThingGroupHierarchyGroups deletes the named groups, children before parents.
+// Groups at the same depth are deleted concurrently.
+func deleteThingGroupHierarchyGroups(ctx context.Context, conn *iot.IoT, groups map[string]thingGroupHierarchyGroup, names []string) error {
+	levels, err := thingGroupLevels(groups, names)
+
+	if err != nil {
+		return err
+	}
+
+	for i := len(levels) - 1; i >= 0; i-- {
+		err := forEachThingGroup(ctx, levels[i], func(ctx context.Context, name string) error {
+			_, err := tfresource.RetryWhen(ctx, thingGroupDeleteTimeout,
+				func() (interface{}, error) {
+					return conn.DeleteThingGroupWithContext(ctx, &iot.DeleteThingGroupInput{
+						ThingGroupName: aws.String(name),
+					})
+				},
+				func(err error) (bool, error) {
+					if tfawserr.ErrMessageContains(err, iot.ErrCodeInvalidRequestException, "there are still child groups attached") {
+						return true, err
+					}
+
+					return false, err
+				})
+
+			if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting thing group (%s): %w", name, err)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// forEachThingGroup calls f for each name, with at most thingGroupHierarchyConcurrency calls in flight.
+func forEachThingGroup(ctx context.Context, names []string, f func(context.Context, string) error) error {
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, thingGroupHierarchyConcurrency)
+
+	for _, name := range names {
+		name := name
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(ctx, name); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+// thingGroupLevels groups the named groups by their depth below the root group.
+func thingGroupLevels(groups map[string]thingGroupHierarchyGroup, names []string) ([][]string, error) {
+	var levels [][]string
+
+	for _, name := range names {
+		depth, err := thingGroupDepth(groups, name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for len(levels) <= depth {
+			levels = append(levels, nil)
+		}
+
+		levels[depth] = append(levels[depth], name)
+	}
+
+	for _, level := range levels {
+		sort.Strings(level)
+	}
+
+	return levels, nil
+}
+
+// thingGroupDepth returns the number of ancestors a group has within the hierarchy.
+func thingGroupDepth(groups map[string]thingGroupHierarchyGroup, name string) (int, error) {
+	depth := 0
+	seen := map[string]bool{name: true}
+
+	for group := groups[name]; group.parentGroupName != ""; depth++ {
+		if seen[group.parentGroupName] {
+			return 0, fmt.Errorf("thing_group %q: parent_group_name forms a cycle", name)
+		}
+		seen[group.parentGroupName] = true
+
+		parent, ok := groups[group.parentGroupName]
+		if !ok {
+			break
+		}
+		group = parent
+	}
+
+	return depth, nil
+}
+
+// FindThingGroupHierarchyByRootGroupName returns all thing groups below the root group.
+func FindThingGroupHierarchyByRootGroupName(ctx context.Context, conn *iot.IoT, rootGroupName string) ([]*iot.DescribeThingGroupOutput, error) {
+	if _, err := FindThingGroupByName(ctx, conn, rootGroupName); err != nil {
+		return nil, err
+	}
+
+	input := &iot.ListThingGroupsInput{
+		ParentGroup: aws.String(rootGroupName),
+		Recursive:   aws.Bool(true),
+	}
+	var names []string
+
+	err := conn.ListThingGroupsPagesWithContext(ctx, input, func(page *iot.ListThingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ThingGroups {
+			if v != nil {
+				names = append(names, aws.StringValue(v.GroupName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu     sync.Mutex
+		output []*iot.DescribeThingGroupOutput
+	)
+
+	err = forEachThingGroup(ctx, names, func(ctx context.Context, name string) error {
+		group, err := FindThingGroupByName(ctx, conn, name)
+
+		// Deleted since listed.
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading thing group (%s): %w", name, err)
+		}
+
+		mu.Lock()
+		output = append(output, group)
+		mu.Unlock()
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandThingGroupHierarchyGroups(tfList []interface{}) map[string]thingGroupHierarchyGroup {
+	groups := make(map[string]thingGroupHierarchyGroup, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		group := expandThingGroupHierarchyGroup(tfMap)
+		groups[group.name] = group
+	}
+
+	return groups
+}
+
+func expandThingGroupHierarchyGroup(tfMap map[string]interface{}) thingGroupHierarchyGroup {
+	group := thingGroupHierarchyGroup{}
+
+	if v, ok := tfMap["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		group.attributes = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok {
+		group.description = v
+	}
+
+	if v, ok := tfMap["name"].(string); ok {
+		group.name = v
+	}
+
+	if v, ok := tfMap["parent_group_name"].(string); ok {
+		group.parentGroupName = v
+	}
+
+	return group
+}
+
+func attributesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+
+	return true
+}
+
+func keysOf(groups map[string]thingGroupHierarchyGroup) []string {
+	keys := make([]string, 0, len(groups))
+
+	for k := range groups {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTThingGroupHierarchy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var groups []*iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_hierarchy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupHierarchyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupHierarchyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupHierarchyExists(ctx, resourceName, &groups),
+					resource.TestCheckResourceAttr(resourceName, "root_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "thing_group.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_group.*", map[string]string{
+						"name":              rName + "-eu",
+						"parent_group_name": "",
+						"description":       "Europe",
+						"attributes.%":      "1",
+						"attributes.region": "eu",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_group.*", map[string]string{
+						"name":              rName + "-eu-west",
+						"parent_group_name": rName + "-eu",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_group.*", map[string]string{
+						"name":              rName + "-us",
+						"parent_group_name": "",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_arns.%", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccThingGroupHierarchyConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupHierarchyExists(ctx, resourceName, &groups),
+					resource.TestCheckResourceAttr(resourceName, "thing_group.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_group.*", map[string]string{
+						"name":              rName + "-eu",
+						"parent_group_name": "",
+						"description":       "",
+						"attributes.%":      "1",
+						"attributes.region": "eu-central",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_group.*", map[string]string{
+						"name":              rName + "-eu-west",
+						"parent_group_name": rName + "-us",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_group.*", map[string]string{
+						"name":              rName + "-us",
+						"parent_group_name": "",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTThingGroupHierarchy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var groups []*iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_hierarchy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupHierarchyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupHierarchyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupHierarchyExists(ctx, resourceName, &groups),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceThingGroupHierarchy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckThingGroupHierarchyExists(ctx context.Context, n string, v *[]*iot.DescribeThingGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		output, err := tfiot.FindThingGroupHierarchyByRootGroupName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckThingGroupHierarchyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_thing_group_hierarchy" {
+				continue
+			}
+
+			output, err := tfiot.FindThingGroupHierarchyByRootGroupName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("IoT Thing Group Hierarchy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccThingGroupHierarchyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_thing_group_hierarchy" "test" {
+  root_group_name = aws_iot_thing_group.test.name
+
+  thing_group {
+    name        = "%[1]s-eu"
+    description = "Europe"
+
+    attributes = {
+      region = "eu"
+    }
+  }
+
+  thing_group {
+    name              = "%[1]s-eu-west"
+    parent_group_name = "%[1]s-eu"
+  }
+
+  thing_group {
+    name = "%[1]s-us"
+  }
+}
+`, rName)
+}
+
+func testAccThingGroupHierarchyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_thing_group_hierarchy" "test" {
+  root_group_name = aws_iot_thing_group.test.name
+
+  thing_group {
+    name = "%[1]s-eu"
+
+    attributes = {
+      region = "eu-central"
+    }
+  }
+
+  thing_group {
+    name              = "%[1]s-eu-west"
+    parent_group_name = "%[1]s-us"
+  }
+
+  thing_group {
+    name = "%[1]s-us"
+  }
+}
+`, rName)
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -47,6 +50,11 @@ func ResourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("kafka_version", func(_ context.Context, old, new, meta interface{}) bool {
 				return verify.SemVerLessThan(new.(string), old.(string))
 			}),
+			// Brokers can't be changed between Standard and Express broker types in-place.
+			customdiff.ForceNewIfChange("broker_node_group_info.0.instance_type", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && isExpressBrokerType(old.(string)) != isExpressBrokerType(new.(string))
+			}),
+			customizeDiffExpressBrokers,
 			verify.SetTagsDiff,
 		),
 
@@ -241,6 +249,17 @@ func ResourceCluster() *schema.Resource {
 													Optional: true,
 													// https://docs.aws.amazon.com/msk/1.0/apireference/clusters.html#clusters-model-ebsstorageinfo
 													ValidateFunc: validation.IntBetween(1, 16384),
+													// Storage autoscaling only ever expands broker storage.
+													DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+														if d.Get("storage_autoscaling.#").(int) == 0 {
+															return false
+														}
+
+														o, _ := strconv.Atoi(old)
+														n, _ := strconv.Atoi(new)
+
+														return n < o
+													},
 												},
 											},
 										},
@@ -513,6 +532,25 @@ func ResourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(kafka.StorageMode_Values(), true),
 			},
+			"storage_autoscaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 16384),
+						},
+						"target_value": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(10, 80),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"zookeeper_connect_string": {
@@ -612,6 +650,12 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("storage_autoscaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putClusterStorageAutoscaling(ctx, meta.(*conns.AWSClient).AppAutoScalingConn(ctx), d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return diag.Errorf("creating MSK Cluster (%s) storage autoscaling: %s", d.Id(), err)
+		}
+	}
+
 	return resourceClusterRead(ctx, d, meta)
 }
 
@@ -700,6 +744,23 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("zookeeper_connect_string", SortEndpointsString(aws.StringValue(cluster.ZookeeperConnectString)))
 	d.Set("zookeeper_connect_string_tls", SortEndpointsString(aws.StringValue(cluster.ZookeeperConnectStringTls)))
 
+	// Storage autoscaling is only read when configured, so that clusters without it
+	// don't need Application Auto Scaling permissions.
+	if v, ok := d.GetOk("storage_autoscaling"); ok && len(v.([]interface{})) > 0 {
+		storageAutoscaling, err := findClusterStorageAutoscaling(ctx, meta.(*conns.AWSClient).AppAutoScalingConn(ctx), d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("storage_autoscaling", nil)
+		case err != nil:
+			return diag.Errorf("reading MSK Cluster (%s) storage autoscaling: %s", d.Id(), err)
+		default:
+			if err := d.Set("storage_autoscaling", []interface{}{storageAutoscaling}); err != nil {
+				return diag.Errorf("setting storage_autoscaling: %s", err)
+			}
+		}
+	}
+
 	setTagsOut(ctx, cluster.Tags)
 
 	return nil
@@ -793,6 +854,31 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    aws.String(d.Get("storage_mode").(string)),
+		}
+
+		output, err := conn.UpdateStorageWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MSK Cluster (%s) storage mode: %s", d.Id(), err)
+		}
+
+		clusterOperationARN := aws.StringValue(output.ClusterOperationArn)
+
+		if _, err := waitClusterOperationCompleted(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for MSK Cluster (%s) operation (%s) complete: %s", d.Id(), clusterOperationARN, err)
+		}
+
+		// refresh the current_version attribute after each update
+		if err := refreshClusterVersion(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("number_of_broker_nodes") {
 		input := &kafka.UpdateBrokerCountInput{
 			ClusterArn:                aws.String(d.Id()),
@@ -952,12 +1038,32 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("storage_autoscaling") {
+		appAutoScalingConn := meta.(*conns.AWSClient).AppAutoScalingConn(ctx)
+
+		if v, ok := d.GetOk("storage_autoscaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := putClusterStorageAutoscaling(ctx, appAutoScalingConn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return diag.Errorf("updating MSK Cluster (%s) storage autoscaling: %s", d.Id(), err)
+			}
+		} else {
+			if err := deleteClusterStorageAutoscaling(ctx, appAutoScalingConn, d.Id()); err != nil {
+				return diag.Errorf("deleting MSK Cluster (%s) storage autoscaling: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceClusterRead(ctx, d, meta)
 }
 
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
 
+	if v, ok := d.GetOk("storage_autoscaling"); ok && len(v.([]interface{})) > 0 {
+		if err := deleteClusterStorageAutoscaling(ctx, meta.(*conns.AWSClient).AppAutoScalingConn(ctx), d.Id()); err != nil {
+			return diag.Errorf("deleting MSK Cluster (%s) storage autoscaling: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting MSK Cluster: %s", d.Id())
 	_, err := conn.DeleteClusterWithContext(ctx, &kafka.DeleteClusterInput{
 		ClusterArn: aws.String(d.Id()),
@@ -978,6 +1084,32 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
+// isExpressBrokerType returns whether the specified instance type is an MSK Express broker type.
+func isExpressBrokerType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, "express.")
+}
+
+// customizeDiffExpressBrokers rejects configuration that isn't supported by Express brokers.
+// Express brokers manage their own storage, so neither EBS storage nor tiered storage can be configured.
+func customizeDiffExpressBrokers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !isExpressBrokerType(diff.Get("broker_node_group_info.0.instance_type").(string)) {
+		return nil
+	}
+
+	// storage_info is Optional+Computed, so check the configuration rather than any value from state.
+	if v := diff.GetRawConfig().GetAttr("broker_node_group_info"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v := v.Index(cty.NumberIntVal(0)).GetAttr("storage_info"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("broker_node_group_info.0.storage_info can't be configured with Express broker types")
+		}
+	}
+
+	if diff.Get("storage_mode").(string) == kafka.StorageModeTiered {
+		return fmt.Errorf("storage_mode %q isn't supported with Express broker types", kafka.StorageModeTiered)
+	}
+
+	return nil
+}
+
 func expandBrokerNodeGroupInfo(tfMap map[string]interface{}) *kafka.BrokerNodeGroupInfo {
 	if tfMap == nil {
 		return nil
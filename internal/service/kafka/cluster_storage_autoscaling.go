@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Broker storage autoscaling is managed through Application Auto Scaling.
+// See https://docs.aws.amazon.com/msk/latest/developerguide/msk-autoexpand.html.
+const (
+	clusterStorageAutoscalingMetricType         = "KafkaBrokerStorageUtilization"
+	clusterStorageAutoscalingPolicyName         = "terraform-msk-storage-autoscaling"
+	clusterStorageAutoscalingPropagationTimeout = 2 * time.Minute
+	clusterStorageAutoscalingScalableDimension  = "kafka:broker-storage:VolumeSize"
+	clusterStorageAutoscalingServiceNamespace   = "kafka"
+)
+
+func putClusterStorageAutoscaling(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, clusterARN string, tfMap map[string]interface{}) error {
+	targetInput := &applicationautoscaling.RegisterScalableTargetInput{
+		// Broker storage can't be scaled in, so the minimum capacity is only a formality.
+		MaxCapacity:       aws.Int64(int64(tfMap["max_capacity"].(int))),
+		MinCapacity:       aws.Int64(1),
+		ResourceId:        aws.String(clusterARN),
+		ScalableDimension: aws.String(clusterStorageAutoscalingScalableDimension),
+		ServiceNamespace:  aws.String(clusterStorageAutoscalingServiceNamespace),
+	}
+
+	if _, err := conn.RegisterScalableTargetWithContext(ctx, targetInput); err != nil {
+		return fmt.Errorf("registering scalable target: %w", err)
+	}
+
+	policyInput := &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(clusterStorageAutoscalingPolicyName),
+		PolicyType:        aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		ResourceId:        aws.String(clusterARN),
+		ScalableDimension: aws.String(clusterStorageAutoscalingScalableDimension),
+		ServiceNamespace:  aws.String(clusterStorageAutoscalingServiceNamespace),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			DisableScaleIn: aws.Bool(true),
+			PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String(clusterStorageAutoscalingMetricType),
+			},
+			TargetValue: aws.Float64(tfMap["target_value"].(float64)),
+		},
+	}
+
+	// The scalable target may not be visible to the policy immediately.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, clusterStorageAutoscalingPropagationTimeout, func() (interface{}, error) {
+		return conn.PutScalingPolicyWithContext(ctx, policyInput)
+	}, applicationautoscaling.ErrCodeObjectNotFoundException)
+
+	if err != nil {
+		return fmt.Errorf("putting scaling policy: %w", err)
+	}
+
+	return nil
+}
+
+func deleteClusterStorageAutoscaling(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, clusterARN string) error {
+	// Deregistering the scalable target also deletes its scaling policies.
+	_, err := conn.DeregisterScalableTargetWithContext(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws.String(clusterARN),
+		ScalableDimension: aws.String(clusterStorageAutoscalingScalableDimension),
+		ServiceNamespace:  aws.String(clusterStorageAutoscalingServiceNamespace),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deregistering scalable target: %w", err)
+	}
+
+	return nil
+}
+
+func findClusterStorageAutoscaling(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, clusterARN string) (map[string]interface{}, error) {
+	target, err := tfappautoscaling.FindTargetByThreePartKey(ctx, conn, clusterARN, clusterStorageAutoscalingServiceNamespace, clusterStorageAutoscalingScalableDimension)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       aws.StringSlice([]string{clusterStorageAutoscalingPolicyName}),
+		ResourceId:        aws.String(clusterARN),
+		ScalableDimension: aws.String(clusterStorageAutoscalingScalableDimension),
+		ServiceNamespace:  aws.String(clusterStorageAutoscalingServiceNamespace),
+	}
+
+	output, err := conn.DescribeScalingPoliciesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ScalingPolicies) == 0 || output.ScalingPolicies[0] == nil || output.ScalingPolicies[0].TargetTrackingScalingPolicyConfiguration == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return map[string]interface{}{
+		"max_capacity": aws.Int64Value(target.MaxCapacity),
+		"target_value": aws.Float64Value(output.ScalingPolicies[0].TargetTrackingScalingPolicyConfiguration.TargetValue),
+	}, nil
+}
@@ -0,0 +1,723 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_msk_replicator", name="Replicator")
+// @Tags(identifierAttribute="id")
+func ResourceReplicator() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicatorCreate,
+		ReadWithoutTimeout:   resourceReplicatorRead,
+		UpdateWithoutTimeout: resourceReplicatorUpdate,
+		DeleteWithoutTimeout: resourceReplicatorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"kafka_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_msk_cluster": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"msk_cluster_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"vpc_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_groups_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replication_info_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumer_group_replication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"consumer_groups_to_exclude": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"consumer_groups_to_replicate": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"detect_and_copy_new_consumer_groups": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"synchronise_consumer_group_offsets": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+						"source_kafka_cluster_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_kafka_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"target_compression_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(kafka.TargetCompressionType_Values(), false),
+						},
+						"target_kafka_cluster_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_kafka_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"topic_replication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"copy_access_control_lists_for_topics": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"copy_topic_configurations": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"detect_and_copy_new_topics": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									// The starting position can only be set when the replicator is created.
+									"starting_position": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(kafka.ReplicationStartingPositionType_Values(), false),
+												},
+											},
+										},
+									},
+									"topics_to_exclude": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"topics_to_replicate": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replicator_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]{0,127}$`), "must start with a letter or number and contain only letters, numbers and hyphens"),
+				),
+			},
+			"service_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceReplicatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	name := d.Get("replicator_name").(string)
+	input := &kafka.CreateReplicatorInput{
+		KafkaClusters:           expandKafkaClusters(d.Get("kafka_cluster").([]interface{})),
+		ReplicationInfoList:     expandReplicationInfos(d.Get("replication_info_list").([]interface{})),
+		ReplicatorName:          aws.String(name),
+		ServiceExecutionRoleArn: aws.String(d.Get("service_execution_role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateReplicatorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MSK Replicator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicatorArn))
+
+	if _, err := waitReplicatorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MSK Replicator (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReplicatorRead(ctx, d, meta)...)
+}
+
+func resourceReplicatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	output, err := FindReplicatorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Replicator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MSK Replicator (%s): %s", d.Id(), err)
+	}
+
+	// The replication information refers to clusters by alias, not ARN.
+	clusterARNByAlias := make(map[string]string)
+	for _, v := range output.KafkaClusters {
+		if v.AmazonMskCluster != nil {
+			clusterARNByAlias[aws.StringValue(v.KafkaClusterAlias)] = aws.StringValue(v.AmazonMskCluster.MskClusterArn)
+		}
+	}
+
+	d.Set("arn", output.ReplicatorArn)
+	d.Set("current_version", output.CurrentVersion)
+	d.Set("description", output.ReplicatorDescription)
+	if err := d.Set("kafka_cluster", flattenKafkaClusterDescriptions(output.KafkaClusters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting kafka_cluster: %s", err)
+	}
+	if err := d.Set("replication_info_list", flattenReplicationInfoDescriptions(output.ReplicationInfoList, clusterARNByAlias)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_info_list: %s", err)
+	}
+	d.Set("replicator_name", output.ReplicatorName)
+	d.Set("service_execution_role_arn", output.ServiceExecutionRoleArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceReplicatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	if d.HasChange("replication_info_list") {
+		tfMap := d.Get("replication_info_list").([]interface{})[0].(map[string]interface{})
+		input := &kafka.UpdateReplicationInfoInput{
+			CurrentVersion:        aws.String(d.Get("current_version").(string)),
+			ReplicatorArn:         aws.String(d.Id()),
+			SourceKafkaClusterArn: aws.String(tfMap["source_kafka_cluster_arn"].(string)),
+			TargetKafkaClusterArn: aws.String(tfMap["target_kafka_cluster_arn"].(string)),
+		}
+
+		if d.HasChange("replication_info_list.0.consumer_group_replication") {
+			input.ConsumerGroupReplication = expandConsumerGroupReplicationUpdate(tfMap["consumer_group_replication"].([]interface{}))
+		}
+
+		if d.HasChange("replication_info_list.0.topic_replication") {
+			input.TopicReplication = expandTopicReplicationUpdate(tfMap["topic_replication"].([]interface{}))
+		}
+
+		_, err := conn.UpdateReplicationInfoWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MSK Replicator (%s) replication info: %s", d.Id(), err)
+		}
+
+		if _, err := waitReplicatorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MSK Replicator (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceReplicatorRead(ctx, d, meta)...)
+}
+
+func resourceReplicatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	log.Printf("[DEBUG] Deleting MSK Replicator: %s", d.Id())
+	_, err := conn.DeleteReplicatorWithContext(ctx, &kafka.DeleteReplicatorInput{
+		ReplicatorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MSK Replicator (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicatorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MSK Replicator (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindReplicatorByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeReplicatorOutput, error) {
+	input := &kafka.DescribeReplicatorInput{
+		ReplicatorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeReplicatorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusReplicatorState(ctx context.Context, conn *kafka.Kafka, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicatorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ReplicatorState), nil
+	}
+}
+
+func waitReplicatorCreated(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateCreating},
+		Target:  []string{kafka.ReplicatorStateRunning},
+		Refresh: statusReplicatorState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if stateInfo := output.StateInfo; stateInfo != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicatorUpdated(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateUpdating},
+		Target:  []string{kafka.ReplicatorStateRunning},
+		Refresh: statusReplicatorState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if stateInfo := output.StateInfo; stateInfo != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicatorDeleted(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateRunning, kafka.ReplicatorStateDeleting},
+		Target:  []string{},
+		Refresh: statusReplicatorState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if stateInfo := output.StateInfo; stateInfo != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandKafkaClusters(tfList []interface{}) []*kafka.KafkaCluster {
+	var apiObjects []*kafka.KafkaCluster
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.KafkaCluster{}
+
+		if v, ok := tfMap["amazon_msk_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.AmazonMskCluster = &kafka.AmazonMskCluster{
+				MskClusterArn: aws.String(tfMap["msk_cluster_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["vpc_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.VpcConfig = &kafka.KafkaClusterClientVpcConfig{
+				SubnetIds: flex.ExpandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+			}
+
+			if v, ok := tfMap["security_groups_ids"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.VpcConfig.SecurityGroupIds = flex.ExpandStringSet(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandReplicationInfos(tfList []interface{}) []*kafka.ReplicationInfo {
+	var apiObjects []*kafka.ReplicationInfo
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.ReplicationInfo{
+			ConsumerGroupReplication: expandConsumerGroupReplication(tfMap["consumer_group_replication"].([]interface{})),
+			SourceKafkaClusterArn:    aws.String(tfMap["source_kafka_cluster_arn"].(string)),
+			TargetCompressionType:    aws.String(tfMap["target_compression_type"].(string)),
+			TargetKafkaClusterArn:    aws.String(tfMap["target_kafka_cluster_arn"].(string)),
+			TopicReplication:         expandTopicReplication(tfMap["topic_replication"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConsumerGroupReplication(tfList []interface{}) *kafka.ConsumerGroupReplication {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kafka.ConsumerGroupReplication{
+		ConsumerGroupsToReplicate:       flex.ExpandStringSet(tfMap["consumer_groups_to_replicate"].(*schema.Set)),
+		DetectAndCopyNewConsumerGroups:  aws.Bool(tfMap["detect_and_copy_new_consumer_groups"].(bool)),
+		SynchroniseConsumerGroupOffsets: aws.Bool(tfMap["synchronise_consumer_group_offsets"].(bool)),
+	}
+
+	if v, ok := tfMap["consumer_groups_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ConsumerGroupsToExclude = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandConsumerGroupReplicationUpdate(tfList []interface{}) *kafka.ConsumerGroupReplicationUpdate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	// All fields are required by the update API.
+	return &kafka.ConsumerGroupReplicationUpdate{
+		ConsumerGroupsToExclude:         aws.StringSlice(flex.ExpandStringValueSet(tfMap["consumer_groups_to_exclude"].(*schema.Set))),
+		ConsumerGroupsToReplicate:       flex.ExpandStringSet(tfMap["consumer_groups_to_replicate"].(*schema.Set)),
+		DetectAndCopyNewConsumerGroups:  aws.Bool(tfMap["detect_and_copy_new_consumer_groups"].(bool)),
+		SynchroniseConsumerGroupOffsets: aws.Bool(tfMap["synchronise_consumer_group_offsets"].(bool)),
+	}
+}
+
+func expandTopicReplication(tfList []interface{}) *kafka.TopicReplication {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kafka.TopicReplication{
+		CopyAccessControlListsForTopics: aws.Bool(tfMap["copy_access_control_lists_for_topics"].(bool)),
+		CopyTopicConfigurations:         aws.Bool(tfMap["copy_topic_configurations"].(bool)),
+		DetectAndCopyNewTopics:          aws.Bool(tfMap["detect_and_copy_new_topics"].(bool)),
+		TopicsToReplicate:               flex.ExpandStringSet(tfMap["topics_to_replicate"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["starting_position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.StartingPosition = &kafka.ReplicationStartingPosition{
+				Type: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["topics_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TopicsToExclude = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicReplicationUpdate(tfList []interface{}) *kafka.TopicReplicationUpdate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	// All fields are required by the update API.
+	return &kafka.TopicReplicationUpdate{
+		CopyAccessControlListsForTopics: aws.Bool(tfMap["copy_access_control_lists_for_topics"].(bool)),
+		CopyTopicConfigurations:         aws.Bool(tfMap["copy_topic_configurations"].(bool)),
+		DetectAndCopyNewTopics:          aws.Bool(tfMap["detect_and_copy_new_topics"].(bool)),
+		TopicsToExclude:                 aws.StringSlice(flex.ExpandStringValueSet(tfMap["topics_to_exclude"].(*schema.Set))),
+		TopicsToReplicate:               flex.ExpandStringSet(tfMap["topics_to_replicate"].(*schema.Set)),
+	}
+}
+
+func flattenKafkaClusterDescriptions(apiObjects []*kafka.KafkaClusterDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AmazonMskCluster; v != nil {
+			tfMap["amazon_msk_cluster"] = []interface{}{map[string]interface{}{
+				"msk_cluster_arn": aws.StringValue(v.MskClusterArn),
+			}}
+		}
+
+		if v := apiObject.VpcConfig; v != nil {
+			tfMap["vpc_config"] = []interface{}{map[string]interface{}{
+				"security_groups_ids": aws.StringValueSlice(v.SecurityGroupIds),
+				"subnet_ids":          aws.StringValueSlice(v.SubnetIds),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenReplicationInfoDescriptions(apiObjects []*kafka.ReplicationInfoDescription, clusterARNByAlias map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		sourceAlias, targetAlias := aws.StringValue(apiObject.SourceKafkaClusterAlias), aws.StringValue(apiObject.TargetKafkaClusterAlias)
+		tfMap := map[string]interface{}{
+			"consumer_group_replication": flattenConsumerGroupReplication(apiObject.ConsumerGroupReplication),
+			"source_kafka_cluster_alias": sourceAlias,
+			"source_kafka_cluster_arn":   clusterARNByAlias[sourceAlias],
+			"target_compression_type":    aws.StringValue(apiObject.TargetCompressionType),
+			"target_kafka_cluster_alias": targetAlias,
+			"target_kafka_cluster_arn":   clusterARNByAlias[targetAlias],
+			"topic_replication":          flattenTopicReplication(apiObject.TopicReplication),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenConsumerGroupReplication(apiObject *kafka.ConsumerGroupReplication) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"consumer_groups_to_exclude":          aws.StringValueSlice(apiObject.ConsumerGroupsToExclude),
+		"consumer_groups_to_replicate":        aws.StringValueSlice(apiObject.ConsumerGroupsToReplicate),
+		"detect_and_copy_new_consumer_groups": aws.BoolValue(apiObject.DetectAndCopyNewConsumerGroups),
+		"synchronise_consumer_group_offsets":  aws.BoolValue(apiObject.SynchroniseConsumerGroupOffsets),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTopicReplication(apiObject *kafka.TopicReplication) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"copy_access_control_lists_for_topics": aws.BoolValue(apiObject.CopyAccessControlListsForTopics),
+		"copy_topic_configurations":            aws.BoolValue(apiObject.CopyTopicConfigurations),
+		"detect_and_copy_new_topics":           aws.BoolValue(apiObject.DetectAndCopyNewTopics),
+		"topics_to_exclude":                    aws.StringValueSlice(apiObject.TopicsToExclude),
+		"topics_to_replicate":                  aws.StringValueSlice(apiObject.TopicsToReplicate),
+	}
+
+	if v := apiObject.StartingPosition; v != nil {
+		tfMap["starting_position"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
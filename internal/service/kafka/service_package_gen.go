@@ -71,6 +71,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceConfiguration,
 			TypeName: "aws_msk_configuration",
 		},
+		{
+			Factory:  ResourceReplicator,
+			TypeName: "aws_msk_replicator",
+			Name:     "Replicator",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceScramSecretAssociation,
 			TypeName: "aws_msk_scram_secret_association",
@@ -5,6 +5,7 @@ package kafka
 
 import (
 	"context"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
@@ -40,6 +41,18 @@ func statusClusterOperationState(ctx context.Context, conn *kafka.Kafka, arn str
 			return nil, "", err
 		}
 
+		// Long-running operations such as broker type changes report progress as a series of steps.
+		if steps := output.OperationSteps; len(steps) > 0 {
+			if step := steps[len(steps)-1]; step != nil {
+				var stepStatus string
+				if step.StepInfo != nil {
+					stepStatus = aws.StringValue(step.StepInfo.StepStatus)
+				}
+
+				log.Printf("[INFO] MSK Cluster operation (%s) %s step %d (%s): %s", arn, aws.StringValue(output.OperationType), len(steps), aws.StringValue(step.StepName), stepStatus)
+			}
+		}
+
 		return output, aws.StringValue(output.OperationState), nil
 	}
 }
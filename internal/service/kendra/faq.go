@@ -173,31 +173,12 @@ func resourceFaqCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.LanguageCode = aws.String(v.(string))
 	}
 
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
-		func() (interface{}, error) {
-			return conn.CreateFaq(ctx, input)
-		},
-		func(err error) (bool, error) {
-			var validationException *types.ValidationException
-
-			if errors.As(err, &validationException) && strings.Contains(validationException.ErrorMessage(), validationExceptionMessage) {
-				return true, err
-			}
-
-			return false, err
-		},
-	)
+	output, err := createFaq(ctx, conn, input)
 
 	if err != nil {
 		return diag.Errorf("creating Kendra Faq (%s): %s", name, err)
 	}
 
-	if outputRaw == nil {
-		return diag.Errorf("creating Kendra Faq (%s): empty output", name)
-	}
-
-	output := outputRaw.(*kendra.CreateFaqOutput)
-
 	id := aws.ToString(output.Id)
 	indexId := d.Get("index_id").(string)
 
@@ -294,6 +275,33 @@ func resourceFaqDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func createFaq(ctx context.Context, conn *kendra.Client, input *kendra.CreateFaqInput) (*kendra.CreateFaqOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFaq(ctx, input)
+		},
+		func(err error) (bool, error) {
+			var validationException *types.ValidationException
+
+			if errors.As(err, &validationException) && strings.Contains(validationException.ErrorMessage(), validationExceptionMessage) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if outputRaw == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return outputRaw.(*kendra.CreateFaqOutput), nil
+}
+
 func waitFaqCreated(ctx context.Context, conn *kendra.Client, id, indexId string, timeout time.Duration) (*kendra.DescribeFaqOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(types.FaqStatusCreating, "PENDING_CREATION"), // API currently returns PENDING_CREATION instead of CREATING
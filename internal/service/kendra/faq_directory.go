@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+var faqNameRegexp = regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]*$`)
+
+// @SDKResource("aws_kendra_faq_directory", name="FAQ Directory")
+func ResourceFaqDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFaqDirectoryCreate,
+		ReadWithoutTimeout:   resourceFaqDirectoryRead,
+		UpdateWithoutTimeout: resourceFaqDirectoryUpdate,
+		DeleteWithoutTimeout: resourceFaqDirectoryDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: resourceFaqDirectoryCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"faq_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"file_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.FaqFileFormat](),
+			},
+			"file_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexache.MustCompile(`[0-9A-Za-z][0-9A-Za-z-]{35}`),
+					"Starts with an alphanumeric character. Subsequently, can contain alphanumeric characters and hyphens. Fixed length of 36.",
+				),
+			},
+			"language_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 10),
+					validation.StringMatch(
+						regexache.MustCompile(`[A-Za-z-]*`),
+						"Must have alphanumeric characters or hyphens.",
+					),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_directory": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceFaqDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(id.UniqueId())
+
+	if err := syncFaqDirectory(ctx, d, meta, map[string]interface{}{}, map[string]interface{}{}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("creating Kendra FAQ Directory (%s): %s", d.Get("source_directory").(string), err)
+	}
+
+	return resourceFaqDirectoryRead(ctx, d, meta)
+}
+
+func resourceFaqDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	indexId := d.Get("index_id").(string)
+	faqIds := d.Get("faq_ids").(map[string]interface{})
+	fileHashes := d.Get("file_hashes").(map[string]interface{})
+
+	for fileName, v := range faqIds {
+		_, err := FindFaqByID(ctx, conn, v.(string), indexId)
+
+		// Forget FAQs removed outside of Terraform so that they are created again.
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] Kendra Faq (%s/%s) for file %s not found, removing from state", v.(string), indexId, fileName)
+			delete(faqIds, fileName)
+			delete(fileHashes, fileName)
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("getting Kendra Faq (%s/%s): %s", v.(string), indexId, err)
+		}
+	}
+
+	d.Set("faq_ids", faqIds)
+	d.Set("file_hashes", fileHashes)
+
+	return nil
+}
+
+func resourceFaqDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("file_hashes") {
+		oldFaqIds, _ := d.GetChange("faq_ids")
+		oldFileHashes, _ := d.GetChange("file_hashes")
+
+		if err := syncFaqDirectory(ctx, d, meta, oldFaqIds.(map[string]interface{}), oldFileHashes.(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating Kendra FAQ Directory (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceFaqDirectoryRead(ctx, d, meta)
+}
+
+func resourceFaqDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+	s3Client := meta.(*conns.AWSClient).S3Client(ctx)
+
+	indexId := d.Get("index_id").(string)
+
+	log.Printf("[INFO] Deleting Kendra FAQ Directory %s", d.Id())
+
+	for fileName, v := range d.Get("faq_ids").(map[string]interface{}) {
+		if err := deleteFaq(ctx, conn, v.(string), indexId, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("deleting Kendra FAQ Directory (%s) file %s: %s", d.Id(), fileName, err)
+		}
+
+		if err := deleteFaqDirectoryObject(ctx, s3Client, d, fileName); err != nil {
+			return diag.Errorf("deleting Kendra FAQ Directory (%s) file %s: %s", d.Id(), fileName, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFaqDirectoryCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_directory") {
+		if err := diff.SetNewComputed("file_hashes"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("faq_ids")
+	}
+
+	fileHashes, err := readFaqDirectory(diff.Get("source_directory").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if faqDirectoryFileHashesEqual(diff.Get("file_hashes").(map[string]interface{}), fileHashes) {
+		return nil
+	}
+
+	if err := diff.SetNew("file_hashes", fileHashes); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed("faq_ids")
+}
+
+// syncFaqDirectory uploads new and changed files in the source directory and (re-)creates their FAQs,
+// then deletes the FAQs of files that are no longer present.
+// The FAQ IDs and file hashes are saved as they change so that a partial failure is retried on the next apply.
+func syncFaqDirectory(ctx context.Context, d *schema.ResourceData, meta interface{}, faqIds, fileHashes map[string]interface{}, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+	s3Client := meta.(*conns.AWSClient).S3Client(ctx)
+
+	indexId := d.Get("index_id").(string)
+	directory := d.Get("source_directory").(string)
+
+	defer func() {
+		d.Set("faq_ids", faqIds)
+		d.Set("file_hashes", fileHashes)
+	}()
+
+	newFileHashes, err := readFaqDirectory(directory)
+
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range faqDirectorySortedKeys(newFileHashes) {
+		hash := newFileHashes[fileName]
+
+		if v, ok := fileHashes[fileName]; ok && v.(string) == hash {
+			if _, ok := faqIds[fileName]; ok {
+				continue
+			}
+		}
+
+		if err := putFaqDirectoryObject(ctx, s3Client, d, directory, fileName); err != nil {
+			return err
+		}
+
+		input := &kendra.CreateFaqInput{
+			ClientToken: aws.String(id.UniqueId()),
+			IndexId:     aws.String(indexId),
+			Name:        aws.String(faqDirectoryFaqName(fileName)),
+			RoleArn:     aws.String(d.Get("role_arn").(string)),
+			S3Path: &types.S3Path{
+				Bucket: aws.String(d.Get("s3_bucket").(string)),
+				Key:    aws.String(faqDirectoryObjectKey(d, fileName)),
+			},
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("file_format"); ok {
+			input.FileFormat = types.FaqFileFormat(v.(string))
+		}
+
+		if v, ok := d.GetOk("language_code"); ok {
+			input.LanguageCode = aws.String(v.(string))
+		}
+
+		output, err := createFaq(ctx, conn, input)
+
+		if err != nil {
+			return fmt.Errorf("creating Kendra Faq for file %s: %w", fileName, err)
+		}
+
+		faqId := aws.ToString(output.Id)
+
+		if _, err := waitFaqCreated(ctx, conn, faqId, indexId, timeout); err != nil {
+			return fmt.Errorf("waiting for Kendra Faq (%s/%s) for file %s creation: %w", faqId, indexId, fileName, err)
+		}
+
+		// The FAQ for the previous version of the file is only removed once its replacement is active.
+		if v, ok := faqIds[fileName]; ok {
+			if err := deleteFaq(ctx, conn, v.(string), indexId, timeout); err != nil {
+				return fmt.Errorf("deleting Kendra Faq for previous version of file %s: %w", fileName, err)
+			}
+		}
+
+		faqIds[fileName] = faqId
+		fileHashes[fileName] = hash
+	}
+
+	for _, fileName := range faqDirectorySortedKeys(faqIds) {
+		if _, ok := newFileHashes[fileName]; ok {
+			continue
+		}
+
+		if err := deleteFaq(ctx, conn, faqIds[fileName].(string), indexId, timeout); err != nil {
+			return fmt.Errorf("deleting Kendra Faq for removed file %s: %w", fileName, err)
+		}
+
+		if err := deleteFaqDirectoryObject(ctx, s3Client, d, fileName); err != nil {
+			return err
+		}
+
+		delete(faqIds, fileName)
+		delete(fileHashes, fileName)
+	}
+
+	return nil
+}
+
+func deleteFaq(ctx context.Context, conn *kendra.Client, id, indexId string, timeout time.Duration) error {
+	_, err := conn.DeleteFaq(ctx, &kendra.DeleteFaqInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexId),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitFaqDeleted(ctx, conn, id, indexId, timeout); err != nil {
+		return fmt.Errorf("waiting for Kendra Faq (%s/%s) delete: %w", id, indexId, err)
+	}
+
+	return nil
+}
+
+func putFaqDirectoryObject(ctx context.Context, client *s3.Client, d *schema.ResourceData, directory, fileName string) error {
+	directory, err := homedir.Expand(directory)
+
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source_directory (%s): %w", directory, err)
+	}
+
+	file, err := os.Open(filepath.Join(directory, fileName))
+
+	if err != nil {
+		return fmt.Errorf("opening FAQ file: %w", err)
+	}
+
+	defer file.Close()
+
+	bucket, key := d.Get("s3_bucket").(string), faqDirectoryObjectKey(d, fileName)
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return fmt.Errorf("uploading FAQ file %s to S3 (%s/%s): %w", fileName, bucket, key, err)
+	}
+
+	return nil
+}
+
+func deleteFaqDirectoryObject(ctx context.Context, client *s3.Client, d *schema.ResourceData, fileName string) error {
+	bucket, key := d.Get("s3_bucket").(string), faqDirectoryObjectKey(d, fileName)
+
+	_, err := client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return fmt.Errorf("deleting FAQ file %s from S3 (%s/%s): %w", fileName, bucket, key, err)
+	}
+
+	return nil
+}
+
+// readFaqDirectory returns the base64-encoded SHA-256 hash of each file in the directory, keyed by file name.
+// Subdirectories and hidden files are ignored.
+func readFaqDirectory(v string) (map[string]string, error) {
+	directory, err := homedir.Expand(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_directory (%s): %w", v, err)
+	}
+
+	entries, err := os.ReadDir(directory)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_directory (%s): %w", directory, err)
+	}
+
+	fileHashes := make(map[string]string)
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+
+		if !entry.Type().IsRegular() || strings.HasPrefix(fileName, ".") {
+			continue
+		}
+
+		if name := faqDirectoryFaqName(fileName); !faqNameRegexp.MatchString(name) || len(name) > 100 {
+			return nil, fmt.Errorf("FAQ file %s: name without extension must start with an alphanumeric character, consist of alphanumerics, hyphens or underscores and be at most 100 characters", fileName)
+		}
+
+		content, err := os.ReadFile(filepath.Join(directory, fileName))
+
+		if err != nil {
+			return nil, fmt.Errorf("reading FAQ file (%s): %w", fileName, err)
+		}
+
+		sum := sha256.Sum256(content)
+		fileHashes[fileName] = base64.StdEncoding.EncodeToString(sum[:])
+	}
+
+	return fileHashes, nil
+}
+
+func faqDirectoryFileHashesEqual(old map[string]interface{}, new map[string]string) bool {
+	if len(old) != len(new) {
+		return false
+	}
+
+	for k, v := range new {
+		if o, ok := old[k]; !ok || o.(string) != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func faqDirectoryFaqName(fileName string) string {
+	return strings.TrimSuffix(fileName, filepath.Ext(fileName))
+}
+
+func faqDirectoryObjectKey(d *schema.ResourceData, fileName string) string {
+	return path.Join(d.Get("s3_key_prefix").(string), fileName)
+}
+
+func faqDirectorySortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKendraFaqDirectory_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_kendra_faq_directory.test"
+	directory := t.TempDir()
+	var faqId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFaqDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccFaqDirectoryWriteFile(t, directory, "first.csv", "")
+					testAccFaqDirectoryWriteFile(t, directory, "second.csv", "")
+				},
+				Config: testAccFaqDirectoryConfig_basic(rName, rName2, rName3, rName4, directory),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqDirectoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "faq_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_ids.first.csv"),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_ids.second.csv"),
+					resource.TestCheckResourceAttr(resourceName, "file_hashes.%", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test_faq", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_key_prefix", "faqs"),
+					resource.TestCheckResourceAttr(resourceName, "source_directory", directory),
+					func(s *terraform.State) error {
+						faqId = s.RootModule().Resources[resourceName].Primary.Attributes["faq_ids.first.csv"]
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccFaqDirectoryWriteFile(t, directory, "first.csv", "How many FAQ files are there?, 2, https://www.example.com/\n")
+					testAccFaqDirectoryWriteFile(t, directory, "third.csv", "")
+					if err := os.Remove(filepath.Join(directory, "second.csv")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFaqDirectoryConfig_basic(rName, rName2, rName3, rName4, directory),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqDirectoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "faq_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_ids.first.csv"),
+					resource.TestCheckNoResourceAttr(resourceName, "faq_ids.second.csv"),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_ids.third.csv"),
+					resource.TestCheckResourceAttr(resourceName, "file_hashes.%", "2"),
+					func(s *terraform.State) error {
+						if v := s.RootModule().Resources[resourceName].Primary.Attributes["faq_ids.first.csv"]; v == faqId {
+							return fmt.Errorf("Kendra Faq (%s) for changed file first.csv was not replaced", v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccFaqDirectoryWriteFile(t *testing.T, directory, fileName, extra string) {
+	t.Helper()
+
+	content, err := os.ReadFile("test-fixtures/basic.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(directory, fileName), append(content, extra...), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckFaqDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kendra_faq_directory" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if !strings.HasPrefix(k, "faq_ids.") || k == "faq_ids.%" {
+					continue
+				}
+
+				_, err := tfkendra.FindFaqByID(ctx, conn, v, rs.Primary.Attributes["index_id"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Kendra Faq %s still exists", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFaqDirectoryExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra FAQ Directory is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "faq_ids.") || k == "faq_ids.%" {
+				continue
+			}
+
+			if _, err := tfkendra.FindFaqByID(ctx, conn, v, rs.Primary.Attributes["index_id"]); err != nil {
+				return fmt.Errorf("Error describing Kendra Faq: %s", err.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccFaqDirectoryConfig_basic(rName, rName2, rName3, rName4, directory string) string {
+	return acctest.ConfigCompose(
+		testAccFaqConfigBase(rName, rName2, rName3, rName4),
+		fmt.Sprintf(`
+resource "aws_kendra_faq_directory" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test_faq]
+
+  index_id         = aws_kendra_index.test.id
+  role_arn         = aws_iam_role.test_faq.arn
+  s3_bucket        = aws_s3_bucket.test.id
+  s3_key_prefix    = "faqs"
+  source_directory = %[1]q
+}
+`, directory))
+}
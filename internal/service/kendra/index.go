@@ -36,6 +36,9 @@ const (
 
 	// validationExceptionMessage describes the error returned when the IAM role has not yet propagated
 	validationExceptionMessage = "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity"
+
+	// indexEditionGenAIEnterpriseEdition is the GenAI Enterprise Edition, which is not yet an IndexEdition enum value in the AWS SDK
+	indexEditionGenAIEnterpriseEdition types.IndexEdition = "GEN_AI_ENTERPRISE_EDITION"
 )
 
 // @SDKResource("aws_kendra_index", name="Index")
@@ -188,11 +191,11 @@ func ResourceIndex() *schema.Resource {
 				},
 			},
 			"edition": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          string(types.IndexEditionEnterpriseEdition),
-				ValidateDiagFunc: enum.Validate[types.IndexEdition](),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(types.IndexEditionEnterpriseEdition),
+				ValidateFunc: validation.StringInSlice(append(enum.Values[types.IndexEdition](), string(indexEditionGenAIEnterpriseEdition)), false),
 			},
 			"error_message": {
 				Type:     schema.TypeString,
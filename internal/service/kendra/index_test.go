@@ -124,6 +124,38 @@ func TestAccKendraIndex_serverSideEncryption(t *testing.T) {
 	})
 }
 
+func TestAccKendraIndex_genAIEnterpriseEdition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var index kendra.DescribeIndexOutput
+
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_kendra_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_edition(rName, rName2, rName3, "GEN_AI_ENTERPRISE_EDITION"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &index),
+					resource.TestCheckResourceAttr(resourceName, "edition", "GEN_AI_ENTERPRISE_EDITION"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.IndexStatusActive)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccKendraIndex_updateCapacityUnits(t *testing.T) {
 	ctx := acctest.Context(t)
 	var index kendra.DescribeIndexOutput
@@ -1599,6 +1631,18 @@ resource "aws_kendra_index" "test" {
 `, rName3, queryCapacityUnits, storageCapacityUnits))
 }
 
+func testAccIndexConfig_edition(rName, rName2, rName3, edition string) string {
+	return acctest.ConfigCompose(
+		testAccIndexConfigBase(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_kendra_index" "test" {
+  name     = %[1]q
+  edition  = %[2]q
+  role_arn = aws_iam_role.access_cw.arn
+}
+`, rName3, edition))
+}
+
 func testAccIndexConfig_secretsManagerRole(rName, rName2, rName3, description string) string {
 	return acctest.ConfigCompose(
 		testAccIndexConfigBase(rName, rName2),
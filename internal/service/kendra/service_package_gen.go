@@ -69,6 +69,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceFaqDirectory,
+			TypeName: "aws_kendra_faq_directory",
+			Name:     "FAQ Directory",
+		},
 		{
 			Factory:  ResourceIndex,
 			TypeName: "aws_kendra_index",
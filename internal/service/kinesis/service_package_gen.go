@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStreamConsumer,
 			TypeName: "aws_kinesis_stream_consumer",
 		},
+		{
+			Factory:  DataSourceStreamConsumers,
+			TypeName: "aws_kinesis_stream_consumers",
+		},
 	}
 }
 
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_kinesis_stream_consumers")
+func DataSourceStreamConsumers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStreamConsumersRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceStreamConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisConn(ctx)
+
+	streamArn := d.Get("stream_arn").(string)
+
+	input := &kinesis.ListStreamConsumersInput{
+		StreamARN: aws.String(streamArn),
+	}
+
+	var arns []string
+	var consumers []interface{}
+
+	err := conn.ListStreamConsumersPagesWithContext(ctx, input, func(page *kinesis.ListStreamConsumersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, consumer := range page.Consumers {
+			if consumer == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(consumer.ConsumerARN))
+			consumers = append(consumers, map[string]interface{}{
+				"arn":                aws.StringValue(consumer.ConsumerARN),
+				"creation_timestamp": aws.TimeValue(consumer.ConsumerCreationTimestamp).Format(time.RFC3339),
+				"name":               aws.StringValue(consumer.ConsumerName),
+				"status":             aws.StringValue(consumer.ConsumerStatus),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Kinesis Stream Consumers: %s", err)
+	}
+
+	d.SetId(streamArn)
+	d.Set("arns", arns)
+	if err := d.Set("consumers", consumers); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting consumers: %s", err)
+	}
+	d.Set("stream_arn", streamArn)
+
+	return diags
+}
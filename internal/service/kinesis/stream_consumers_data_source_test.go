@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKinesisStreamConsumersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kinesis_stream_consumers.test"
+	streamName := "aws_kinesis_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_kinesis_stream_consumer.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_kinesis_stream_consumer.test.1", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "consumers.*", map[string]string{
+						"name": rName + "-0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "consumers.*", map[string]string{
+						"name": rName + "-1",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stream_arn", streamName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisStreamConsumersDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kinesis_stream_consumers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersDataSourceConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStreamConsumersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStreamConsumerBaseDataSourceConfig(rName),
+		fmt.Sprintf(`
+data "aws_kinesis_stream_consumers" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+
+  depends_on = [aws_kinesis_stream_consumer.test]
+}
+
+resource "aws_kinesis_stream_consumer" "test" {
+  count = 2
+
+  name       = "%[1]s-${count.index}"
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
+
+func testAccStreamConsumersDataSourceConfig_empty(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStreamConsumerBaseDataSourceConfig(rName),
+		`
+data "aws_kinesis_stream_consumers" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`)
+}
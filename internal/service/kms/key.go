@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -39,7 +41,11 @@ func ResourceKey() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffKeyPolicyLockout,
+			customizeDiffKeyRotationPeriod,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -96,6 +102,18 @@ func ResourceKey() *schema.Resource {
 				Default:      kms.KeyUsageTypeEncryptDecrypt,
 				ValidateFunc: validation.StringInSlice(kms.KeyUsageType_Values(), false),
 			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_rotation_start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"multi_region": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -114,6 +132,13 @@ func ResourceKey() *schema.Resource {
 					return json
 				},
 			},
+			"rotation_period_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(90, 2560),
+				RequiredWith: []string{"enable_key_rotation"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -170,7 +195,13 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
 
 	if enableKeyRotation := d.Get("enable_key_rotation").(bool); enableKeyRotation {
-		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation); err != nil {
+		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation, d.Get("rotation_period_in_days").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating KMS Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("on_demand_rotation_trigger"); ok && v.(string) != "" {
+		if err := rotateKeyOnDemand(ctx, conn, d.Id()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating KMS Key (%s): %s", d.Id(), err)
 		}
 	}
@@ -228,6 +259,17 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("key_id", key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	if key.nextRotationDate != nil {
+		d.Set("next_rotation_date", aws.TimeValue(key.nextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+	if key.onDemandRotationStartDate != nil {
+		d.Set("on_demand_rotation_start_date", aws.TimeValue(key.onDemandRotationStartDate).Format(time.RFC3339))
+	} else {
+		d.Set("on_demand_rotation_start_date", nil)
+	}
+	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
 
 	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), key.policy)
 	if err != nil {
@@ -254,8 +296,14 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if hasChange, enableKeyRotation := d.HasChange("enable_key_rotation"), d.Get("enable_key_rotation").(bool); hasChange {
-		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation); err != nil {
+	if d.HasChanges("enable_key_rotation", "rotation_period_in_days") {
+		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), d.Get("enable_key_rotation").(bool), d.Get("rotation_period_in_days").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("on_demand_rotation_trigger") {
+		if err := rotateKeyOnDemand(ctx, conn, d.Id()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): %s", d.Id(), err)
 		}
 	}
@@ -282,6 +330,26 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	return append(diags, resourceKeyRead(ctx, d, meta)...)
 }
 
+// customizeDiffKeyRotationPeriod rejects a rotation period unless automatic key rotation is enabled.
+// rotation_period_in_days is Computed, so the configuration is checked rather than the planned value.
+func customizeDiffKeyRotationPeriod(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	if v := config.GetAttr("rotation_period_in_days"); v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	if v := config.GetAttr("enable_key_rotation"); !v.IsKnown() || (!v.IsNull() && v.True()) {
+		return nil
+	}
+
+	return fmt.Errorf("rotation_period_in_days can only be set when enable_key_rotation is true")
+}
+
 func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSConn(ctx)
@@ -319,10 +387,13 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 type kmsKey struct {
-	metadata *kms.KeyMetadata
-	policy   string
-	rotation *bool
-	tags     []*kms.Tag
+	metadata                  *kms.KeyMetadata
+	nextRotationDate          *time.Time
+	onDemandRotationStartDate *time.Time
+	policy                    string
+	rotation                  *bool
+	rotationPeriodInDays      *int64
+	tags                      []*kms.Tag
 }
 
 func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
@@ -350,11 +421,16 @@ func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource boo
 		}
 
 		if aws.StringValue(key.metadata.Origin) == kms.OriginTypeAwsKms {
-			key.rotation, err = FindKeyRotationEnabledByKeyID(ctx, conn, keyID)
+			rotationStatus, err := FindKeyRotationStatusByKeyID(ctx, conn, keyID)
 
 			if err != nil {
-				return nil, fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err)
+				return nil, fmt.Errorf("reading KMS Key (%s) rotation status: %w", keyID, err)
 			}
+
+			key.nextRotationDate = rotationStatus.NextRotationDate
+			key.onDemandRotationStartDate = rotationStatus.OnDemandRotationStartDate
+			key.rotation = rotationStatus.KeyRotationEnabled
+			key.rotationPeriodInDays = rotationStatus.RotationPeriodInDays
 		}
 
 		tags, err := listTags(ctx, conn, keyID)
@@ -472,18 +548,26 @@ func updateKeyPolicy(ctx context.Context, conn *kms.KMS, keyID string, policy st
 	return nil
 }
 
-func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool) error {
+func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool, rotationPeriodInDays int) error {
 	var action string
 
 	updateFunc := func() (interface{}, error) {
 		var err error
 
 		if enabled {
-			log.Printf("[DEBUG] Enabling KMS Key (%s) key rotation", keyID)
-			_, err = conn.EnableKeyRotationWithContext(ctx, &kms.EnableKeyRotationInput{
+			action = "enabling"
+			input := &kms.EnableKeyRotationInput{
 				KeyId: aws.String(keyID),
-			})
+			}
+
+			if rotationPeriodInDays > 0 {
+				input.RotationPeriodInDays = aws.Int64(int64(rotationPeriodInDays))
+			}
+
+			log.Printf("[DEBUG] Enabling KMS Key (%s) key rotation", keyID)
+			_, err = conn.EnableKeyRotationWithContext(ctx, input)
 		} else {
+			action = "disabling"
 			log.Printf("[DEBUG] Disabling KMS Key (%s) key rotation", keyID)
 			_, err = conn.DisableKeyRotationWithContext(ctx, &kms.DisableKeyRotationInput{
 				KeyId: aws.String(keyID),
@@ -507,3 +591,16 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string,
 
 	return nil
 }
+
+func rotateKeyOnDemand(ctx context.Context, conn *kms.KMS, keyID string) error {
+	log.Printf("[DEBUG] Rotating KMS Key (%s) on demand", keyID)
+	_, err := conn.RotateKeyOnDemandWithContext(ctx, &kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("rotating key on demand: %w", err)
+	}
+
+	return nil
+}
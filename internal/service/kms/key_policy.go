@@ -29,6 +29,8 @@ func ResourceKeyPolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffKeyPolicyLockout,
+
 		Schema: map[string]*schema.Schema{
 			"bypass_policy_lockout_safety_check": {
 				Type:     schema.TypeBool,
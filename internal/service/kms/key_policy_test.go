@@ -90,7 +90,7 @@ func TestAccKMSKeyPolicy_bypass(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccKeyPolicyConfig_policyBypass(rName, false),
-				ExpectError: regexache.MustCompile(`The new key policy will not allow you to update the key policy in the future`),
+				ExpectError: regexache.MustCompile(`no statement allows kms:PutKeyPolicy`),
 			},
 			{
 				Config: testAccKeyPolicyConfig_policyBypass(rName, true),
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_kms_key_rotation_status")
+func DataSourceKeyRotationStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKeyRotationStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateKeyOrAlias,
+			},
+			"key_rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_region": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"multi_region_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"multi_region_key_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_key": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"replica_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_rotation_start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_period_in_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"valid_to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeyRotationStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	keyID := d.Get("key_id").(string)
+	output, err := conn.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
+	}
+
+	keyMetadata := output.KeyMetadata
+	d.SetId(aws.StringValue(keyMetadata.KeyId))
+	d.Set("arn", keyMetadata.Arn)
+	d.Set("expiration_model", keyMetadata.ExpirationModel)
+	d.Set("key_state", keyMetadata.KeyState)
+	d.Set("multi_region", keyMetadata.MultiRegion)
+	if keyMetadata.MultiRegionConfiguration != nil {
+		if err := d.Set("multi_region_configuration", []interface{}{flattenMultiRegionConfiguration(keyMetadata.MultiRegionConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting multi_region_configuration: %s", err)
+		}
+	} else {
+		d.Set("multi_region_configuration", nil)
+	}
+	d.Set("origin", keyMetadata.Origin)
+	if keyMetadata.ValidTo != nil {
+		d.Set("valid_to", aws.TimeValue(keyMetadata.ValidTo).Format(time.RFC3339))
+	} else {
+		d.Set("valid_to", nil)
+	}
+
+	// Only keys with AWS KMS generated key material support automatic rotation.
+	var rotationStatus kms.GetKeyRotationStatusOutput
+	if aws.StringValue(keyMetadata.Origin) == kms.OriginTypeAwsKms {
+		output, err := FindKeyRotationStatusByKeyID(ctx, conn, aws.StringValue(keyMetadata.Arn))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) rotation status: %s", keyID, err)
+		}
+
+		rotationStatus = *output
+	}
+
+	d.Set("key_rotation_enabled", aws.BoolValue(rotationStatus.KeyRotationEnabled))
+	if rotationStatus.NextRotationDate != nil {
+		d.Set("next_rotation_date", aws.TimeValue(rotationStatus.NextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+	if rotationStatus.OnDemandRotationStartDate != nil {
+		d.Set("on_demand_rotation_start_date", aws.TimeValue(rotationStatus.OnDemandRotationStartDate).Format(time.RFC3339))
+	} else {
+		d.Set("on_demand_rotation_start_date", nil)
+	}
+	d.Set("rotation_period_in_days", rotationStatus.RotationPeriodInDays)
+
+	return diags
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeyRotationStatusDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_key.test"
+	dataSourceName := "data.aws_kms_key_rotation_status.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationStatusDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "expiration_model", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "key_rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_state", "Enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.0.multi_region_key_type", "PRIMARY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_region_configuration.0.primary_key.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.0.replica_keys.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "next_rotation_date", resourceName, "next_rotation_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "origin", "AWS_KMS"),
+					resource.TestCheckResourceAttr(dataSourceName, "rotation_period_in_days", "180"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid_to", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyRotationStatusDataSource_externalKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kms_key_rotation_status.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validTo := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationStatusDataSourceConfig_externalKey(rName, validTo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expiration_model", "KEY_MATERIAL_EXPIRES"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "next_rotation_date", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "origin", "EXTERNAL"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid_to", validTo),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyRotationStatusDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+  rotation_period_in_days = 180
+  multi_region            = true
+}
+
+data "aws_kms_key_rotation_status" "test" {
+  key_id = aws_kms_key.test.arn
+}
+`, rName)
+}
+
+func testAccKeyRotationStatusDataSourceConfig_externalKey(rName, validTo string) string {
+	return fmt.Sprintf(`
+# ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
+resource "aws_kms_external_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  key_material_base64     = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+  valid_to                = %[2]q
+}
+
+data "aws_kms_key_rotation_status" "test" {
+  key_id = aws_kms_external_key.test.id
+}
+`, rName, validTo)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+const (
+	keyPolicyLockoutAction = "kms:PutKeyPolicy"
+)
+
+// customizeDiffKeyPolicyLockout reports, at plan time, a key policy that would lock every principal out of
+// changing it again. KMS performs the same check when the policy is applied, after the key has been created.
+func customizeDiffKeyPolicyLockout(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("bypass_policy_lockout_safety_check").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	policy := d.Get("policy").(string)
+
+	if policy == "" {
+		return nil
+	}
+
+	allowed, err := keyPolicyAllowsPutKeyPolicy(policy)
+
+	if err != nil {
+		// Malformed policies are reported by the policy's own validation.
+		return nil
+	}
+
+	if !allowed {
+		return fmt.Errorf("policy: no statement allows %s, so the key policy could never be changed again; set bypass_policy_lockout_safety_check to skip this check", keyPolicyLockoutAction)
+	}
+
+	return nil
+}
+
+// keyPolicyAllowsPutKeyPolicy returns whether any principal may still call kms:PutKeyPolicy under the policy.
+// Conditions are not evaluated, so a conditional Allow counts as allowing and only an unconditional Deny for
+// all principals counts as denying.
+func keyPolicyAllowsPutKeyPolicy(policy string) (bool, error) {
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	var allowed bool
+
+	for _, statement := range doc.Statements {
+		if !statementAppliesToAction(statement, keyPolicyLockoutAction) {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(statement.Effect, "Deny"):
+			if len(statement.Conditions) == 0 && len(statement.NotPrincipals) == 0 && principalsIncludeEveryone(statement.Principals) {
+				return false, nil
+			}
+		case strings.EqualFold(statement.Effect, "Allow"):
+			if len(statement.Principals) > 0 || len(statement.NotPrincipals) > 0 {
+				allowed = true
+			}
+		}
+	}
+
+	return allowed, nil
+}
+
+func statementAppliesToAction(statement *tfiam.IAMPolicyStatement, action string) bool {
+	if statement.NotActions != nil {
+		return !actionsMatch(statement.NotActions, action)
+	}
+
+	return actionsMatch(statement.Actions, action)
+}
+
+func actionsMatch(v interface{}, action string) bool {
+	var patterns []string
+
+	switch v := v.(type) {
+	case string:
+		patterns = []string{v}
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				patterns = append(patterns, v)
+			}
+		}
+	}
+
+	for _, pattern := range patterns {
+		if actionPatternMatch(strings.ToLower(pattern), strings.ToLower(action)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// actionPatternMatch matches an IAM action against a pattern containing the '*' and '?' wildcards.
+func actionPatternMatch(pattern, action string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(action); i >= 0; i-- {
+				if actionPatternMatch(pattern[1:], action[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(action) == 0 {
+				return false
+			}
+		default:
+			if len(action) == 0 || pattern[0] != action[0] {
+				return false
+			}
+		}
+		pattern, action = pattern[1:], action[1:]
+	}
+
+	return len(action) == 0
+}
+
+func principalsIncludeEveryone(principals tfiam.IAMPolicyStatementPrincipalSet) bool {
+	for _, principal := range principals {
+		if principal.Type != "*" && principal.Type != "AWS" {
+			continue
+		}
+
+		switch v := principal.Identifiers.(type) {
+		case string:
+			if v == "*" {
+				return true
+			}
+		case []string:
+			for _, v := range v {
+				if v == "*" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"testing"
+)
+
+func TestKeyPolicyAllowsPutKeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy  string
+		want    bool
+		wantErr bool
+	}{
+		"root kms:*": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`,
+			want:   true,
+		},
+		"explicit action list": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/admin"]},"Action":["kms:Describe*","kms:PutKeyPolicy"],"Resource":"*"}]}`,
+			want:   true,
+		},
+		"wildcard prefix": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:Put*","Resource":"*"}]}`,
+			want:   true,
+		},
+		"case insensitive action": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"KMS:putkeypolicy","Resource":"*"}]}`,
+			want:   true,
+		},
+		"not action excluding other actions": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"NotAction":"kms:ScheduleKeyDeletion","Resource":"*"}]}`,
+			want:   true,
+		},
+		"conditional allow": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*","Condition":{"StringEquals":{"kms:CallerAccount":"123456789012"}}}]}`,
+			want:   true,
+		},
+		"usage only": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["kms:Encrypt","kms:Decrypt"],"Resource":"*"}]}`,
+			want:   false,
+		},
+		"not action excluding put key policy": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"NotAction":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			want:   false,
+		},
+		"unconditional deny for everyone": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"},{"Effect":"Deny","Principal":"*","Action":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			want:   false,
+		},
+		"conditional deny": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"},{"Effect":"Deny","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"false"}}}]}`,
+			want:   true,
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := keyPolicyAllowsPutKeyPolicy(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("keyPolicyAllowsPutKeyPolicy() err = %v, want error %t", err, want)
+			}
+
+			if got != testCase.want {
+				t.Errorf("keyPolicyAllowsPutKeyPolicy() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,644 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_kms_replica_keys", name="Replica Keys")
+func ResourceReplicaKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicaKeysCreate,
+		ReadWithoutTimeout:   resourceReplicaKeysRead,
+		UpdateWithoutTimeout: resourceReplicaKeysUpdate,
+		DeleteWithoutTimeout: resourceReplicaKeysDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceReplicaKeysImport,
+		},
+
+		CustomizeDiff: resourceReplicaKeysCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bypass_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8192),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"primary_key_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"replica": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validNameForResource,
+						},
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
+			"replica_arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceReplicaKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	// e.g. arn:aws:kms:us-east-2:111122223333:key/mrk-1234abcd12ab34cd56ef1234567890ab
+	primaryKeyARN, err := arn.Parse(d.Get("primary_key_arn").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing primary key ARN: %s", err)
+	}
+
+	// Replication is initiated in the primary key's region.
+	replicateConn, err := replicaKeyConnForRegion(conn, meta, primaryKeyARN.Region)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(primaryKeyARN.String())
+
+	for _, replica := range expandReplicaKeysReplicas(d.Get("replica").(*schema.Set).List()) {
+		if err := createReplicaKeysReplica(ctx, conn, replicateConn, meta, d, primaryKeyARN, replica); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating KMS Replica Keys (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceReplicaKeysRead(ctx, d, meta)...)
+}
+
+func resourceReplicaKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	primaryKeyARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing primary key ARN: %s", err)
+	}
+
+	primaryConn, err := replicaKeyConnForRegion(conn, meta, primaryKeyARN.Region)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keyID := replicaKeysKeyID(primaryKeyARN)
+	primaryKey, err := FindKeyByID(ctx, primaryConn, keyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Replica Keys (%s) primary key not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Replica Keys (%s) primary key: %s", d.Id(), err)
+	}
+
+	if primaryKey.MultiRegionConfiguration == nil {
+		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is not a multi-Region key", d.Id())
+	}
+
+	// Only the replicas managed by this resource are reported.
+	replicas := expandReplicaKeysReplicas(d.Get("replica").(*schema.Set).List())
+	var regions []string
+	for _, v := range replicas {
+		regions = append(regions, v.region)
+	}
+	sort.Strings(regions)
+
+	replicasByRegion := make(map[string]replicaKeysReplica)
+	for _, v := range replicas {
+		replicasByRegion[v.region] = v
+	}
+
+	var tfList []interface{}
+	replicaARNs := make(map[string]interface{})
+	var keyMetadataRead bool
+
+	for _, region := range regions {
+		replica := replicasByRegion[region]
+
+		regionConn, err := replicaKeyConnForRegion(conn, meta, region)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		key, err := findKey(ctx, regionConn, keyID, d.IsNewResource())
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] KMS Replica Keys (%s) replica in %s not found, removing from state", d.Id(), region)
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading KMS Replica Keys (%s) replica in %s: %s", d.Id(), region, err)
+		}
+
+		// Shared attributes are reported from the first replica.
+		if !keyMetadataRead {
+			d.Set("description", key.metadata.Description)
+			d.Set("enabled", key.metadata.Enabled)
+			keyMetadataRead = true
+		}
+
+		// The default key policy is only tracked when configured.
+		if replica.policy != "" {
+			policy, err := verify.SecondJSONUnlessEquivalent(replica.policy, key.policy)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", key.policy, err)
+			}
+
+			replica.policy = policy
+		}
+
+		if replica.alias != "" {
+			alias, err := FindAliasByName(ctx, regionConn, replica.alias)
+
+			if tfresource.NotFound(err) || (err == nil && aws.StringValue(alias.TargetKeyId) != keyID) {
+				replica.alias = ""
+			} else if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading KMS Replica Keys (%s) alias in %s: %s", d.Id(), region, err)
+			}
+		}
+
+		tfList = append(tfList, replica.flatten())
+		replicaARNs[region] = aws.StringValue(key.metadata.Arn)
+	}
+
+	d.Set("primary_key_arn", d.Id())
+	if err := d.Set("replica", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replica: %s", err)
+	}
+	d.Set("replica_arns", replicaARNs)
+
+	return diags
+}
+
+func resourceReplicaKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	primaryKeyARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing primary key ARN: %s", err)
+	}
+
+	keyID := replicaKeysKeyID(primaryKeyARN)
+	o, n := d.GetChange("replica")
+	oldReplicas, newReplicas := make(map[string]replicaKeysReplica), make(map[string]replicaKeysReplica)
+	for _, v := range expandReplicaKeysReplicas(o.(*schema.Set).List()) {
+		oldReplicas[v.region] = v
+	}
+	for _, v := range expandReplicaKeysReplicas(n.(*schema.Set).List()) {
+		newReplicas[v.region] = v
+	}
+
+	for region, old := range oldReplicas {
+		if _, ok := newReplicas[region]; ok {
+			continue
+		}
+
+		regionConn, err := replicaKeyConnForRegion(conn, meta, region)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := deleteReplicaKeysReplica(ctx, regionConn, keyID, old, d.Get("deletion_window_in_days").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting KMS Replica Keys (%s) replica in %s: %s", d.Id(), region, err)
+		}
+	}
+
+	for region, new := range newReplicas {
+		regionConn, err := replicaKeyConnForRegion(conn, meta, region)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		old, ok := oldReplicas[region]
+
+		if !ok {
+			replicateConn, err := replicaKeyConnForRegion(conn, meta, primaryKeyARN.Region)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := createReplicaKeysReplica(ctx, conn, replicateConn, meta, d, primaryKeyARN, new); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating KMS Replica Keys (%s) replica in %s: %s", d.Id(), region, err)
+			}
+
+			continue
+		}
+
+		if err := updateReplicaKeysReplica(ctx, regionConn, d, keyID, old, new); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Replica Keys (%s) replica in %s: %s", d.Id(), region, err)
+		}
+	}
+
+	return append(diags, resourceReplicaKeysRead(ctx, d, meta)...)
+}
+
+func resourceReplicaKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	primaryKeyARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing primary key ARN: %s", err)
+	}
+
+	keyID := replicaKeysKeyID(primaryKeyARN)
+
+	for _, replica := range expandReplicaKeysReplicas(d.Get("replica").(*schema.Set).List()) {
+		regionConn, err := replicaKeyConnForRegion(conn, meta, replica.region)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		log.Printf("[DEBUG] Deleting KMS Replica Keys (%s) replica in %s", d.Id(), replica.region)
+		if err := deleteReplicaKeysReplica(ctx, regionConn, keyID, replica, d.Get("deletion_window_in_days").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting KMS Replica Keys (%s) replica in %s: %s", d.Id(), replica.region, err)
+		}
+	}
+
+	return diags
+}
+
+// resourceReplicaKeysImport imports all existing replicas of the primary key.
+func resourceReplicaKeysImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	primaryKeyARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing primary key ARN (%s): %w", d.Id(), err)
+	}
+
+	primaryConn, err := replicaKeyConnForRegion(conn, meta, primaryKeyARN.Region)
+
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKey, err := FindKeyByID(ctx, primaryConn, replicaKeysKeyID(primaryKeyARN))
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Key (%s): %w", d.Id(), err)
+	}
+
+	if primaryKey.MultiRegionConfiguration == nil {
+		return nil, fmt.Errorf("KMS Key (%s) is not a multi-Region key", d.Id())
+	}
+
+	var tfList []interface{}
+	for _, v := range primaryKey.MultiRegionConfiguration.ReplicaKeys {
+		tfList = append(tfList, replicaKeysReplica{region: aws.StringValue(v.Region)}.flatten())
+	}
+
+	if err := d.Set("replica", tfList); err != nil {
+		return nil, err
+	}
+	d.Set("bypass_policy_lockout_safety_check", false)
+	d.Set("deletion_window_in_days", 30)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceReplicaKeysCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	regions := make(map[string]struct{})
+
+	for _, replica := range expandReplicaKeysReplicas(d.Get("replica").(*schema.Set).List()) {
+		// The region may not be known until apply.
+		if replica.region == "" {
+			continue
+		}
+
+		if _, ok := regions[replica.region]; ok {
+			return fmt.Errorf("replica Region (%s) must be unique", replica.region)
+		}
+
+		regions[replica.region] = struct{}{}
+	}
+
+	// The primary key ARN may not be known until apply.
+	if primaryKeyARN, err := arn.Parse(d.Get("primary_key_arn").(string)); err == nil {
+		if _, ok := regions[primaryKeyARN.Region]; ok {
+			return fmt.Errorf("replica must not contain the primary key Region (%s)", primaryKeyARN.Region)
+		}
+	}
+
+	return nil
+}
+
+type replicaKeysReplica struct {
+	alias  string
+	policy string
+	region string
+}
+
+func (r replicaKeysReplica) flatten() map[string]interface{} {
+	return map[string]interface{}{
+		"alias":  r.alias,
+		"policy": r.policy,
+		"region": r.region,
+	}
+}
+
+func expandReplicaKeysReplicas(tfList []interface{}) []replicaKeysReplica {
+	var replicas []replicaKeysReplica
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		replicas = append(replicas, replicaKeysReplica{
+			alias:  tfMap["alias"].(string),
+			policy: tfMap["policy"].(string),
+			region: tfMap["region"].(string),
+		})
+	}
+
+	return replicas
+}
+
+// replicaKeysKeyID returns the key ID shared by a multi-Region primary key and its replicas.
+func replicaKeysKeyID(primaryKeyARN arn.ARN) string {
+	return strings.TrimPrefix(primaryKeyARN.Resource, "key/")
+}
+
+func createReplicaKeysReplica(ctx context.Context, conn, replicateConn *kms.KMS, meta interface{}, d *schema.ResourceData, primaryKeyARN arn.ARN, replica replicaKeysReplica) error {
+	input := kms.ReplicateKeyInput{
+		BypassPolicyLockoutSafetyCheck: aws.Bool(d.Get("bypass_policy_lockout_safety_check").(bool)),
+		KeyId:                          aws.String(replicaKeysKeyID(primaryKeyARN)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if replica.policy != "" {
+		input.Policy = aws.String(replica.policy)
+	}
+
+	if err := createAdditionalReplicaKey(ctx, conn, replicateConn, meta, input, replica.region, d.Get("enabled").(bool)); err != nil {
+		return err
+	}
+
+	if replica.alias != "" {
+		regionConn, err := replicaKeyConnForRegion(conn, meta, replica.region)
+
+		if err != nil {
+			return err
+		}
+
+		if err := createReplicaKeysAlias(ctx, regionConn, replica.alias, replicaKeysKeyID(primaryKeyARN)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateReplicaKeysReplica applies shared and per-Region changes to the replica key in conn's Region.
+func updateReplicaKeysReplica(ctx context.Context, conn *kms.KMS, d *schema.ResourceData, keyID string, old, new replicaKeysReplica) error {
+	if hasChange, enabled := d.HasChange("enabled"), d.Get("enabled").(bool); hasChange && enabled {
+		// Enable before any attributes are modified.
+		if err := updateKeyEnabled(ctx, conn, keyID, enabled); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		if err := updateKeyDescription(ctx, conn, keyID, d.Get("description").(string)); err != nil {
+			return err
+		}
+	}
+
+	// Removing the policy from the configuration leaves the current key policy in place.
+	if new.policy != "" && !verify.SuppressEquivalentPolicyDiffs("", old.policy, new.policy, nil) {
+		if err := updateKeyPolicy(ctx, conn, keyID, new.policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if old.alias != new.alias {
+		if old.alias != "" {
+			if err := deleteReplicaKeysAlias(ctx, conn, old.alias); err != nil {
+				return err
+			}
+		}
+
+		if new.alias != "" {
+			if err := createReplicaKeysAlias(ctx, conn, new.alias, keyID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if hasChange, enabled := d.HasChange("enabled"), d.Get("enabled").(bool); hasChange && !enabled {
+		// Only disable after all attributes have been modified because we cannot modify disabled keys.
+		if err := updateKeyEnabled(ctx, conn, keyID, enabled); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteReplicaKeysReplica(ctx context.Context, conn *kms.KMS, keyID string, replica replicaKeysReplica, deletionWindowInDays int) error {
+	// Aliases are not removed when their key is scheduled for deletion.
+	if replica.alias != "" {
+		if err := deleteReplicaKeysAlias(ctx, conn, replica.alias); err != nil {
+			return err
+		}
+	}
+
+	return deleteReplicaKey(ctx, conn, keyID, deletionWindowInDays)
+}
+
+func createReplicaKeysAlias(ctx context.Context, conn *kms.KMS, name, keyID string) error {
+	input := &kms.CreateAliasInput{
+		AliasName:   aws.String(name),
+		TargetKeyId: aws.String(keyID),
+	}
+
+	// KMS is eventually consistent.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, KeyRotationUpdatedTimeout, func() (interface{}, error) {
+		return conn.CreateAliasWithContext(ctx, input)
+	}, kms.ErrCodeNotFoundException)
+
+	if err != nil {
+		return fmt.Errorf("creating alias (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func deleteReplicaKeysAlias(ctx context.Context, conn *kms.KMS, name string) error {
+	_, err := conn.DeleteAliasWithContext(ctx, &kms.DeleteAliasInput{
+		AliasName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting alias (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func replicaKeyConnForRegion(conn *kms.KMS, meta interface{}, region string) (*kms.KMS, error) {
+	session, err := conns.NewSessionForRegion(&conn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating AWS session (%s): %w", region, err)
+	}
+
+	return kms.New(session), nil
+}
+
+// createAdditionalReplicaKey replicates the primary key into the specified Region.
+// A multi-Region replica key has the same key ID as its primary key in every Region.
+func createAdditionalReplicaKey(ctx context.Context, conn, replicateConn *kms.KMS, meta interface{}, input kms.ReplicateKeyInput, region string, enabled bool) error {
+	regionConn, err := replicaKeyConnForRegion(conn, meta, region)
+
+	if err != nil {
+		return err
+	}
+
+	input.ReplicaRegion = aws.String(region)
+
+	output, err := WaitIAMPropagation(ctx, func() (*kms.ReplicateKeyOutput, error) {
+		return replicateConn.ReplicateKeyWithContext(ctx, &input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("replicating to %s: %w", region, err)
+	}
+
+	keyID := aws.StringValue(output.ReplicaKeyMetadata.KeyId)
+
+	if _, err := WaitReplicaKeyCreated(ctx, regionConn, keyID); err != nil {
+		return fmt.Errorf("waiting for replica in %s create: %w", region, err)
+	}
+
+	if !enabled {
+		if err := updateKeyEnabled(ctx, regionConn, keyID, enabled); err != nil {
+			return fmt.Errorf("replica in %s: %w", region, err)
+		}
+	}
+
+	// Wait for propagation since KMS is eventually consistent.
+	if input.Policy != nil {
+		if err := WaitKeyPolicyPropagated(ctx, regionConn, keyID, aws.StringValue(input.Policy)); err != nil {
+			return fmt.Errorf("waiting for replica in %s policy propagation: %w", region, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteReplicaKey(ctx context.Context, conn *kms.KMS, keyID string, deletionWindowInDays int) error {
+	input := &kms.ScheduleKeyDeletionInput{
+		KeyId: aws.String(keyID),
+	}
+
+	if deletionWindowInDays > 0 {
+		input.PendingWindowInDays = aws.Int64(int64(deletionWindowInDays))
+	}
+
+	_, err := conn.ScheduleKeyDeletionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, kms.ErrCodeInvalidStateException, "is pending deletion") {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitKeyDeleted(ctx, conn, keyID); err != nil {
+		return fmt.Errorf("waiting for delete: %w", err)
+	}
+
+	return nil
+}
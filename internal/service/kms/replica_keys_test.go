@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSReplicaKeys_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_replica_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeysConfig_basic(rName, "description1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, primaryKeyResourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"alias":  "alias/" + rName,
+						"region": acctest.AlternateRegion(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"alias":  "",
+						"region": acctest.ThirdRegion(),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "replica_arns.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("replica_arns.%s", acctest.AlternateRegion())),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("replica_arns.%s", acctest.ThirdRegion())),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replica"},
+			},
+			{
+				Config: testAccReplicaKeysConfig_basic(rName, "description2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+				),
+			},
+			{
+				Config: testAccReplicaKeysConfig_single(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"alias":  "alias/" + rName + "-updated",
+						"region": acctest.AlternateRegion(),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "replica_arns.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSReplicaKeys_primaryRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicaKeysConfig_primaryRegion(rName),
+				ExpectError: regexache.MustCompile(`replica must not contain the primary key Region`),
+			},
+		},
+	})
+}
+
+func testAccReplicaKeysConfig_basic(rName, description string, enabled bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description  = %[1]q
+  multi_region = true
+
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_replica_keys" "test" {
+  description     = %[2]q
+  enabled         = %[3]t
+  primary_key_arn = aws_kms_key.test.arn
+
+  replica {
+    region = %[4]q
+    alias  = "alias/%[1]s"
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Id      = %[1]q
+      Statement = [{
+        Sid       = "Enable IAM User Permissions"
+        Effect    = "Allow"
+        Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+        Action    = "kms:*"
+        Resource  = "*"
+      }]
+    })
+  }
+
+  replica {
+    region = %[5]q
+  }
+
+  deletion_window_in_days = 7
+}
+`, rName, description, enabled, acctest.AlternateRegion(), acctest.ThirdRegion())
+}
+
+func testAccReplicaKeysConfig_single(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description  = %[1]q
+  multi_region = true
+
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_replica_keys" "test" {
+  description     = "description2"
+  enabled         = false
+  primary_key_arn = aws_kms_key.test.arn
+
+  replica {
+    region = %[2]q
+    alias  = "alias/%[1]s-updated"
+  }
+
+  deletion_window_in_days = 7
+}
+`, rName, acctest.AlternateRegion())
+}
+
+func testAccReplicaKeysConfig_primaryRegion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_replica_keys" "test" {
+  primary_key_arn = "arn:%[3]s:kms:%[2]s:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab"
+
+  replica {
+    region = %[2]q
+    alias  = "alias/%[1]s"
+  }
+}
+`, rName, acctest.Region(), acctest.Partition())
+}
@@ -41,6 +41,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceKey,
 			TypeName: "aws_kms_key",
 		},
+		{
+			Factory:  DataSourceKeyRotationStatus,
+			TypeName: "aws_kms_key_rotation_status",
+		},
 		{
 			Factory:  DataSourcePublicKey,
 			TypeName: "aws_kms_public_key",
@@ -110,6 +114,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceReplicaKeys,
+			TypeName: "aws_kms_replica_keys",
+			Name:     "Replica Keys",
+		},
 	}
 }
 
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_lakeformation_effective_permissions")
+func DataSourceEffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEffectivePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"grant": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permissions": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validPrincipal,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	// IncludeRelated also returns permissions that apply to the table through LF-Tags,
+	// the parent database and column-level grants.
+	input := &lakeformation.ListPermissionsInput{
+		IncludeRelated: aws.String("TRUE"),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &lakeformation.Resource{
+			Table: ExpandTableResource(d.Get("table").([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPagesWithContext(ctx, input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			// Related permissions for other principals (e.g. IAM_ALLOWED_PRINCIPALS) may be returned.
+			if v == nil || v.Principal == nil || aws.StringValue(v.Principal.DataLakePrincipalIdentifier) != aws.StringValue(input.Principal.DataLakePrincipalIdentifier) {
+				continue
+			}
+
+			permissions = append(permissions, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Effective Permissions: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(input.String())))
+
+	var all, allWithGrantOption []*string
+
+	for _, v := range permissions {
+		all = append(all, v.Permissions...)
+		allWithGrantOption = append(allWithGrantOption, v.PermissionsWithGrantOption...)
+	}
+
+	if err := d.Set("grant", flattenEffectivePermissionsGrants(permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting grant: %s", err)
+	}
+	d.Set("permissions", flex.FlattenStringSet(all))
+	d.Set("permissions_with_grant_option", flex.FlattenStringSet(allWithGrantOption))
+
+	return diags
+}
+
+func flattenEffectivePermissionsGrants(apiObjects []*lakeformation.PrincipalResourcePermissions) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"permissions":                   flex.FlattenStringSet(apiObject.Permissions),
+			"permissions_with_grant_option": flex.FlattenStringSet(apiObject.PermissionsWithGrantOption),
+			"resource_type":                 permissionsResourceType(apiObject.Resource),
+		})
+	}
+
+	return tfList
+}
+
+// permissionsResourceType returns the kind of resource a permission was granted on.
+func permissionsResourceType(apiObject *lakeformation.Resource) string {
+	switch {
+	case apiObject == nil:
+		return ""
+	case apiObject.Catalog != nil:
+		return lakeformation.DataLakeResourceTypeCatalog
+	case apiObject.DataLocation != nil:
+		return lakeformation.DataLakeResourceTypeDataLocation
+	case apiObject.Database != nil:
+		return lakeformation.DataLakeResourceTypeDatabase
+	case apiObject.LFTag != nil:
+		return lakeformation.DataLakeResourceTypeLfTag
+	case apiObject.LFTagPolicy != nil:
+		return lakeformation.DataLakeResourceTypeLfTagPolicy
+	case apiObject.DataCellsFilter != nil, apiObject.Table != nil, apiObject.TableWithColumns != nil:
+		return lakeformation.DataLakeResourceTypeTable
+	default:
+		return ""
+	}
+}
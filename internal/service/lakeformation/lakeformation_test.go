@@ -23,6 +23,14 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"basic":          testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"EffectivePermissionsDataSource": {
+			"basic": testAccEffectivePermissionsDataSource_basic,
+		},
+		"OptIn": {
+			"database":   testAccOptIn_database,
+			"disappears": testAccOptIn_disappears,
+			"table":      testAccOptIn_table,
+		},
 		"PermissionsBasic": {
 			"basic":               testAccPermissions_basic,
 			"database":            testAccPermissions_database,
@@ -61,6 +69,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"LFTagExpressionGrants": {
+			"basic":  testAccLFTagExpressionGrants_basic,
+			"update": testAccLFTagExpressionGrants_update,
+		},
 		"LFTags": {
 			"basic":           testAccLFTag_basic,
 			"disappears":      testAccLFTag_disappears,
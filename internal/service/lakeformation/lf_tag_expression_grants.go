@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lakeformation_lf_tag_expression_grants", name="LF-Tag Expression Grants")
+func ResourceLFTagExpressionGrants() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLFTagExpressionGrantsCreate,
+		ReadWithoutTimeout:   resourceLFTagExpressionGrantsRead,
+		UpdateWithoutTimeout: resourceLFTagExpressionGrantsUpdate,
+		DeleteWithoutTimeout: resourceLFTagExpressionGrantsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"expression": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLFTagValues(),
+							},
+						},
+					},
+				},
+			},
+			"grant": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lakeformation.ResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceLFTagExpressionGrantsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	policy := expandLFTagExpressionGrantsPolicy(d)
+
+	if err := batchGrantLFTagPolicyPermissions(ctx, conn, policy, d.Get("grant").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation LF-Tag Expression Grants: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(policy.String())))
+
+	return append(diags, resourceLFTagExpressionGrantsRead(ctx, d, meta)...)
+}
+
+func resourceLFTagExpressionGrantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	permissions, err := FindLFTagPolicyPermissions(ctx, conn, expandLFTagExpressionGrantsPolicy(d))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation LF-Tag Expression Grants (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation LF-Tag Expression Grants (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("grant", flattenLFTagExpressionGrants(permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting grant: %s", err)
+	}
+
+	return diags
+}
+
+func resourceLFTagExpressionGrantsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	if d.HasChange("grant") {
+		policy := expandLFTagExpressionGrantsPolicy(d)
+		o, n := d.GetChange("grant")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Revoke first so that a principal whose permissions changed ends up with exactly the new set.
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			if err := batchRevokeLFTagPolicyPermissions(ctx, conn, policy, del); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lake Formation LF-Tag Expression Grants (%s): %s", d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			if err := batchGrantLFTagPolicyPermissions(ctx, conn, policy, add); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lake Formation LF-Tag Expression Grants (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceLFTagExpressionGrantsRead(ctx, d, meta)...)
+}
+
+func resourceLFTagExpressionGrantsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Lake Formation LF-Tag Expression Grants: %s", d.Id())
+	if err := batchRevokeLFTagPolicyPermissions(ctx, conn, expandLFTagExpressionGrantsPolicy(d), d.Get("grant").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation LF-Tag Expression Grants (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindLFTagPolicyPermissions returns the permissions granted to all principals on the LF-Tag expression.
+func FindLFTagPolicyPermissions(ctx context.Context, conn *lakeformation.LakeFormation, policy *lakeformation.LFTagPolicyResource) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	input := &lakeformation.ListPermissionsInput{
+		CatalogId: policy.CatalogId,
+		Resource: &lakeformation.Resource{
+			LFTagPolicy: policy,
+		},
+	}
+	var output []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPagesWithContext(ctx, input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v != nil && v.Resource != nil && v.Resource.LFTagPolicy != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func batchGrantLFTagPolicyPermissions(ctx context.Context, conn *lakeformation.LakeFormation, policy *lakeformation.LFTagPolicyResource, tfList []interface{}) error {
+	input := &lakeformation.BatchGrantPermissionsInput{
+		CatalogId: policy.CatalogId,
+		Entries:   expandLFTagExpressionGrantEntries(policy, tfList),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.BatchGrantPermissionsWithContext(ctx, input)
+	}, lakeformation.ErrCodeInvalidInputException, "Invalid principal")
+
+	if err != nil {
+		return err
+	}
+
+	return batchPermissionsFailuresError(outputRaw.(*lakeformation.BatchGrantPermissionsOutput).Failures)
+}
+
+func batchRevokeLFTagPolicyPermissions(ctx context.Context, conn *lakeformation.LakeFormation, policy *lakeformation.LFTagPolicyResource, tfList []interface{}) error {
+	input := &lakeformation.BatchRevokePermissionsInput{
+		CatalogId: policy.CatalogId,
+		Entries:   expandLFTagExpressionGrantEntries(policy, tfList),
+	}
+
+	output, err := conn.BatchRevokePermissionsWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	var failures []*lakeformation.BatchPermissionsFailureEntry
+
+	for _, v := range output.Failures {
+		// Already revoked.
+		if v.Error != nil && aws.StringValue(v.Error.ErrorCode) == lakeformation.ErrCodeEntityNotFoundException {
+			continue
+		}
+
+		failures = append(failures, v)
+	}
+
+	return batchPermissionsFailuresError(failures)
+}
+
+func batchPermissionsFailuresError(apiObjects []*lakeformation.BatchPermissionsFailureEntry) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Error == nil {
+			continue
+		}
+
+		principal := ""
+		if v := apiObject.RequestEntry; v != nil && v.Principal != nil {
+			principal = aws.StringValue(v.Principal.DataLakePrincipalIdentifier)
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s: %s", principal, aws.StringValue(apiObject.Error.ErrorCode), aws.StringValue(apiObject.Error.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func expandLFTagExpressionGrantsPolicy(d *schema.ResourceData) *lakeformation.LFTagPolicyResource {
+	apiObject := &lakeformation.LFTagPolicyResource{
+		Expression:   ExpandLFTagExpression(d.Get("expression").(*schema.Set).List()),
+		ResourceType: aws.String(d.Get("resource_type").(string)),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		apiObject.CatalogId = aws.String(v.(string))
+	}
+
+	return apiObject
+}
+
+func expandLFTagExpressionGrantEntries(policy *lakeformation.LFTagPolicyResource, tfList []interface{}) []*lakeformation.BatchPermissionsRequestEntry {
+	var apiObjects []*lakeformation.BatchPermissionsRequestEntry
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lakeformation.BatchPermissionsRequestEntry{
+			Id: aws.String(strconv.Itoa(i)),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(tfMap["principal"].(string)),
+			},
+			Resource: &lakeformation.Resource{
+				LFTagPolicy: policy,
+			},
+		}
+
+		if v, ok := tfMap["permissions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Permissions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.PermissionsWithGrantOption = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLFTagExpressionGrants(apiObjects []*lakeformation.PrincipalResourcePermissions) []interface{} {
+	// A principal's permissions may be returned across several entries.
+	type grant struct {
+		permissions                []*string
+		permissionsWithGrantOption []*string
+	}
+	var principals []string
+	grants := make(map[string]*grant)
+
+	for _, apiObject := range apiObjects {
+		if apiObject.Principal == nil {
+			continue
+		}
+
+		principal := aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier)
+		g, ok := grants[principal]
+		if !ok {
+			g = &grant{}
+			grants[principal] = g
+			principals = append(principals, principal)
+		}
+
+		g.permissions = append(g.permissions, apiObject.Permissions...)
+		g.permissionsWithGrantOption = append(g.permissionsWithGrantOption, apiObject.PermissionsWithGrantOption...)
+	}
+
+	tfList := make([]interface{}, 0, len(principals))
+
+	for _, principal := range principals {
+		g := grants[principal]
+		tfList = append(tfList, map[string]interface{}{
+			"permissions":                   flex.FlattenStringSet(g.permissions),
+			"permissions_with_grant_option": flex.FlattenStringSet(g.permissionsWithGrantOption),
+			"principal":                     principal,
+		})
+	}
+
+	return tfList
+}
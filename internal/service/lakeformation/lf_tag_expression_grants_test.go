@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccLFTagExpressionGrants_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression_grants.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionGrantsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionGrantsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionGrantsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"permissions.#":                   "1",
+						"permissions_with_grant_option.#": "0",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionDescribe),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant.*.principal", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", lakeformation.ResourceTypeDatabase),
+				),
+			},
+		},
+	})
+}
+
+func testAccLFTagExpressionGrants_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression_grants.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionGrantsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionGrantsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionGrantsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+				),
+			},
+			{
+				Config: testAccLFTagExpressionGrantsConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionGrantsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"permissions.#":                   "2",
+						"permissions_with_grant_option.#": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccLFTagExpressionGrantsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionGrantsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLFTagExpressionGrantsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_lf_tag_expression_grants" {
+				continue
+			}
+
+			_, err := tflakeformation.FindLFTagPolicyPermissions(ctx, conn, lfTagPolicyFromState(rs))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation LF-Tag Expression Grants %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLFTagExpressionGrantsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		_, err := tflakeformation.FindLFTagPolicyPermissions(ctx, conn, lfTagPolicyFromState(rs))
+
+		return err
+	}
+}
+
+// lfTagPolicyFromState rebuilds the LF-Tag expression from the flattened "expression.<hash>.key" and
+// "expression.<hash>.values.<n>" state attributes.
+func lfTagPolicyFromState(rs *terraform.ResourceState) *lakeformation.LFTagPolicyResource {
+	apiObject := &lakeformation.LFTagPolicyResource{
+		ResourceType: aws.String(rs.Primary.Attributes["resource_type"]),
+	}
+
+	if v := rs.Primary.Attributes["catalog_id"]; v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	tags := make(map[string]*lakeformation.LFTag)
+
+	for k, v := range rs.Primary.Attributes {
+		parts := strings.Split(k, ".")
+
+		if len(parts) < 3 || parts[0] != "expression" {
+			continue
+		}
+
+		tag, ok := tags[parts[1]]
+		if !ok {
+			tag = &lakeformation.LFTag{}
+			tags[parts[1]] = tag
+			apiObject.Expression = append(apiObject.Expression, tag)
+		}
+
+		switch {
+		case len(parts) == 3 && parts[2] == "key":
+			tag.TagKey = aws.String(v)
+		case len(parts) == 4 && parts[2] == "values" && parts[3] != "#":
+			tag.TagValues = append(tag.TagValues, aws.String(v))
+		}
+	}
+
+	return apiObject
+}
+
+func testAccLFTagExpressionGrantsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccLFTagExpressionGrantsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLFTagExpressionGrantsConfig_base(rName), `
+resource "aws_lakeformation_lf_tag_expression_grants" "test" {
+  resource_type = "DATABASE"
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = aws_lakeformation_lf_tag.test.values
+  }
+
+  grant {
+    principal   = aws_iam_role.test[0].arn
+    permissions = ["DESCRIBE"]
+  }
+}
+`)
+}
+
+func testAccLFTagExpressionGrantsConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccLFTagExpressionGrantsConfig_base(rName), `
+resource "aws_lakeformation_lf_tag_expression_grants" "test" {
+  resource_type = "DATABASE"
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = aws_lakeformation_lf_tag.test.values
+  }
+
+  grant {
+    principal   = aws_iam_role.test[0].arn
+    permissions = ["DESCRIBE"]
+  }
+
+  grant {
+    principal                     = aws_iam_role.test[1].arn
+    permissions                   = ["ALTER", "CREATE_TABLE"]
+    permissions_with_grant_option = ["CREATE_TABLE"]
+  }
+}
+`)
+}
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
 		},
+		{
+			Factory:  DataSourceEffectivePermissions,
+			TypeName: "aws_lakeformation_effective_permissions",
+		},
 		{
 			Factory:  DataSourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
@@ -50,6 +54,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 		},
+		{
+			Factory:  ResourceLFTagExpressionGrants,
+			TypeName: "aws_lakeformation_lf_tag_expression_grants",
+			Name:     "LF-Tag Expression Grants",
+		},
+		{
+			Factory:  ResourceOptIn,
+			TypeName: "aws_lakeformation_opt_in",
+			Name:     "Opt In",
+		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/launchwizard"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_launchwizard_deployment", name="Deployment")
+// @Tags(identifierAttribute="arn")
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_pattern_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z0-9_\s\.-]+$`), "must contain only alphanumeric characters, whitespace, periods, underscores, and hyphens"),
+				),
+			},
+			"resource_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Specifications are returned by the API with sensitive values redacted.
+			"specifications": {
+				Type:      schema.TypeMap,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workload_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &launchwizard.CreateDeploymentInput{
+		DeploymentPatternName: aws.String(d.Get("deployment_pattern_name").(string)),
+		Name:                  aws.String(name),
+		Specifications:        flex.ExpandStringMap(d.Get("specifications").(map[string]interface{})),
+		Tags:                  getTagsIn(ctx),
+		WorkloadName:          aws.String(d.Get("workload_name").(string)),
+	}
+
+	output, err := conn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Launch Wizard Deployment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DeploymentId))
+
+	if _, err := waitDeploymentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Launch Wizard Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardConn(ctx)
+
+	deployment, err := FindDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Launch Wizard Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Launch Wizard Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", deployment.DeploymentArn)
+	if deployment.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(deployment.CreatedAt).Format(time.RFC3339))
+	}
+	d.Set("deployment_pattern_name", deployment.PatternName)
+	d.Set("name", deployment.Name)
+	d.Set("resource_group", deployment.ResourceGroup)
+	d.Set("status", deployment.Status)
+	d.Set("workload_name", deployment.WorkloadName)
+
+	return diags
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Launch Wizard Deployment: %s", d.Id())
+	_, err := conn.DeleteDeploymentWithContext(ctx, &launchwizard.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, launchwizard.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Launch Wizard Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Launch Wizard Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDeploymentByID(ctx context.Context, conn *launchwizard.LaunchWizard, id string) (*launchwizard.DeploymentData, error) {
+	input := &launchwizard.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeploymentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, launchwizard.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Deployment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Deployment.Status); status == launchwizard.DeploymentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Deployment, nil
+}
+
+func statusDeployment(ctx context.Context, conn *launchwizard.LaunchWizard, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDeploymentCreated(ctx context.Context, conn *launchwizard.LaunchWizard, id string, timeout time.Duration) (*launchwizard.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{launchwizard.DeploymentStatusCreating, launchwizard.DeploymentStatusInProgress, launchwizard.DeploymentStatusValidating},
+		Target:  []string{launchwizard.DeploymentStatusCompleted},
+		Refresh: statusDeployment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*launchwizard.DeploymentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentDeleted(ctx context.Context, conn *launchwizard.LaunchWizard, id string, timeout time.Duration) (*launchwizard.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{launchwizard.DeploymentStatusCompleted, launchwizard.DeploymentStatusDeleteInitiating, launchwizard.DeploymentStatusDeleteInProgress, launchwizard.DeploymentStatusFailed},
+		Target:  []string{},
+		Refresh: statusDeployment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*launchwizard.DeploymentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
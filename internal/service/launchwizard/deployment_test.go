@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/launchwizard"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tflaunchwizard "github.com/hashicorp/terraform-provider-aws/internal/service/launchwizard"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarSpecifications             = "AWS_LAUNCHWIZARD_SAP_SPECIFICATIONS"
+	envVarSpecificationsMessageError = "Environment variable AWS_LAUNCHWIZARD_SAP_SPECIFICATIONS is not set. " +
+		"It must be a JSON object holding the specifications of an SAP single instance deployment (VPC, subnets, key pair, SAP parameters)."
+)
+
+func TestAccLaunchWizardDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	specifications := envvar.SkipIfEmpty(t, envVarSpecifications, envVarSpecificationsMessageError)
+	var v launchwizard.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, launchwizard.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, launchwizard.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, specifications),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "launchwizard", regexache.MustCompile(`deployment/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_pattern_name", "SapHanaSingle"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", "SAP"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specifications"},
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *launchwizard.DeploymentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardConn(ctx)
+
+		output, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launchwizard_deployment" {
+				continue
+			}
+
+			_, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Launch Wizard Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_basic(rName, specifications string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  deployment_pattern_name = "SapHanaSingle"
+  workload_name           = "SAP"
+  specifications          = jsondecode(%[2]q)
+}
+`, rName, specifications)
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKResource("aws_macie2_automated_discovery_configuration")
+func ResourceAutomatedDiscoveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		ReadWithoutTimeout:   resourceAutomatedDiscoveryConfigurationRead,
+		UpdateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		DeleteWithoutTimeout: resourceAutomatedDiscoveryConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable_organization_members": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.AutoEnableMode_Values(), false),
+			},
+			"classification_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"excluded_bucket_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"first_enabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sensitivity_inspection_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_managed_data_identifier_ids": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"sensitivity_inspection_template.0.included_managed_data_identifier_ids"},
+						},
+						"included_allow_list_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_custom_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_managed_data_identifier_ids": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"sensitivity_inspection_template.0.excluded_managed_data_identifier_ids"},
+						},
+					},
+				},
+			},
+			"sensitivity_inspection_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.AutomatedDiscoveryStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAutomatedDiscoveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	if d.IsNewResource() || d.HasChanges("auto_enable_organization_members", "status") {
+		input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+			Status: aws.String(d.Get("status").(string)),
+		}
+
+		if v, ok := d.GetOk("auto_enable_organization_members"); ok {
+			input.AutoEnableOrganizationMembers = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Macie automated discovery configuration: %s", err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	// The classification scope and sensitivity inspection template are created by Macie when automated discovery is first enabled.
+	if d.IsNewResource() || d.HasChanges("excluded_bucket_names", "sensitivity_inspection_template") {
+		output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.GetAutomatedDiscoveryConfigurationInput{})
+
+		if err != nil {
+			return diag.Errorf("reading Macie automated discovery configuration: %s", err)
+		}
+
+		if v := aws.StringValue(output.ClassificationScopeId); v != "" && (d.IsNewResource() || d.HasChange("excluded_bucket_names")) {
+			input := &macie2.UpdateClassificationScopeInput{
+				Id: aws.String(v),
+				S3: &macie2.S3ClassificationScopeUpdate{
+					Excludes: &macie2.S3ClassificationScopeExclusionUpdate{
+						BucketNames: flex.ExpandStringSet(d.Get("excluded_bucket_names").(*schema.Set)),
+						Operation:   aws.String(macie2.ClassificationScopeUpdateOperationReplace),
+					},
+				},
+			}
+
+			if input.S3.Excludes.BucketNames == nil {
+				input.S3.Excludes.BucketNames = []*string{}
+			}
+
+			_, err := conn.UpdateClassificationScopeWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating Macie classification scope (%s): %s", v, err)
+			}
+		}
+
+		if v := aws.StringValue(output.SensitivityInspectionTemplateId); v != "" && (d.IsNewResource() || d.HasChange("sensitivity_inspection_template")) {
+			input := expandSensitivityInspectionTemplate(d.Get("sensitivity_inspection_template").([]interface{}))
+			input.Id = aws.String(v)
+
+			_, err := conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating Macie sensitivity inspection template (%s): %s", v, err)
+			}
+		}
+	}
+
+	return resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)
+}
+
+func resourceAutomatedDiscoveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.GetAutomatedDiscoveryConfigurationInput{})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie not enabled for AWS account (%s), removing automated discovery configuration from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Macie automated discovery configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auto_enable_organization_members", output.AutoEnableOrganizationMembers)
+	d.Set("classification_scope_id", output.ClassificationScopeId)
+	if output.FirstEnabledAt != nil {
+		d.Set("first_enabled_at", aws.TimeValue(output.FirstEnabledAt).Format(time.RFC3339))
+	} else {
+		d.Set("first_enabled_at", nil)
+	}
+	if output.LastUpdatedAt != nil {
+		d.Set("last_updated_at", aws.TimeValue(output.LastUpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_at", nil)
+	}
+	d.Set("sensitivity_inspection_template_id", output.SensitivityInspectionTemplateId)
+	d.Set("status", output.Status)
+
+	if v := aws.StringValue(output.ClassificationScopeId); v != "" {
+		scope, err := conn.GetClassificationScopeWithContext(ctx, &macie2.GetClassificationScopeInput{
+			Id: aws.String(v),
+		})
+
+		if err != nil {
+			return diag.Errorf("reading Macie classification scope (%s): %s", v, err)
+		}
+
+		var bucketNames []*string
+		if scope.S3 != nil && scope.S3.Excludes != nil {
+			bucketNames = scope.S3.Excludes.BucketNames
+		}
+		d.Set("excluded_bucket_names", aws.StringValueSlice(bucketNames))
+	}
+
+	if v := aws.StringValue(output.SensitivityInspectionTemplateId); v != "" {
+		template, err := conn.GetSensitivityInspectionTemplateWithContext(ctx, &macie2.GetSensitivityInspectionTemplateInput{
+			Id: aws.String(v),
+		})
+
+		if err != nil {
+			return diag.Errorf("reading Macie sensitivity inspection template (%s): %s", v, err)
+		}
+
+		if err := d.Set("sensitivity_inspection_template", flattenSensitivityInspectionTemplate(template)); err != nil {
+			return diag.Errorf("setting sensitivity_inspection_template: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAutomatedDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	log.Printf("[DEBUG] Disabling Macie automated discovery: %s", d.Id())
+	_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: aws.String(macie2.AutomatedDiscoveryStatusDisabled),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Macie automated discovery (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSensitivityInspectionTemplate(tfList []interface{}) *macie2.UpdateSensitivityInspectionTemplateInput {
+	// Removing the block resets the template to Macie's default set of managed data identifiers.
+	apiObject := &macie2.UpdateSensitivityInspectionTemplateInput{
+		Excludes: &macie2.SensitivityInspectionTemplateExcludes{
+			ManagedDataIdentifierIds: []*string{},
+		},
+		Includes: &macie2.SensitivityInspectionTemplateIncludes{
+			AllowListIds:             []*string{},
+			CustomDataIdentifierIds:  []*string{},
+			ManagedDataIdentifierIds: []*string{},
+		},
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["excluded_managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Excludes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_allow_list_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Includes.AllowListIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_custom_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Includes.CustomDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Includes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSensitivityInspectionTemplate(apiObject *macie2.GetSensitivityInspectionTemplateOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Excludes; v != nil {
+		tfMap["excluded_managed_data_identifier_ids"] = aws.StringValueSlice(v.ManagedDataIdentifierIds)
+	}
+
+	if v := apiObject.Includes; v != nil {
+		tfMap["included_allow_list_ids"] = aws.StringValueSlice(v.AllowListIds)
+		tfMap["included_custom_data_identifier_ids"] = aws.StringValueSlice(v.CustomDataIdentifierIds)
+		tfMap["included_managed_data_identifier_ids"] = aws.StringValueSlice(v.ManagedDataIdentifierIds)
+	}
+
+	// An empty template is equivalent to no block in configuration.
+	for _, v := range tfMap {
+		if len(v.([]string)) > 0 {
+			return []interface{}{tfMap}
+		}
+	}
+
+	return nil
+}
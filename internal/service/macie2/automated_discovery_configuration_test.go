@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccAutomatedDiscoveryConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(macie2.AutomatedDiscoveryStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "classification_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "first_enabled_at"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "sensitivity_inspection_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.AutomatedDiscoveryStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_exclusions(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excluded_bucket_names.*", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.0.excluded_managed_data_identifier_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sensitivity_inspection_template.0.excluded_managed_data_identifier_ids.*", "ADDRESS"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sensitivity_inspection_template.0.excluded_managed_data_identifier_ids.*", "NAME"),
+				),
+			},
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(macie2.AutomatedDiscoveryStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.AutomatedDiscoveryStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		_, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.GetAutomatedDiscoveryConfigurationInput{})
+
+		return err
+	}
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_automated_discovery_configuration" {
+				continue
+			}
+
+			output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.GetAutomatedDiscoveryConfigurationInput{})
+
+			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+				tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if status := *output.Status; status != macie2.AutomatedDiscoveryStatusDisabled {
+				return fmt.Errorf("Macie automated discovery %s still %s", rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, status)
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_exclusions(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status                = "ENABLED"
+  excluded_bucket_names = [aws_s3_bucket.test.bucket]
+
+  sensitivity_inspection_template {
+    excluded_managed_data_identifier_ids = ["ADDRESS", "NAME"]
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, bucketName)
+}
@@ -79,7 +79,7 @@ func resourceClassificationExportConfigurationCreate(ctx context.Context, d *sch
 
 	log.Printf("[DEBUG] Creating Macie classification export configuration: %s", input)
 
-	_, err := conn.PutClassificationExportConfigurationWithContext(ctx, &input)
+	_, err := waitClassificationExportConfigurationValid(ctx, conn, &input)
 
 	if err != nil {
 		return diag.Errorf("creating Macie classification export configuration failed: %s", err)
@@ -103,7 +103,7 @@ func resourceClassificationExportConfigurationUpdate(ctx context.Context, d *sch
 
 	log.Printf("[DEBUG] Creating Macie classification export configuration: %s", input)
 
-	_, err := conn.PutClassificationExportConfigurationWithContext(ctx, &input)
+	_, err := waitClassificationExportConfigurationValid(ctx, conn, &input)
 
 	if err != nil {
 		return diag.Errorf("creating Macie classification export configuration failed: %s", err)
@@ -20,6 +20,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			"disappears":                   testAccAccount_disappears,
 		},
+		"AutomatedDiscoveryConfiguration": {
+			"basic": testAccAutomatedDiscoveryConfiguration_basic,
+		},
 		"ClassificationExportConfiguration": {
 			"basic": testAccClassificationExportConfiguration_basic,
 		},
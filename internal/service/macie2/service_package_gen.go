@@ -33,6 +33,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccount,
 			TypeName: "aws_macie2_account",
 		},
+		{
+			Factory:  ResourceAutomatedDiscoveryConfiguration,
+			TypeName: "aws_macie2_automated_discovery_configuration",
+		},
 		{
 			Factory:  ResourceClassificationExportConfiguration,
 			TypeName: "aws_macie2_classification_export_configuration",
@@ -8,12 +8,17 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	// Maximum amount of time to wait for the statusMemberRelationship to be Invited, Enabled, or Paused
 	memberInvitedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for Macie to be able to use a classification export S3 bucket and KMS key
+	classificationExportConfigurationValidTimeout = 2 * time.Minute
 )
 
 // waitMemberInvited waits for an AdminAccount to return Invited, Enabled and Paused
@@ -33,3 +38,27 @@ func waitMemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID
 
 	return nil, err
 }
+
+// waitClassificationExportConfigurationValid puts the classification export configuration, retrying while Macie
+// reports that it cannot use the S3 bucket or KMS key. Macie validates the destination when the configuration is put,
+// and newly created bucket and key policies can take some time to become effective.
+func waitClassificationExportConfigurationValid(ctx context.Context, conn *macie2.Macie2, input *macie2.PutClassificationExportConfigurationInput) (*macie2.PutClassificationExportConfigurationOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, classificationExportConfigurationValidTimeout,
+		func() (interface{}, error) {
+			return conn.PutClassificationExportConfigurationWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeValidationException) || tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "KMS") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if output, ok := outputRaw.(*macie2.PutClassificationExportConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
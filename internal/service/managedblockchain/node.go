@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	nodeResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_managedblockchain_node", name="Node")
+// @Tags(identifierAttribute="arn")
+func ResourceNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNodeCreate,
+		ReadWithoutTimeout:   resourceNodeRead,
+		UpdateWithoutTimeout: resourceNodeUpdate,
+		DeleteWithoutTimeout: resourceNodeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_db": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchain.StateDBType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"websocket_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	networkID := d.Get("network_id").(string)
+	input := &managedblockchain.CreateNodeInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		NetworkId:          aws.String(networkID),
+		NodeConfiguration: &managedblockchain.NodeConfiguration{
+			AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+			InstanceType:     aws.String(d.Get("instance_type").(string)),
+		},
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("member_id"); ok {
+		input.MemberId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("state_db"); ok {
+		input.NodeConfiguration.StateDB = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateNodeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Node (%s): %s", networkID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{networkID, aws.StringValue(output.NodeId)}, nodeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitNodeCreated(ctx, conn, networkID, aws.StringValue(output.NodeId), d.Get("member_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceNodeRead(ctx, d, meta)...)
+}
+
+func resourceNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, nodeID := parts[0], parts[1]
+	node, err := FindNodeByThreePartKey(ctx, conn, networkID, nodeID, d.Get("member_id").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Node (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", node.Arn)
+	d.Set("availability_zone", node.AvailabilityZone)
+	if node.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(node.CreationDate).Format(time.RFC3339))
+	}
+	d.Set("http_endpoint", nil)
+	d.Set("websocket_endpoint", nil)
+	if v := node.FrameworkAttributes; v != nil && v.Ethereum != nil {
+		d.Set("http_endpoint", v.Ethereum.HttpEndpoint)
+		d.Set("websocket_endpoint", v.Ethereum.WebSocketEndpoint)
+	}
+	d.Set("instance_type", node.InstanceType)
+	d.Set("member_id", node.MemberId)
+	d.Set("network_id", node.NetworkId)
+	d.Set("node_id", node.Id)
+	d.Set("state_db", node.StateDB)
+	d.Set("status", node.Status)
+
+	return diags
+}
+
+func resourceNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceNodeRead(ctx, d, meta)...)
+}
+
+func resourceNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, nodeID := parts[0], parts[1]
+	memberID := d.Get("member_id").(string)
+	input := &managedblockchain.DeleteNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	log.Printf("[DEBUG] Deleting Managed Blockchain Node: %s", d.Id())
+	_, err = conn.DeleteNodeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Managed Blockchain Node (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNodeDeleted(ctx, conn, networkID, nodeID, memberID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindNodeByThreePartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, nodeID, memberID string) (*managedblockchain.Node, error) {
+	input := &managedblockchain.GetNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	output, err := conn.GetNodeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Node == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Node.Status); status == managedblockchain.NodeStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Node, nil
+}
+
+func statusNode(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, nodeID, memberID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNodeByThreePartKey(ctx, conn, networkID, nodeID, memberID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitNodeCreated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, nodeID, memberID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{managedblockchain.NodeStatusCreating},
+		Target:  []string{managedblockchain.NodeStatusAvailable},
+		Refresh: statusNode(ctx, conn, networkID, nodeID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Node); ok {
+		if status := aws.StringValue(output.Status); status == managedblockchain.NodeStatusCreateFailed || status == managedblockchain.NodeStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("node status: %s", status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNodeDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, nodeID, memberID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			managedblockchain.NodeStatusAvailable,
+			managedblockchain.NodeStatusCreateFailed,
+			managedblockchain.NodeStatusDeleting,
+			managedblockchain.NodeStatusFailed,
+			managedblockchain.NodeStatusUnhealthy,
+		},
+		Target:  []string{},
+		Refresh: statusNode(ctx, conn, networkID, nodeID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Node); ok {
+		return output, err
+	}
+
+	return nil, err
+}
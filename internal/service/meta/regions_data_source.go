@@ -5,17 +5,29 @@ package meta
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
+const (
+	// regionsDetailsConcurrency is the maximum number of Regions whose details are read concurrently.
+	regionsDetailsConcurrency = 10
+)
+
 // @FrameworkDataSource
 func newDataSourceRegions(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceRegions{}
@@ -45,10 +57,19 @@ func (d *dataSourceRegions) Schema(ctx context.Context, req datasource.SchemaReq
 				Optional: true,
 				Computed: true,
 			},
+			"include_details": schema.BoolAttribute{
+				Optional: true,
+			},
 			"names": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"regions": schema.ListAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: regionsRegionAttributeTypes,
+				},
+				Computed: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"filter": tfec2.CustomFiltersBlock(),
@@ -83,19 +104,151 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 	}
 
 	var names []string
+	regions := make([]regionsRegion, 0, len(output.Regions))
 	for _, v := range output.Regions {
 		names = append(names, aws.StringValue(v.RegionName))
+		regions = append(regions, regionsRegion{
+			endpoint:    aws.StringValue(v.Endpoint),
+			name:        aws.StringValue(v.RegionName),
+			optInStatus: aws.StringValue(v.OptInStatus),
+		})
+	}
+
+	if data.IncludeDetails.ValueBool() {
+		if err := d.readRegionsDetails(ctx, regions); err != nil {
+			response.Diagnostics.AddError("reading Regions details", err.Error())
+
+			return
+		}
 	}
 
 	data.ID = types.StringValue(d.Meta().Partition)
 	data.Names = flex.FlattenFrameworkStringValueSetLegacy(ctx, names)
+	data.Regions = flattenRegionsRegions(regions)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+// readRegionsDetails reads the default EBS encryption and STS endpoint status of each enabled Region.
+// Regions that are not enabled for the account cannot be called and are left without details.
+func (d *dataSourceRegions) readRegionsDetails(ctx context.Context, regions []regionsRegion) error {
+	meta := d.Meta()
+	ec2Config := meta.EC2Conn(ctx).Config
+	stsConfig := meta.STSConn(ctx).Config
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, regionsDetailsConcurrency)
+	errs := make([]error, len(regions))
+
+	for i := range regions {
+		if !regions[i].enabled() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(region *regionsRegion, err *error) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			*err = readRegionsRegionDetails(ctx, meta, &ec2Config, &stsConfig, region)
+		}(&regions[i], &errs[i])
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readRegionsRegionDetails(ctx context.Context, meta *conns.AWSClient, ec2Config, stsConfig *aws.Config, region *regionsRegion) error {
+	ec2Session, err := conns.NewSessionForRegion(ec2Config, region.name, meta.TerraformVersion)
+
+	if err != nil {
+		return fmt.Errorf("creating AWS session (%s): %w", region.name, err)
+	}
+
+	output, err := ec2.New(ec2Session).GetEbsEncryptionByDefaultWithContext(ctx, &ec2.GetEbsEncryptionByDefaultInput{})
+
+	if err != nil {
+		return fmt.Errorf("reading EBS default encryption (%s): %w", region.name, err)
+	}
+
+	region.ebsEncryptionByDefault = aws.Bool(aws.BoolValue(output.EbsEncryptionByDefault))
+
+	stsSession, err := conns.NewSessionForRegion(stsConfig, region.name, meta.TerraformVersion)
+
+	if err != nil {
+		return fmt.Errorf("creating AWS session (%s): %w", region.name, err)
+	}
+
+	// Use the Regional STS endpoint, which can be deactivated for the account, rather than the global one.
+	stsConn := sts.New(stsSession, &aws.Config{STSRegionalEndpoint: endpoints.RegionalSTSEndpoint})
+
+	_, err = stsConn.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, sts.ErrCodeRegionDisabledException):
+		region.stsEndpointActive = aws.Bool(false)
+	case err != nil:
+		return fmt.Errorf("reading STS caller identity (%s): %w", region.name, err)
+	default:
+		region.stsEndpointActive = aws.Bool(true)
+	}
+
+	return nil
+}
+
 type dataSourceRegionsData struct {
-	AllRegions types.Bool   `tfsdk:"all_regions"`
-	Filters    types.Set    `tfsdk:"filter"`
-	ID         types.String `tfsdk:"id"`
-	Names      types.Set    `tfsdk:"names"`
+	AllRegions     types.Bool   `tfsdk:"all_regions"`
+	Filters        types.Set    `tfsdk:"filter"`
+	ID             types.String `tfsdk:"id"`
+	IncludeDetails types.Bool   `tfsdk:"include_details"`
+	Names          types.Set    `tfsdk:"names"`
+	Regions        types.List   `tfsdk:"regions"`
+}
+
+var regionsRegionAttributeTypes = map[string]attr.Type{
+	"ebs_encryption_by_default": types.BoolType,
+	"enabled":                   types.BoolType,
+	"endpoint":                  types.StringType,
+	"name":                      types.StringType,
+	"opt_in_status":             types.StringType,
+	"sts_endpoint_active":       types.BoolType,
+}
+
+type regionsRegion struct {
+	ebsEncryptionByDefault *bool
+	endpoint               string
+	name                   string
+	optInStatus            string
+	stsEndpointActive      *bool
+}
+
+// enabled returns whether the Region can be used by the account.
+func (r regionsRegion) enabled() bool {
+	return r.optInStatus == ec2.AvailabilityZoneOptInStatusOptInNotRequired || r.optInStatus == ec2.AvailabilityZoneOptInStatusOptedIn
+}
+
+func flattenRegionsRegions(regions []regionsRegion) types.List {
+	elementType := types.ObjectType{AttrTypes: regionsRegionAttributeTypes}
+	elements := make([]attr.Value, 0, len(regions))
+
+	for _, region := range regions {
+		elements = append(elements, types.ObjectValueMust(regionsRegionAttributeTypes, map[string]attr.Value{
+			"ebs_encryption_by_default": types.BoolPointerValue(region.ebsEncryptionByDefault),
+			"enabled":                   types.BoolValue(region.enabled()),
+			"endpoint":                  types.StringValue(region.endpoint),
+			"name":                      types.StringValue(region.name),
+			"opt_in_status":             types.StringValue(region.optInStatus),
+			"sts_endpoint_active":       types.BoolPointerValue(region.stsEndpointActive),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements)
 }
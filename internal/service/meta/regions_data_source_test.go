@@ -88,6 +88,31 @@ func TestAccMetaRegionsDataSource_nonExistentRegion(t *testing.T) {
 	})
 }
 
+func TestAccMetaRegionsDataSource_includeDetails(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_regions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig_includeDetails(acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "regions.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "regions.0.ebs_encryption_by_default"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "regions.0.endpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.name", acctest.Region()),
+					resource.TestCheckResourceAttrSet(dataSourceName, "regions.0.opt_in_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.sts_endpoint_active", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRegionsDataSourceConfig_empty() string {
 	return `
 data "aws_regions" "test" {}
@@ -123,3 +148,16 @@ data "aws_regions" "test" {
 }
 `
 }
+
+func testAccRegionsDataSourceConfig_includeDetails(region string) string {
+	return fmt.Sprintf(`
+data "aws_regions" "test" {
+  include_details = true
+
+  filter {
+    name   = "region-name"
+    values = [%[1]q]
+  }
+}
+`, region)
+}
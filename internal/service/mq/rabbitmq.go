@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mq"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// rabbitMQAWSOwnedUserSuffix identifies users that Amazon MQ creates for its own use.
+	rabbitMQAWSOwnedUserSuffix = "AWS-OWNED-DO-NOT-DELETE"
+)
+
+// rabbitMQClient is a minimal client for the RabbitMQ management HTTP API of an Amazon MQ for RabbitMQ broker.
+type rabbitMQClient struct {
+	endpoint string
+	password string
+	username string
+}
+
+// newRabbitMQClient returns a management API client for the specified broker using its web console URL.
+func newRabbitMQClient(ctx context.Context, conn *mq.MQ, brokerID, username, password string) (*rabbitMQClient, error) {
+	broker, err := FindBrokerByID(ctx, conn, brokerID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(aws.StringValue(broker.EngineType), mq.EngineTypeRabbitmq) {
+		return nil, fmt.Errorf("MQ Broker (%s) engine type is %s, not %s", brokerID, aws.StringValue(broker.EngineType), mq.EngineTypeRabbitmq)
+	}
+
+	var endpoint string
+	for _, v := range broker.BrokerInstances {
+		if v != nil && aws.StringValue(v.ConsoleURL) != "" {
+			endpoint = strings.TrimSuffix(aws.StringValue(v.ConsoleURL), "/")
+			break
+		}
+	}
+
+	if endpoint == "" {
+		return nil, fmt.Errorf("MQ Broker (%s) has no web console URL", brokerID)
+	}
+
+	return &rabbitMQClient{
+		endpoint: endpoint,
+		password: password,
+		username: username,
+	}, nil
+}
+
+// do sends a request to the management API. The path must already be escaped.
+// A 404 response is returned as a NotFoundError.
+func (c *rabbitMQClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(b)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, c.endpoint+"/api/"+path, body)
+
+	if err != nil {
+		return err
+	}
+
+	request.SetBasicAuth(c.username, c.password)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return &retry.NotFoundError{
+			Message: fmt.Sprintf("%s %s: %s", method, path, response.Status),
+		}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		b, _ := io.ReadAll(response.Body)
+
+		return fmt.Errorf("%s %s: %s: %s", method, path, response.Status, strings.TrimSpace(string(b)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(response.Body).Decode(out)
+}
+
+type rabbitMQUser struct {
+	HashingAlgorithm string          `json:"hashing_algorithm"`
+	Name             string          `json:"name"`
+	PasswordHash     string          `json:"password_hash"`
+	Tags             json.RawMessage `json:"tags"`
+}
+
+// tags returns the user's tags. RabbitMQ 3.8 returns them as a comma-separated string and later versions as a list.
+func (u rabbitMQUser) tags() []string {
+	var list []string
+	if err := json.Unmarshal(u.Tags, &list); err == nil {
+		return list
+	}
+
+	var s string
+	if err := json.Unmarshal(u.Tags, &s); err != nil || s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
+
+// passwordMatches returns whether the password matches the user's salted password hash.
+func (u rabbitMQUser) passwordMatches(password string) bool {
+	return rabbitMQPasswordMatches(u.HashingAlgorithm, u.PasswordHash, password)
+}
+
+// rabbitMQPasswordMatches returns whether the password matches a RabbitMQ salted password hash,
+// which is the Base64 encoding of a 4 byte salt followed by the hash of the salt and password.
+// Hashes using an unsupported algorithm are assumed to match.
+func rabbitMQPasswordMatches(hashingAlgorithm, passwordHash, password string) bool {
+	var h hash.Hash
+	switch hashingAlgorithm {
+	case "rabbit_password_hashing_sha256":
+		h = sha256.New()
+	case "rabbit_password_hashing_sha512":
+		h = sha512.New()
+	default:
+		return true
+	}
+
+	b, err := base64.StdEncoding.DecodeString(passwordHash)
+
+	if err != nil || len(b) < 4 {
+		return true
+	}
+
+	salt, sum := b[:4], b[4:]
+	h.Write(salt)
+	h.Write([]byte(password))
+
+	return subtle.ConstantTimeCompare(h.Sum(nil), sum) == 1
+}
+
+// awsOwned returns whether the user is managed by Amazon MQ.
+func (u rabbitMQUser) awsOwned() bool {
+	return strings.HasSuffix(u.Name, rabbitMQAWSOwnedUserSuffix)
+}
+
+type rabbitMQPermission struct {
+	Configure string `json:"configure"`
+	Read      string `json:"read"`
+	User      string `json:"user,omitempty"`
+	VHost     string `json:"vhost,omitempty"`
+	Write     string `json:"write"`
+}
+
+type rabbitMQPolicy struct {
+	ApplyTo    string                 `json:"apply-to"`
+	Definition map[string]interface{} `json:"definition"`
+	Name       string                 `json:"name,omitempty"`
+	Pattern    string                 `json:"pattern"`
+	Priority   int                    `json:"priority"`
+	VHost      string                 `json:"vhost,omitempty"`
+}
+
+type rabbitMQVHostLimits struct {
+	Value map[string]int `json:"value"`
+	VHost string         `json:"vhost"`
+}
+
+func (c *rabbitMQClient) listUsers(ctx context.Context) ([]rabbitMQUser, error) {
+	var users []rabbitMQUser
+
+	if err := c.do(ctx, http.MethodGet, "users", nil, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (c *rabbitMQClient) putUser(ctx context.Context, name, password string, tags []string) error {
+	return c.do(ctx, http.MethodPut, "users/"+url.PathEscape(name), map[string]interface{}{
+		"password": password,
+		"tags":     strings.Join(tags, ","),
+	}, nil)
+}
+
+func (c *rabbitMQClient) deleteUser(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, "users/"+url.PathEscape(name), nil, nil)
+}
+
+func (c *rabbitMQClient) listUserPermissions(ctx context.Context, user string) ([]rabbitMQPermission, error) {
+	var permissions []rabbitMQPermission
+
+	if err := c.do(ctx, http.MethodGet, "users/"+url.PathEscape(user)+"/permissions", nil, &permissions); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (c *rabbitMQClient) putPermission(ctx context.Context, vhost, user string, permission rabbitMQPermission) error {
+	return c.do(ctx, http.MethodPut, "permissions/"+url.PathEscape(vhost)+"/"+url.PathEscape(user), rabbitMQPermission{
+		Configure: permission.Configure,
+		Read:      permission.Read,
+		Write:     permission.Write,
+	}, nil)
+}
+
+func (c *rabbitMQClient) deletePermission(ctx context.Context, vhost, user string) error {
+	return c.do(ctx, http.MethodDelete, "permissions/"+url.PathEscape(vhost)+"/"+url.PathEscape(user), nil, nil)
+}
+
+func (c *rabbitMQClient) listPolicies(ctx context.Context, vhost string) ([]rabbitMQPolicy, error) {
+	var policies []rabbitMQPolicy
+
+	if err := c.do(ctx, http.MethodGet, "policies/"+url.PathEscape(vhost), nil, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (c *rabbitMQClient) putPolicy(ctx context.Context, vhost string, policy rabbitMQPolicy) error {
+	return c.do(ctx, http.MethodPut, "policies/"+url.PathEscape(vhost)+"/"+url.PathEscape(policy.Name), rabbitMQPolicy{
+		ApplyTo:    policy.ApplyTo,
+		Definition: policy.Definition,
+		Pattern:    policy.Pattern,
+		Priority:   policy.Priority,
+	}, nil)
+}
+
+func (c *rabbitMQClient) deletePolicy(ctx context.Context, vhost, name string) error {
+	return c.do(ctx, http.MethodDelete, "policies/"+url.PathEscape(vhost)+"/"+url.PathEscape(name), nil, nil)
+}
+
+// getVHostLimits returns the limits set on the virtual host, keyed by limit name.
+func (c *rabbitMQClient) getVHostLimits(ctx context.Context, vhost string) (map[string]int, error) {
+	var limits []rabbitMQVHostLimits
+
+	if err := c.do(ctx, http.MethodGet, "vhost-limits/"+url.PathEscape(vhost), nil, &limits); err != nil {
+		return nil, err
+	}
+
+	for _, v := range limits {
+		if v.VHost == vhost {
+			return v.Value, nil
+		}
+	}
+
+	return map[string]int{}, nil
+}
+
+func (c *rabbitMQClient) putVHostLimit(ctx context.Context, vhost, name string, value int) error {
+	return c.do(ctx, http.MethodPut, "vhost-limits/"+url.PathEscape(vhost)+"/"+url.PathEscape(name), map[string]int{
+		"value": value,
+	}, nil)
+}
+
+func (c *rabbitMQClient) deleteVHostLimit(ctx context.Context, vhost, name string) error {
+	return c.do(ctx, http.MethodDelete, "vhost-limits/"+url.PathEscape(vhost)+"/"+url.PathEscape(name), nil, nil)
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_mq_rabbitmq_policies", name="RabbitMQ Policies")
+func ResourceRabbitMQPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRabbitMQPoliciesCreate,
+		ReadWithoutTimeout:   resourceRabbitMQPoliciesRead,
+		UpdateWithoutTimeout: resourceRabbitMQPoliciesUpdate,
+		DeleteWithoutTimeout: resourceRabbitMQPoliciesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"admin_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"admin_username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"broker_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_queues": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"policy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_to": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      rabbitMQPolicyApplyToAll,
+							ValidateFunc: validation.StringInSlice(rabbitMQPolicyApplyTo_Values(), false),
+						},
+						"definition": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+							StateFunc: func(v interface{}) string {
+								definition, _ := structure.NormalizeJsonString(v)
+								return definition
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "/",
+			},
+		},
+	}
+}
+
+const (
+	rabbitMQPolicyApplyToAll           = "all"
+	rabbitMQPolicyApplyToClassicQueues = "classic_queues"
+	rabbitMQPolicyApplyToExchanges     = "exchanges"
+	rabbitMQPolicyApplyToQueues        = "queues"
+	rabbitMQPolicyApplyToQuorumQueues  = "quorum_queues"
+	rabbitMQPolicyApplyToStreams       = "streams"
+)
+
+func rabbitMQPolicyApplyTo_Values() []string {
+	return []string{
+		rabbitMQPolicyApplyToAll,
+		rabbitMQPolicyApplyToClassicQueues,
+		rabbitMQPolicyApplyToExchanges,
+		rabbitMQPolicyApplyToQueues,
+		rabbitMQPolicyApplyToQuorumQueues,
+		rabbitMQPolicyApplyToStreams,
+	}
+}
+
+const (
+	rabbitMQVHostLimitMaxConnections = "max-connections"
+	rabbitMQVHostLimitMaxQueues      = "max-queues"
+)
+
+const (
+	rabbitMQPoliciesResourceIDPartCount = 2
+)
+
+func resourceRabbitMQPoliciesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	brokerID := d.Get("broker_id").(string)
+	vhost := d.Get("vhost").(string)
+	id, err := flex.FlattenResourceId([]string{brokerID, vhost}, rabbitMQPoliciesResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := newRabbitMQClient(ctx, conn, brokerID, d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if err != nil {
+		return diag.Errorf("creating MQ RabbitMQ Policies (%s): %s", id, err)
+	}
+
+	policies, err := expandRabbitMQPolicies(d.Get("policy").(*schema.Set).List())
+
+	if err != nil {
+		return diag.Errorf("creating MQ RabbitMQ Policies (%s): %s", id, err)
+	}
+
+	if err := syncRabbitMQPolicies(ctx, client, vhost, policies); err != nil {
+		return diag.Errorf("creating MQ RabbitMQ Policies (%s): %s", id, err)
+	}
+
+	if err := syncRabbitMQVHostLimits(ctx, client, vhost, expandRabbitMQVHostLimits(d)); err != nil {
+		return diag.Errorf("creating MQ RabbitMQ Policies (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRabbitMQPoliciesRead(ctx, d, meta)
+}
+
+func resourceRabbitMQPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), rabbitMQPoliciesResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	brokerID, vhost := parts[0], parts[1]
+	client, err := newRabbitMQClient(ctx, conn, brokerID, d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MQ Broker (%s) not found, removing MQ RabbitMQ Policies (%s) from state", brokerID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+	}
+
+	policies, err := client.listPolicies(ctx, vhost)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MQ RabbitMQ virtual host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+	}
+
+	limits, err := client.getVHostLimits(ctx, vhost)
+
+	if err != nil {
+		return diag.Errorf("reading MQ RabbitMQ Policies (%s) virtual host limits: %s", d.Id(), err)
+	}
+
+	tfList := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		definition, err := json.Marshal(policy.Definition)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_to":   policy.ApplyTo,
+			"definition": string(definition),
+			"name":       policy.Name,
+			"pattern":    policy.Pattern,
+			"priority":   policy.Priority,
+		})
+	}
+
+	d.Set("broker_id", brokerID)
+	d.Set("max_connections", limits[rabbitMQVHostLimitMaxConnections])
+	d.Set("max_queues", limits[rabbitMQVHostLimitMaxQueues])
+	if err := d.Set("policy", tfList); err != nil {
+		return diag.Errorf("setting policy: %s", err)
+	}
+	d.Set("vhost", vhost)
+
+	return nil
+}
+
+func resourceRabbitMQPoliciesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	client, err := newRabbitMQClient(ctx, conn, d.Get("broker_id").(string), d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if err != nil {
+		return diag.Errorf("updating MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+	}
+
+	vhost := d.Get("vhost").(string)
+
+	if d.HasChange("policy") {
+		policies, err := expandRabbitMQPolicies(d.Get("policy").(*schema.Set).List())
+
+		if err != nil {
+			return diag.Errorf("updating MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+		}
+
+		if err := syncRabbitMQPolicies(ctx, client, vhost, policies); err != nil {
+			return diag.Errorf("updating MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("max_connections", "max_queues") {
+		if err := syncRabbitMQVHostLimits(ctx, client, vhost, expandRabbitMQVHostLimits(d)); err != nil {
+			return diag.Errorf("updating MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceRabbitMQPoliciesRead(ctx, d, meta)
+}
+
+func resourceRabbitMQPoliciesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	client, err := newRabbitMQClient(ctx, conn, d.Get("broker_id").(string), d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+	}
+
+	vhost := d.Get("vhost").(string)
+
+	log.Printf("[DEBUG] Deleting MQ RabbitMQ Policies: %s", d.Id())
+	if err := syncRabbitMQPolicies(ctx, client, vhost, nil); err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("deleting MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+	}
+
+	if err := syncRabbitMQVHostLimits(ctx, client, vhost, nil); err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("deleting MQ RabbitMQ Policies (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncRabbitMQPolicies makes the virtual host's policies match the desired policies.
+func syncRabbitMQPolicies(ctx context.Context, client *rabbitMQClient, vhost string, desired []rabbitMQPolicy) error {
+	desiredByName := make(map[string]struct{}, len(desired))
+	for _, v := range desired {
+		if _, ok := desiredByName[v.Name]; ok {
+			return fmt.Errorf("policy name (%s) must be unique", v.Name)
+		}
+
+		desiredByName[v.Name] = struct{}{}
+	}
+
+	policies, err := client.listPolicies(ctx, vhost)
+
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if _, ok := desiredByName[policy.Name]; ok {
+			continue
+		}
+
+		if err := client.deletePolicy(ctx, vhost, policy.Name); err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("deleting policy (%s): %w", policy.Name, err)
+		}
+	}
+
+	for _, v := range desired {
+		if err := client.putPolicy(ctx, vhost, v); err != nil {
+			return fmt.Errorf("putting policy (%s): %w", v.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// syncRabbitMQVHostLimits makes the virtual host's limits match the desired limits.
+func syncRabbitMQVHostLimits(ctx context.Context, client *rabbitMQClient, vhost string, desired map[string]int) error {
+	for _, name := range []string{rabbitMQVHostLimitMaxConnections, rabbitMQVHostLimitMaxQueues} {
+		var err error
+
+		if v, ok := desired[name]; ok {
+			err = client.putVHostLimit(ctx, vhost, name, v)
+		} else if err = client.deleteVHostLimit(ctx, vhost, name); tfresource.NotFound(err) {
+			err = nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("setting virtual host limit (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandRabbitMQPolicies(tfList []interface{}) ([]rabbitMQPolicy, error) {
+	var policies []rabbitMQPolicy
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		policy := rabbitMQPolicy{
+			ApplyTo:  tfMap["apply_to"].(string),
+			Name:     tfMap["name"].(string),
+			Pattern:  tfMap["pattern"].(string),
+			Priority: tfMap["priority"].(int),
+		}
+
+		if err := json.Unmarshal([]byte(tfMap["definition"].(string)), &policy.Definition); err != nil {
+			return nil, fmt.Errorf("policy (%s) definition: %w", policy.Name, err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func expandRabbitMQVHostLimits(d *schema.ResourceData) map[string]int {
+	limits := make(map[string]int)
+
+	if v, ok := d.GetOk("max_connections"); ok {
+		limits[rabbitMQVHostLimitMaxConnections] = v.(int)
+	}
+
+	if v, ok := d.GetOk("max_queues"); ok {
+		limits[rabbitMQVHostLimitMaxQueues] = v.(int)
+	}
+
+	return limits
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mq"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMQRabbitMQPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_rabbitmq_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, mq.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRabbitMQPoliciesConfig_basic(rName, 1000, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "broker_id", "aws_mq_broker.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "max_connections", "100"),
+					resource.TestCheckResourceAttr(resourceName, "max_queues", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy.*", map[string]string{
+						"apply_to":   "queues",
+						"definition": `{"max-length":1000}`,
+						"name":       "max-length",
+						"pattern":    "^app\\.",
+						"priority":   "5",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "vhost", "/"),
+				),
+			},
+			{
+				Config: testAccRabbitMQPoliciesConfig_basic(rName, 2000, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy.*", map[string]string{
+						"definition": `{"max-length":2000}`,
+						"priority":   "10",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRabbitMQPoliciesConfig_basic(rName string, maxLength, priority int) string {
+	return acctest.ConfigCompose(testAccRabbitMQConfig_broker(rName), fmt.Sprintf(`
+resource "aws_mq_rabbitmq_policies" "test" {
+  broker_id       = aws_mq_broker.test.id
+  admin_username  = "Admin"
+  admin_password  = "TestTest1234"
+  max_connections = 100
+
+  policy {
+    name     = "max-length"
+    pattern  = "^app\\."
+    apply_to = "queues"
+    priority = %[2]d
+
+    definition = jsonencode({
+      "max-length" = %[1]d
+    })
+  }
+}
+`, maxLength, priority))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	tfmq "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+)
+
+func TestRabbitMQPasswordMatches(t *testing.T) {
+	t.Parallel()
+
+	salt := []byte{0x90, 0x8d, 0xc6, 0x0a}
+	sum := sha256.Sum256(append(append([]byte{}, salt...), "TestTest1234"...))
+	passwordHash := base64.StdEncoding.EncodeToString(append(append([]byte{}, salt...), sum[:]...))
+
+	testCases := []struct {
+		Name             string
+		HashingAlgorithm string
+		PasswordHash     string
+		Password         string
+		Expected         bool
+	}{
+		{
+			Name:             "match",
+			HashingAlgorithm: "rabbit_password_hashing_sha256",
+			PasswordHash:     passwordHash,
+			Password:         "TestTest1234",
+			Expected:         true,
+		},
+		{
+			Name:             "mismatch",
+			HashingAlgorithm: "rabbit_password_hashing_sha256",
+			PasswordHash:     passwordHash,
+			Password:         "TestTest5678",
+			Expected:         false,
+		},
+		{
+			Name:             "wrong algorithm",
+			HashingAlgorithm: "rabbit_password_hashing_sha512",
+			PasswordHash:     passwordHash,
+			Password:         "TestTest1234",
+			Expected:         false,
+		},
+		{
+			Name:             "unsupported algorithm",
+			HashingAlgorithm: "rabbit_password_hashing_md5",
+			PasswordHash:     passwordHash,
+			Password:         "TestTest5678",
+			Expected:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfmq.RabbitMQPasswordMatches(testCase.HashingAlgorithm, testCase.PasswordHash, testCase.Password); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
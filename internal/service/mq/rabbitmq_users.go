@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_mq_rabbitmq_users", name="RabbitMQ Users")
+func ResourceRabbitMQUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRabbitMQUsersCreate,
+		ReadWithoutTimeout:   resourceRabbitMQUsersRead,
+		UpdateWithoutTimeout: resourceRabbitMQUsersUpdate,
+		DeleteWithoutTimeout: resourceRabbitMQUsersDelete,
+
+		Schema: map[string]*schema.Schema{
+			"admin_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"admin_username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"broker_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: ValidBrokerPassword,
+						},
+						"permission": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"configure": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  ".*",
+									},
+									"read": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  ".*",
+									},
+									"vhost": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "/",
+									},
+									"write": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  ".*",
+									},
+								},
+							},
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(rabbitMQUserTag_Values(), false),
+							},
+						},
+						"username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	rabbitMQUserTagAdministrator = "administrator"
+	rabbitMQUserTagImpersonator  = "impersonator"
+	rabbitMQUserTagManagement    = "management"
+	rabbitMQUserTagMonitoring    = "monitoring"
+	rabbitMQUserTagPolicymaker   = "policymaker"
+)
+
+func rabbitMQUserTag_Values() []string {
+	return []string{
+		rabbitMQUserTagAdministrator,
+		rabbitMQUserTagImpersonator,
+		rabbitMQUserTagManagement,
+		rabbitMQUserTagMonitoring,
+		rabbitMQUserTagPolicymaker,
+	}
+}
+
+func resourceRabbitMQUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	brokerID := d.Get("broker_id").(string)
+	client, err := newRabbitMQClient(ctx, conn, brokerID, d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if err != nil {
+		return diag.Errorf("creating MQ RabbitMQ Users (%s): %s", brokerID, err)
+	}
+
+	if err := syncRabbitMQUsers(ctx, client, d.Get("admin_username").(string), expandRabbitMQUsers(d.Get("user").(*schema.Set).List())); err != nil {
+		return diag.Errorf("creating MQ RabbitMQ Users (%s): %s", brokerID, err)
+	}
+
+	d.SetId(brokerID)
+
+	return resourceRabbitMQUsersRead(ctx, d, meta)
+}
+
+func resourceRabbitMQUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	client, err := newRabbitMQClient(ctx, conn, d.Id(), d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MQ Broker (%s) not found, removing MQ RabbitMQ Users from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MQ RabbitMQ Users (%s): %s", d.Id(), err)
+	}
+
+	users, err := client.listUsers(ctx)
+
+	if err != nil {
+		return diag.Errorf("reading MQ RabbitMQ Users (%s): %s", d.Id(), err)
+	}
+
+	// Passwords cannot be read back. Keep the configured password unless it no longer matches the user's password hash.
+	passwords := make(map[string]string)
+	for _, v := range expandRabbitMQUsers(d.Get("user").(*schema.Set).List()) {
+		passwords[v.username] = v.password
+	}
+
+	var tfList []interface{}
+	for _, user := range users {
+		if user.Name == d.Get("admin_username").(string) || user.awsOwned() {
+			continue
+		}
+
+		password := passwords[user.Name]
+		if !user.passwordMatches(password) {
+			password = ""
+		}
+
+		permissions, err := client.listUserPermissions(ctx, user.Name)
+
+		if err != nil {
+			return diag.Errorf("reading MQ RabbitMQ User (%s) permissions: %s", user.Name, err)
+		}
+
+		tfList = append(tfList, rabbitMQUsersUser{
+			password:    password,
+			permissions: permissions,
+			tags:        user.tags(),
+			username:    user.Name,
+		}.flatten())
+	}
+
+	d.Set("broker_id", d.Id())
+	if err := d.Set("user", tfList); err != nil {
+		return diag.Errorf("setting user: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRabbitMQUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	if d.HasChange("user") {
+		client, err := newRabbitMQClient(ctx, conn, d.Id(), d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+		if err != nil {
+			return diag.Errorf("updating MQ RabbitMQ Users (%s): %s", d.Id(), err)
+		}
+
+		if err := syncRabbitMQUsers(ctx, client, d.Get("admin_username").(string), expandRabbitMQUsers(d.Get("user").(*schema.Set).List())); err != nil {
+			return diag.Errorf("updating MQ RabbitMQ Users (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceRabbitMQUsersRead(ctx, d, meta)
+}
+
+func resourceRabbitMQUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn(ctx)
+
+	client, err := newRabbitMQClient(ctx, conn, d.Id(), d.Get("admin_username").(string), d.Get("admin_password").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MQ RabbitMQ Users (%s): %s", d.Id(), err)
+	}
+
+	for _, v := range expandRabbitMQUsers(d.Get("user").(*schema.Set).List()) {
+		log.Printf("[DEBUG] Deleting MQ RabbitMQ User: %s", v.username)
+		err := client.deleteUser(ctx, v.username)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting MQ RabbitMQ User (%s): %s", v.username, err)
+		}
+	}
+
+	return nil
+}
+
+// syncRabbitMQUsers makes the broker's users, other than the admin user and users owned by Amazon MQ, match the desired users.
+func syncRabbitMQUsers(ctx context.Context, client *rabbitMQClient, adminUsername string, desired []rabbitMQUsersUser) error {
+	desiredByName := make(map[string]rabbitMQUsersUser, len(desired))
+	for _, v := range desired {
+		if v.username == adminUsername {
+			return fmt.Errorf("user must not contain the admin user (%s)", adminUsername)
+		}
+
+		if _, ok := desiredByName[v.username]; ok {
+			return fmt.Errorf("user username (%s) must be unique", v.username)
+		}
+
+		desiredByName[v.username] = v
+	}
+
+	users, err := client.listUsers(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if _, ok := desiredByName[user.Name]; ok || user.Name == adminUsername || user.awsOwned() {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting MQ RabbitMQ User: %s", user.Name)
+		if err := client.deleteUser(ctx, user.Name); err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("deleting user (%s): %w", user.Name, err)
+		}
+	}
+
+	for _, v := range desired {
+		if err := client.putUser(ctx, v.username, v.password, v.tags); err != nil {
+			return fmt.Errorf("putting user (%s): %w", v.username, err)
+		}
+
+		permissions, err := client.listUserPermissions(ctx, v.username)
+
+		if err != nil {
+			return fmt.Errorf("reading user (%s) permissions: %w", v.username, err)
+		}
+
+		vhosts := make(map[string]struct{}, len(v.permissions))
+		for _, permission := range v.permissions {
+			vhosts[permission.VHost] = struct{}{}
+
+			if err := client.putPermission(ctx, permission.VHost, v.username, permission); err != nil {
+				return fmt.Errorf("putting user (%s) permission (%s): %w", v.username, permission.VHost, err)
+			}
+		}
+
+		for _, permission := range permissions {
+			if _, ok := vhosts[permission.VHost]; ok {
+				continue
+			}
+
+			if err := client.deletePermission(ctx, permission.VHost, v.username); err != nil && !tfresource.NotFound(err) {
+				return fmt.Errorf("deleting user (%s) permission (%s): %w", v.username, permission.VHost, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+type rabbitMQUsersUser struct {
+	password    string
+	permissions []rabbitMQPermission
+	tags        []string
+	username    string
+}
+
+func expandRabbitMQUsers(tfList []interface{}) []rabbitMQUsersUser {
+	var users []rabbitMQUsersUser
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		user := rabbitMQUsersUser{
+			password: tfMap["password"].(string),
+			tags:     flex.ExpandStringValueSet(tfMap["tags"].(*schema.Set)),
+			username: tfMap["username"].(string),
+		}
+		sort.Strings(user.tags)
+
+		for _, v := range tfMap["permission"].(*schema.Set).List() {
+			m := v.(map[string]interface{})
+
+			user.permissions = append(user.permissions, rabbitMQPermission{
+				Configure: m["configure"].(string),
+				Read:      m["read"].(string),
+				VHost:     m["vhost"].(string),
+				Write:     m["write"].(string),
+			})
+		}
+
+		users = append(users, user)
+	}
+
+	return users
+}
+
+func (u rabbitMQUsersUser) flatten() map[string]interface{} {
+	var permissions []interface{}
+	for _, v := range u.permissions {
+		permissions = append(permissions, map[string]interface{}{
+			"configure": v.Configure,
+			"read":      v.Read,
+			"vhost":     v.VHost,
+			"write":     v.Write,
+		})
+	}
+
+	return map[string]interface{}{
+		"password":   u.password,
+		"permission": permissions,
+		"tags":       u.tags,
+		"username":   u.username,
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mq"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMQRabbitMQUsers_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_rabbitmq_users.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, mq.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRabbitMQUsersConfig_basic(rName, "TestTest1111", "monitoring"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "broker_id", "aws_mq_broker.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"username":     "app",
+						"permission.#": "1",
+						"tags.#":       "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"username":     "observer",
+						"permission.#": "0",
+						"tags.#":       "1",
+					}),
+				),
+			},
+			{
+				Config: testAccRabbitMQUsersConfig_basic(rName, "TestTest2222", "management"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.tags.*", "management"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRabbitMQConfig_broker(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  broker_name         = %[1]q
+  engine_type         = "RabbitMQ"
+  engine_version      = %[2]q
+  host_instance_type  = "mq.t3.micro"
+  publicly_accessible = true
+
+  user {
+    username = "Admin"
+    password = "TestTest1234"
+  }
+}
+`, rName, testAccRabbitVersion)
+}
+
+func testAccRabbitMQUsersConfig_basic(rName, password, tag string) string {
+	return acctest.ConfigCompose(testAccRabbitMQConfig_broker(rName), fmt.Sprintf(`
+resource "aws_mq_rabbitmq_users" "test" {
+  broker_id      = aws_mq_broker.test.id
+  admin_username = "Admin"
+  admin_password = "TestTest1234"
+
+  user {
+    username = "app"
+    password = %[1]q
+
+    permission {
+      vhost = "/"
+      read  = "^app\\."
+      write = "^app\\."
+    }
+  }
+
+  user {
+    username = "observer"
+    password = "TestTest1234"
+    tags     = [%[2]q]
+  }
+}
+`, password, tag))
+}
@@ -54,6 +54,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceRabbitMQPolicies,
+			TypeName: "aws_mq_rabbitmq_policies",
+			Name:     "RabbitMQ Policies",
+		},
+		{
+			Factory:  ResourceRabbitMQUsers,
+			TypeName: "aws_mq_rabbitmq_users",
+			Name:     "RabbitMQ Users",
+		},
 	}
 }
 
@@ -0,0 +1,441 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package omics
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_omics_annotation_store", name="Annotation Store")
+// @Tags(identifierAttribute="arn")
+func ResourceAnnotationStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnnotationStoreCreate,
+		ReadWithoutTimeout:   resourceAnnotationStoreRead,
+		UpdateWithoutTimeout: resourceAnnotationStoreUpdate,
+		DeleteWithoutTimeout: resourceAnnotationStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validStoreName,
+			},
+			"reference":  referenceSchema(false),
+			"sse_config": sseConfigSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"store_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(omics.StoreFormat_Values(), false),
+			},
+			"store_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tsv_store_options": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"annotation_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(omics.AnnotationType_Values(), false),
+									},
+									"format_to_header": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"schema": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeMap,
+											Elem: &schema.Schema{
+												Type:         schema.TypeString,
+												ValidateFunc: validation.StringInSlice(omics.SchemaValueType_Values(), false),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"store_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 255),
+					validStoreName,
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAnnotationStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &omics.CreateAnnotationStoreInput{
+		Name:        aws.String(name),
+		Reference:   expandReferenceItem(d.Get("reference").([]interface{})),
+		StoreFormat: aws.String(d.Get("store_format").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfig = expandSseConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("store_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StoreOptions = expandStoreOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_name"); ok {
+		input.VersionName = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAnnotationStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Omics Annotation Store (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Name))
+
+	if _, err := waitAnnotationStoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Omics Annotation Store (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAnnotationStoreRead(ctx, d, meta)...)
+}
+
+func resourceAnnotationStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	store, err := FindAnnotationStoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Annotation Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Omics Annotation Store (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", store.StoreArn)
+	d.Set("creation_time", aws.TimeValue(store.CreationTime).Format(time.RFC3339))
+	d.Set("description", store.Description)
+	d.Set("name", store.Name)
+	if err := d.Set("reference", flattenReferenceItem(store.Reference)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reference: %s", err)
+	}
+	if err := d.Set("sse_config", flattenSseConfig(store.SseConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sse_config: %s", err)
+	}
+	d.Set("status", store.Status)
+	d.Set("status_message", store.StatusMessage)
+	d.Set("store_format", store.StoreFormat)
+	if err := d.Set("store_options", flattenStoreOptions(store.StoreOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting store_options: %s", err)
+	}
+	d.Set("store_size_bytes", store.StoreSizeBytes)
+
+	return diags
+}
+
+func resourceAnnotationStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	if d.HasChange("description") {
+		input := &omics.UpdateAnnotationStoreInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateAnnotationStoreWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Omics Annotation Store (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAnnotationStoreUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Omics Annotation Store (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnnotationStoreRead(ctx, d, meta)...)
+}
+
+func resourceAnnotationStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Omics Annotation Store: %s", d.Id())
+	_, err := conn.DeleteAnnotationStoreWithContext(ctx, &omics.DeleteAnnotationStoreInput{
+		Force: aws.Bool(true),
+		Name:  aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Omics Annotation Store (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAnnotationStoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Omics Annotation Store (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAnnotationStoreByName(ctx context.Context, conn *omics.Omics, name string) (*omics.GetAnnotationStoreOutput, error) {
+	input := &omics.GetAnnotationStoreInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetAnnotationStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAnnotationStore(ctx context.Context, conn *omics.Omics, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAnnotationStoreByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAnnotationStoreCreated(ctx context.Context, conn *omics.Omics, name string, timeout time.Duration) (*omics.GetAnnotationStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{omics.StoreStatusCreating},
+		Target:  []string{omics.StoreStatusActive},
+		Refresh: statusAnnotationStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*omics.GetAnnotationStoreOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAnnotationStoreUpdated(ctx context.Context, conn *omics.Omics, name string, timeout time.Duration) (*omics.GetAnnotationStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{omics.StoreStatusUpdating},
+		Target:  []string{omics.StoreStatusActive},
+		Refresh: statusAnnotationStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*omics.GetAnnotationStoreOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAnnotationStoreDeleted(ctx context.Context, conn *omics.Omics, name string, timeout time.Duration) (*omics.GetAnnotationStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{omics.StoreStatusDeleting},
+		Target:  []string{},
+		Refresh: statusAnnotationStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*omics.GetAnnotationStoreOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandStoreOptions(tfMap map[string]interface{}) *omics.StoreOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &omics.StoreOptions{}
+
+	if v, ok := tfMap["tsv_store_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TsvStoreOptions = expandTsvStoreOptions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTsvStoreOptions(tfMap map[string]interface{}) *omics.TsvStoreOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &omics.TsvStoreOptions{}
+
+	if v, ok := tfMap["annotation_type"].(string); ok && v != "" {
+		apiObject.AnnotationType = aws.String(v)
+	}
+
+	if v, ok := tfMap["format_to_header"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.FormatToHeader = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["schema"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Schema = append(apiObject.Schema, flex.ExpandStringMap(tfMap))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenStoreOptions(apiObject *omics.StoreOptions) []interface{} {
+	if apiObject == nil || apiObject.TsvStoreOptions == nil {
+		return nil
+	}
+
+	tsvStoreOptions := apiObject.TsvStoreOptions
+	tfMap := map[string]interface{}{
+		"annotation_type":  aws.StringValue(tsvStoreOptions.AnnotationType),
+		"format_to_header": aws.StringValueMap(tsvStoreOptions.FormatToHeader),
+	}
+
+	var tfList []interface{}
+	for _, v := range tsvStoreOptions.Schema {
+		tfList = append(tfList, aws.StringValueMap(v))
+	}
+	tfMap["schema"] = tfList
+
+	return []interface{}{map[string]interface{}{
+		"tsv_store_options": []interface{}{tfMap},
+	}}
+}
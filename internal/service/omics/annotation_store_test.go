@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package omics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/omics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfomics "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOmicsAnnotationStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v omics.GetAnnotationStoreOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(10)
+	resourceName := "aws_omics_annotation_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, omics.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnnotationStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnnotationStoreConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnnotationStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reference.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "store_format", "TSV"),
+					resource.TestCheckResourceAttr(resourceName, "store_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "store_options.0.tsv_store_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "store_options.0.tsv_store_options.0.annotation_type", "GENERIC"),
+					resource.TestCheckResourceAttr(resourceName, "store_options.0.tsv_store_options.0.schema.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "store_options.0.tsv_store_options.0.schema.0.id", "STRING"),
+					resource.TestCheckResourceAttr(resourceName, "store_options.0.tsv_store_options.0.schema.1.score", "DOUBLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_name"},
+			},
+			{
+				Config: testAccAnnotationStoreConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnnotationStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOmicsAnnotationStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v omics.GetAnnotationStoreOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(10)
+	resourceName := "aws_omics_annotation_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, omics.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnnotationStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnnotationStoreConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnnotationStoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfomics.ResourceAnnotationStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsAnnotationStore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v omics.GetAnnotationStoreOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(10)
+	resourceName := "aws_omics_annotation_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, omics.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnnotationStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnnotationStoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnnotationStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_name"},
+			},
+			{
+				Config: testAccAnnotationStoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnnotationStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAnnotationStoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnnotationStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnnotationStoreExists(ctx context.Context, n string, v *omics.GetAnnotationStoreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn(ctx)
+
+		output, err := tfomics.FindAnnotationStoreByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAnnotationStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_omics_annotation_store" {
+				continue
+			}
+
+			_, err := tfomics.FindAnnotationStoreByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Omics Annotation Store %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAnnotationStoreConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_annotation_store" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  store_format = "TSV"
+
+  store_options {
+    tsv_store_options {
+      annotation_type = "GENERIC"
+
+      schema = [
+        { id = "STRING" },
+        { score = "DOUBLE" },
+      ]
+    }
+  }
+}
+`, rName, description)
+}
+
+func testAccAnnotationStoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_annotation_store" "test" {
+  name         = %[1]q
+  store_format = "TSV"
+
+  store_options {
+    tsv_store_options {
+      annotation_type = "GENERIC"
+
+      schema = [
+        { id = "STRING" },
+      ]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAnnotationStoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_annotation_store" "test" {
+  name         = %[1]q
+  store_format = "TSV"
+
+  store_options {
+    tsv_store_options {
+      annotation_type = "GENERIC"
+
+      schema = [
+        { id = "STRING" },
+      ]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package omics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_omics_run_group", name="Run Group")
+// @Tags(identifierAttribute="arn")
+func ResourceRunGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRunGroupCreate,
+		ReadWithoutTimeout:   resourceRunGroupRead,
+		UpdateWithoutTimeout: resourceRunGroupUpdate,
+		DeleteWithoutTimeout: resourceRunGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_cpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"max_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"max_gpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"max_runs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceRunGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	input := &omics.CreateRunGroupInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("max_cpus"); ok {
+		input.MaxCpus = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_duration"); ok {
+		input.MaxDuration = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_gpus"); ok {
+		input.MaxGpus = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_runs"); ok {
+		input.MaxRuns = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRunGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Omics Run Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceRunGroupRead(ctx, d, meta)...)
+}
+
+func resourceRunGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	group, err := FindRunGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Run Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Omics Run Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", group.Arn)
+	d.Set("max_cpus", group.MaxCpus)
+	d.Set("max_duration", group.MaxDuration)
+	d.Set("max_gpus", group.MaxGpus)
+	d.Set("max_runs", group.MaxRuns)
+	d.Set("name", group.Name)
+
+	return diags
+}
+
+func resourceRunGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &omics.UpdateRunGroupInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("max_cpus"); ok {
+			input.MaxCpus = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("max_duration"); ok {
+			input.MaxDuration = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("max_gpus"); ok {
+			input.MaxGpus = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("max_runs"); ok {
+			input.MaxRuns = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("name"); ok {
+			input.Name = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateRunGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Omics Run Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRunGroupRead(ctx, d, meta)...)
+}
+
+func resourceRunGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Omics Run Group: %s", d.Id())
+	_, err := conn.DeleteRunGroupWithContext(ctx, &omics.DeleteRunGroupInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Omics Run Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRunGroupByID(ctx context.Context, conn *omics.Omics, id string) (*omics.GetRunGroupOutput, error) {
+	input := &omics.GetRunGroupInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetRunGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package omics
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_omics_sequence_store", name="Sequence Store")
+// @Tags(identifierAttribute="arn")
+func ResourceSequenceStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSequenceStoreCreate,
+		ReadWithoutTimeout:   resourceSequenceStoreRead,
+		UpdateWithoutTimeout: resourceSequenceStoreUpdate,
+		DeleteWithoutTimeout: resourceSequenceStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"e_tag_algorithm_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(omics.ETagAlgorithmFamily_Values(), false),
+			},
+			"fallback_location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"s3_access_point_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sse_config":      sseConfigSchema(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func sseConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(omics.EncryptionType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceSequenceStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &omics.CreateSequenceStoreInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("e_tag_algorithm_family"); ok {
+		input.ETagAlgorithmFamily = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("fallback_location"); ok {
+		input.FallbackLocation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfig = expandSseConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateSequenceStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Omics Sequence Store (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceSequenceStoreRead(ctx, d, meta)...)
+}
+
+func resourceSequenceStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	store, err := FindSequenceStoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Sequence Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Omics Sequence Store (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", store.Arn)
+	d.Set("creation_time", aws.TimeValue(store.CreationTime).Format(time.RFC3339))
+	d.Set("description", store.Description)
+	d.Set("e_tag_algorithm_family", store.ETagAlgorithmFamily)
+	d.Set("fallback_location", store.FallbackLocation)
+	d.Set("name", store.Name)
+	if store.S3Access != nil {
+		d.Set("s3_access_point_arn", store.S3Access.S3AccessPointArn)
+		d.Set("s3_uri", store.S3Access.S3Uri)
+	} else {
+		d.Set("s3_access_point_arn", nil)
+		d.Set("s3_uri", nil)
+	}
+	if err := d.Set("sse_config", flattenSseConfig(store.SseConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sse_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSequenceStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceSequenceStoreRead(ctx, d, meta)
+}
+
+func resourceSequenceStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Omics Sequence Store: %s", d.Id())
+	_, err := conn.DeleteSequenceStoreWithContext(ctx, &omics.DeleteSequenceStoreInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Omics Sequence Store (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSequenceStoreByID(ctx context.Context, conn *omics.Omics, id string) (*omics.GetSequenceStoreOutput, error) {
+	input := &omics.GetSequenceStoreInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSequenceStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSseConfig(tfMap map[string]interface{}) *omics.SseConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &omics.SseConfig{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["key_arn"].(string); ok && v != "" {
+		apiObject.KeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSseConfig(apiObject *omics.SseConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_arn": aws.StringValue(apiObject.KeyArn),
+		"type":    aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
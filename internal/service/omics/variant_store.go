@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package omics
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_omics_variant_store", name="Variant Store")
+// @Tags(identifierAttribute="arn")
+func ResourceVariantStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVariantStoreCreate,
+		ReadWithoutTimeout:   resourceVariantStoreRead,
+		UpdateWithoutTimeout: resourceVariantStoreUpdate,
+		DeleteWithoutTimeout: resourceVariantStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validStoreName,
+			},
+			"reference":  referenceSchema(true),
+			"sse_config": sseConfigSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"store_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+var validStoreName = validation.StringMatch(regexache.MustCompile(`^[a-z][0-9a-z_]{2,254}$`), "must start with a lowercase letter and contain only lowercase letters, numbers and underscores")
+
+func referenceSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"reference_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceVariantStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &omics.CreateVariantStoreInput{
+		Name:      aws.String(name),
+		Reference: expandReferenceItem(d.Get("reference").([]interface{})),
+		Tags:      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfig = expandSseConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateVariantStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Omics Variant Store (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Name))
+
+	if _, err := waitVariantStoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Omics Variant Store (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVariantStoreRead(ctx, d, meta)...)
+}
+
+func resourceVariantStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	store, err := FindVariantStoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Variant Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Omics Variant Store (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", store.StoreArn)
+	d.Set("creation_time", aws.TimeValue(store.CreationTime).Format(time.RFC3339))
+	d.Set("description", store.Description)
+	d.Set("name", store.Name)
+	if err := d.Set("reference", flattenReferenceItem(store.Reference)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reference: %s", err)
+	}
+	if err := d.Set("sse_config", flattenSseConfig(store.SseConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sse_config: %s", err)
+	}
+	d.Set("status", store.Status)
+	d.Set("status_message", store.StatusMessage)
+	d.Set("store_size_bytes", store.StoreSizeBytes)
+
+	return diags
+}
+
+func resourceVariantStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	if d.HasChange("description") {
+		input := &omics.UpdateVariantStoreInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateVariantStoreWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Omics Variant Store (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitVariantStoreUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Omics Variant Store (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVariantStoreRead(ctx, d, meta)...)
+}
+
+func resourceVariantStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OmicsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Omics Variant Store: %s", d.Id())
+	_, err := conn.DeleteVariantStoreWithContext(ctx, &omics.DeleteVariantStoreInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Omics Variant Store (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVariantStoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Omics Variant Store (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindVariantStoreByName(ctx context.Context, conn *omics.Omics, name string) (*omics.GetVariantStoreOutput, error) {
+	input := &omics.GetVariantStoreInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetVariantStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusVariantStore(ctx context.Context, conn *omics.Omics, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVariantStoreByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitVariantStoreCreated(ctx context.Context, conn *omics.Omics, name string, timeout time.Duration) (*omics.GetVariantStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{omics.StoreStatusCreating},
+		Target:  []string{omics.StoreStatusActive},
+		Refresh: statusVariantStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*omics.GetVariantStoreOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVariantStoreUpdated(ctx context.Context, conn *omics.Omics, name string, timeout time.Duration) (*omics.GetVariantStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{omics.StoreStatusUpdating},
+		Target:  []string{omics.StoreStatusActive},
+		Refresh: statusVariantStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*omics.GetVariantStoreOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVariantStoreDeleted(ctx context.Context, conn *omics.Omics, name string, timeout time.Duration) (*omics.GetVariantStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{omics.StoreStatusDeleting},
+		Target:  []string{},
+		Refresh: statusVariantStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*omics.GetVariantStoreOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandReferenceItem(tfList []interface{}) *omics.ReferenceItem {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &omics.ReferenceItem{
+		ReferenceArn: aws.String(tfMap["reference_arn"].(string)),
+	}
+}
+
+func flattenReferenceItem(apiObject *omics.ReferenceItem) []interface{} {
+	if apiObject == nil || apiObject.ReferenceArn == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"reference_arn": aws.StringValue(apiObject.ReferenceArn),
+	}
+
+	return []interface{}{tfMap}
+}
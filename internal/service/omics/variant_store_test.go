@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package omics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/omics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfomics "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarReferenceARN             = "AWS_OMICS_REFERENCE_ARN"
+	envVarReferenceARNMessageError = "Environment variable AWS_OMICS_REFERENCE_ARN is not set. " +
+		"To properly test variant stores the ARN of an active reference genome in a reference store must be provided."
+)
+
+func TestAccOmicsVariantStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	referenceARN := envvar.SkipIfEmpty(t, envVarReferenceARN, envVarReferenceARNMessageError)
+	var v omics.GetVariantStoreOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(10)
+	resourceName := "aws_omics_variant_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, omics.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariantStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariantStoreConfig_basic(rName, referenceARN, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariantStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reference.0.reference_arn", referenceARN),
+					resource.TestCheckResourceAttr(resourceName, "sse_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVariantStoreConfig_basic(rName, referenceARN, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariantStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOmicsVariantStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	referenceARN := envvar.SkipIfEmpty(t, envVarReferenceARN, envVarReferenceARNMessageError)
+	var v omics.GetVariantStoreOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(10)
+	resourceName := "aws_omics_variant_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, omics.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariantStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariantStoreConfig_basic(rName, referenceARN, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariantStoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfomics.ResourceVariantStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsVariantStore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	referenceARN := envvar.SkipIfEmpty(t, envVarReferenceARN, envVarReferenceARNMessageError)
+	var v omics.GetVariantStoreOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(10)
+	resourceName := "aws_omics_variant_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, omics.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariantStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariantStoreConfig_tags1(rName, referenceARN, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariantStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVariantStoreConfig_tags2(rName, referenceARN, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariantStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVariantStoreConfig_tags1(rName, referenceARN, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariantStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVariantStoreExists(ctx context.Context, n string, v *omics.GetVariantStoreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn(ctx)
+
+		output, err := tfomics.FindVariantStoreByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVariantStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_omics_variant_store" {
+				continue
+			}
+
+			_, err := tfomics.FindVariantStoreByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Omics Variant Store %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVariantStoreConfig_basic(rName, referenceARN, description string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_variant_store" "test" {
+  name        = %[1]q
+  description = %[3]q
+
+  reference {
+    reference_arn = %[2]q
+  }
+}
+`, rName, referenceARN, description)
+}
+
+func testAccVariantStoreConfig_tags1(rName, referenceARN, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_variant_store" "test" {
+  name = %[1]q
+
+  reference {
+    reference_arn = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, referenceARN, tagKey1, tagValue1)
+}
+
+func testAccVariantStoreConfig_tags2(rName, referenceARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_variant_store" "test" {
+  name = %[1]q
+
+  reference {
+    reference_arn = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, referenceARN, tagKey1, tagValue1, tagKey2, tagValue2)
+}
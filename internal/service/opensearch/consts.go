@@ -12,3 +12,15 @@ const (
 	// especially with acceptance tests
 	propagationTimeout = 10 * time.Minute
 )
+
+const (
+	serviceSoftwareUpdateActionCancel = "CANCEL"
+	serviceSoftwareUpdateActionStart  = "START"
+)
+
+func serviceSoftwareUpdateAction_Values() []string {
+	return []string{
+		serviceSoftwareUpdateActionCancel,
+		serviceSoftwareUpdateActionStart,
+	}
+}
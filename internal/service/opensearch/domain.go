@@ -137,6 +137,34 @@ func ResourceDomain() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"jwt_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"public_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"roles_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"subject_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"master_user_options": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -163,6 +191,36 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"aiml_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"natural_language_query_generation_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"current_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"desired_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(opensearchservice.NaturalLanguageQueryGenerationDesiredState_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -545,6 +603,70 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"service_software_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automated_update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cancellable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"current_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"new_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"optional_deployment": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"update_available": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"update_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_software_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(serviceSoftwareUpdateAction_Values(), false),
+						},
+						"desired_start_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"schedule_at": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      opensearchservice.ScheduleAtNow,
+							ValidateFunc: validation.StringInSlice(opensearchservice.ScheduleAt_Values(), false),
+						},
+					},
+				},
+			},
 			"snapshot_options": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -658,6 +780,10 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("aiml_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AIMLOptions = expandAIMLOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -908,6 +1034,14 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if ds.AIMLOptions != nil {
+		if err := d.Set("aiml_options", []interface{}{flattenAIMLOptions(ds.AIMLOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting aiml_options: %s", err)
+		}
+	} else {
+		d.Set("aiml_options", nil)
+	}
+
 	if v := dc.AutoTuneOptions; v != nil {
 		err = d.Set("auto_tune_options", []interface{}{flattenAutoTuneOptions(v.Options)})
 		if err != nil {
@@ -915,6 +1049,14 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if ds.ServiceSoftwareOptions != nil {
+		if err := d.Set("service_software_options", []interface{}{flattenServiceSoftwareOptions(ds.ServiceSoftwareOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting service_software_options: %s", err)
+		}
+	} else {
+		d.Set("service_software_options", nil)
+	}
+
 	if err := d.Set("snapshot_options", flattenSnapshotOptions(ds.SnapshotOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snapshot_options: %s", err)
 	}
@@ -969,7 +1111,7 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("service_software_update", "tags", "tags_all") {
 		input := opensearchservice.UpdateDomainConfigInput{
 			DomainName: aws.String(d.Get("domain_name").(string)),
 		}
@@ -990,6 +1132,12 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]interface{}))
 		}
 
+		if d.HasChange("aiml_options") {
+			if v, ok := d.GetOk("aiml_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.AIMLOptions = expandAIMLOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("auto_tune_options") {
 			input.AutoTuneOptions = expandAutoTuneOptions(d.Get("auto_tune_options").([]interface{})[0].(map[string]interface{}))
 		}
@@ -1117,6 +1265,14 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange("service_software_update") {
+		if v, ok := d.GetOk("service_software_update"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateServiceSoftware(ctx, conn, d.Get("domain_name").(string), v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): service software: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
@@ -1144,6 +1300,65 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// updateServiceSoftware starts or cancels the domain's pending service software update.
+// Nothing is done if there's no update to act on.
+func updateServiceSoftware(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, tfMap map[string]interface{}, timeout time.Duration) error {
+	ds, err := FindDomainByName(ctx, conn, domainName)
+
+	if err != nil {
+		return err
+	}
+
+	options := ds.ServiceSoftwareOptions
+
+	switch action := tfMap["action"].(string); action {
+	case serviceSoftwareUpdateActionCancel:
+		if options == nil || !aws.BoolValue(options.Cancellable) {
+			log.Printf("[WARN] OpenSearch Domain (%s) has no cancellable service software update", domainName)
+			return nil
+		}
+
+		_, err := conn.CancelServiceSoftwareUpdateWithContext(ctx, &opensearchservice.CancelServiceSoftwareUpdateInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("cancelling: %w", err)
+		}
+	case serviceSoftwareUpdateActionStart:
+		if options == nil || !aws.BoolValue(options.UpdateAvailable) {
+			log.Printf("[WARN] OpenSearch Domain (%s) has no available service software update", domainName)
+			return nil
+		}
+
+		scheduleAt := tfMap["schedule_at"].(string)
+		input := &opensearchservice.StartServiceSoftwareUpdateInput{
+			DomainName: aws.String(domainName),
+			ScheduleAt: aws.String(scheduleAt),
+		}
+
+		if v, ok := tfMap["desired_start_time"].(int); ok && v != 0 {
+			input.DesiredStartTime = aws.Int64(int64(v))
+		}
+
+		_, err := conn.StartServiceSoftwareUpdateWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("starting: %w", err)
+		}
+
+		if scheduleAt == opensearchservice.ScheduleAtNow {
+			if err := waitForDomainUpdate(ctx, conn, domainName, timeout); err != nil {
+				return fmt.Errorf("starting: waiting for completion: %w", err)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported action: %s", action)
+	}
+
+	return nil
+}
+
 // inPlaceEncryptionEnableVersion returns true if, based on version, encryption
 // can be enabled in place (without ForceNew)
 func inPlaceEncryptionEnableVersion(version string) bool {
@@ -27,6 +27,10 @@ func expandAdvancedSecurityOptions(m []interface{}) *opensearchservice.AdvancedS
 				config.InternalUserDatabaseEnabled = aws.Bool(v)
 			}
 
+			if v, ok := group["jwt_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				config.JWTOptions = expandJWTOptions(v[0].(map[string]interface{}))
+			}
+
 			if v, ok := group["master_user_options"].([]interface{}); ok {
 				if len(v) > 0 && v[0] != nil {
 					muo := opensearchservice.MasterUserOptions{}
@@ -53,6 +57,34 @@ func expandAdvancedSecurityOptions(m []interface{}) *opensearchservice.AdvancedS
 	return &config
 }
 
+func expandAIMLOptions(tfMap map[string]interface{}) *opensearchservice.AIMLOptionsInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.AIMLOptionsInput_{}
+
+	if v, ok := tfMap["natural_language_query_generation_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NaturalLanguageQueryGenerationOptions = expandNaturalLanguageQueryGenerationOptions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandNaturalLanguageQueryGenerationOptions(tfMap map[string]interface{}) *opensearchservice.NaturalLanguageQueryGenerationOptionsInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.NaturalLanguageQueryGenerationOptionsInput_{}
+
+	if v, ok := tfMap["desired_state"].(string); ok && v != "" {
+		apiObject.DesiredState = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandAutoTuneOptions(tfMap map[string]interface{}) *opensearchservice.AutoTuneOptions {
 	if tfMap == nil {
 		return nil
@@ -118,6 +150,32 @@ func expandAutoTuneMaintenanceScheduleDuration(tfMap map[string]interface{}) *op
 	return autoTuneMaintenanceScheduleDuration
 }
 
+func expandJWTOptions(tfMap map[string]interface{}) *opensearchservice.JWTOptionsInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.JWTOptionsInput_{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["public_key"].(string); ok && v != "" {
+		apiObject.PublicKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["roles_key"].(string); ok && v != "" {
+		apiObject.RolesKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["subject_key"].(string); ok && v != "" {
+		apiObject.SubjectKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandESSAMLOptions(data []interface{}) *opensearchservice.SAMLOptionsInput_ {
 	if len(data) == 0 {
 		return nil
@@ -239,9 +297,30 @@ func flattenAdvancedSecurityOptions(advancedSecurityOptions *opensearchservice.A
 		m["internal_user_database_enabled"] = aws.BoolValue(advancedSecurityOptions.InternalUserDatabaseEnabled)
 	}
 
+	if v := advancedSecurityOptions.JWTOptions; aws.BoolValue(advancedSecurityOptions.Enabled) && v != nil && aws.BoolValue(v.Enabled) {
+		m["jwt_options"] = []interface{}{flattenJWTOptions(v)}
+	}
+
 	return []map[string]interface{}{m}
 }
 
+func flattenAIMLOptions(apiObject *opensearchservice.AIMLOptionsOutput_) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NaturalLanguageQueryGenerationOptions; v != nil {
+		tfMap["natural_language_query_generation_options"] = []interface{}{map[string]interface{}{
+			"current_state": aws.StringValue(v.CurrentState),
+			"desired_state": aws.StringValue(v.DesiredState),
+		}}
+	}
+
+	return tfMap
+}
+
 func flattenAutoTuneOptions(autoTuneOptions *opensearchservice.AutoTuneOptions) map[string]interface{} {
 	if autoTuneOptions == nil {
 		return nil
@@ -328,6 +407,21 @@ func flattenESSAMLIdpOptions(SAMLIdp *opensearchservice.SAMLIdp) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenJWTOptions(apiObject *opensearchservice.JWTOptionsOutput_) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":     aws.BoolValue(apiObject.Enabled),
+		"public_key":  aws.StringValue(apiObject.PublicKey),
+		"roles_key":   aws.StringValue(apiObject.RolesKey),
+		"subject_key": aws.StringValue(apiObject.SubjectKey),
+	}
+
+	return tfMap
+}
+
 func getMasterUserOptions(d *schema.ResourceData) []interface{} {
 	if v, ok := d.GetOk("advanced_security_options"); ok {
 		options := v.([]interface{})
@@ -36,3 +36,27 @@ func FindDomainByName(ctx context.Context, conn *opensearchservice.OpenSearchSer
 
 	return output.DomainStatus, nil
 }
+
+func findDomainChangeProgressByName(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) (*opensearchservice.ChangeProgressStatusDetails, error) {
+	input := &opensearchservice.DescribeDomainChangeProgressInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomainChangeProgressWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChangeProgressStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChangeProgressStatus, nil
+}
@@ -4,6 +4,8 @@
 package opensearch
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -230,6 +232,28 @@ func flattenSoftwareUpdateOptions(softwareUpdateOptions *opensearchservice.Softw
 	return []interface{}{m}
 }
 
+func flattenServiceSoftwareOptions(apiObject *opensearchservice.ServiceSoftwareOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cancellable":         aws.BoolValue(apiObject.Cancellable),
+		"current_version":     aws.StringValue(apiObject.CurrentVersion),
+		"description":         aws.StringValue(apiObject.Description),
+		"new_version":         aws.StringValue(apiObject.NewVersion),
+		"optional_deployment": aws.BoolValue(apiObject.OptionalDeployment),
+		"update_available":    aws.BoolValue(apiObject.UpdateAvailable),
+		"update_status":       aws.StringValue(apiObject.UpdateStatus),
+	}
+
+	if v := apiObject.AutomatedUpdateDate; v != nil {
+		tfMap["automated_update_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
 func expandVPCOptions(tfMap map[string]interface{}) *opensearchservice.VPCOptions {
 	if tfMap == nil {
 		return nil
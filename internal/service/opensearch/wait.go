@@ -5,7 +5,9 @@ package opensearch
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -98,11 +100,54 @@ func waitForDomainUpdate(ctx context.Context, conn *opensearchservice.OpenSearch
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("waiting for OpenSearch Domain changes to be processed: %w", err)
+		return fmt.Errorf("waiting for OpenSearch Domain changes to be processed: %w", errors.Join(err, domainChangeProgressError(ctx, conn, domainName, out)))
 	}
 	return nil
 }
 
+// domainChangeProgressError describes the state of a domain's in-flight configuration change
+// (e.g. a blue/green deployment), so that waiter failures show where the change got stuck.
+func domainChangeProgressError(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, domain *opensearchservice.DomainStatus) error {
+	var details []string
+
+	if domain != nil {
+		if v := aws.StringValue(domain.DomainProcessingStatus); v != "" {
+			details = append(details, fmt.Sprintf("processing status: %s", v))
+		}
+
+		var properties []string
+		for _, v := range domain.ModifyingProperties {
+			if v != nil {
+				properties = append(properties, aws.StringValue(v.Name))
+			}
+		}
+		if len(properties) > 0 {
+			details = append(details, fmt.Sprintf("modifying properties: %s", strings.Join(properties, ", ")))
+		}
+	}
+
+	if progress, err := findDomainChangeProgressByName(ctx, conn, domainName); err == nil {
+		for _, v := range progress.ChangeProgressStages {
+			// Stage statuses aren't modeled as an enum.
+			if v == nil || aws.StringValue(v.Status) == "COMPLETED" {
+				continue
+			}
+
+			details = append(details, fmt.Sprintf("stage %s: %s (%s)", aws.StringValue(v.Name), aws.StringValue(v.Status), aws.StringValue(v.Description)))
+		}
+
+		if v := progress.PendingProperties; len(v) > 0 {
+			details = append(details, fmt.Sprintf("pending properties: %s", strings.Join(aws.StringValueSlice(v), ", ")))
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(details, "; "))
+}
+
 func waitForDomainDelete(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, timeout time.Duration) error {
 	var out *opensearchservice.DomainStatus
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
@@ -79,6 +79,7 @@ func (r *resourceAccessPolicy) Schema(ctx context.Context, req resource.SchemaRe
 				Required: true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 20480),
+					policyDocumentIsJSON(),
 				},
 			},
 			"policy_version": schema.StringAttribute{
@@ -5,15 +5,19 @@ package opensearchserverless
 
 // Exports for use in tests only.
 var (
-	ResourceAccessPolicy   = newResourceAccessPolicy
-	ResourceCollection     = newResourceCollection
-	ResourceSecurityConfig = newResourceSecurityConfig
-	ResourceSecurityPolicy = newResourceSecurityPolicy
-	ResourceVPCEndpoint    = newResourceVPCEndpoint
+	ResourceAccessPolicy    = newResourceAccessPolicy
+	ResourceCollection      = newResourceCollection
+	ResourceLifecyclePolicy = newResourceLifecyclePolicy
+	ResourceSecurityConfig  = newResourceSecurityConfig
+	ResourceSecurityPolicy  = newResourceSecurityPolicy
+	ResourceVPCEndpoint     = newResourceVPCEndpoint
 
-	FindAccessPolicyByNameAndType   = findAccessPolicyByNameAndType
-	FindCollectionByID              = findCollectionByID
-	FindSecurityConfigByID          = findSecurityConfigByID
-	FindSecurityPolicyByNameAndType = findSecurityPolicyByNameAndType
-	FindVPCEndpointByID             = findVPCEndpointByID
+	FindAccessPolicyByNameAndType    = findAccessPolicyByNameAndType
+	FindCollectionByID               = findCollectionByID
+	FindLifecyclePolicyByNameAndType = findLifecyclePolicyByNameAndType
+	FindSecurityConfigByID           = findSecurityConfigByID
+	FindSecurityPolicyByNameAndType  = findSecurityPolicyByNameAndType
+	FindVPCEndpointByID              = findVPCEndpointByID
+
+	ValidateLifecyclePolicyDocument = validateLifecyclePolicyDocument
 )
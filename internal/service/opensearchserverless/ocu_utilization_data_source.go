@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="OCU Utilization")
+func newDataSourceOCUUtilization(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceOCUUtilization{}, nil
+}
+
+const (
+	DSNameOCUUtilization = "OCU Utilization Data Source"
+
+	defaultOCUUtilizationPeriodInMinutes = 60
+	ocuMetricNamespace                   = "AWS/AOSS"
+	ocuMetricNameIndexing                = "IndexingOCU"
+	ocuMetricNameSearch                  = "SearchOCU"
+)
+
+type dataSourceOCUUtilization struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceOCUUtilization) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_opensearchserverless_ocu_utilization"
+}
+
+func (d *dataSourceOCUUtilization) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"indexing_ocu": schema.Float64Attribute{
+				Computed: true,
+			},
+			"max_indexing_capacity_in_ocu": schema.Int64Attribute{
+				Computed: true,
+			},
+			"max_search_capacity_in_ocu": schema.Int64Attribute{
+				Computed: true,
+			},
+			"period_in_minutes": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(5, 1440),
+				},
+			},
+			"search_ocu": schema.Float64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceOCUUtilization) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataSourceOCUUtilizationData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := d.Meta().AccountID
+	client := d.Meta().OpenSearchServerlessClient(ctx)
+
+	out, err := client.GetAccountSettings(ctx, &opensearchserverless.GetAccountSettingsInput{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameOCUUtilization, accountID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(accountID)
+	data.MaxIndexingCapacityInOCU = types.Int64Null()
+	data.MaxSearchCapacityInOCU = types.Int64Null()
+	if out.AccountSettingsDetail != nil && out.AccountSettingsDetail.CapacityLimits != nil {
+		if v := out.AccountSettingsDetail.CapacityLimits.MaxIndexingCapacityInOCU; v != nil {
+			data.MaxIndexingCapacityInOCU = types.Int64Value(int64(aws_sdkv2.ToInt32(v)))
+		}
+		if v := out.AccountSettingsDetail.CapacityLimits.MaxSearchCapacityInOCU; v != nil {
+			data.MaxSearchCapacityInOCU = types.Int64Value(int64(aws_sdkv2.ToInt32(v)))
+		}
+	}
+
+	if data.PeriodInMinutes.IsNull() || data.PeriodInMinutes.IsUnknown() {
+		data.PeriodInMinutes = types.Int64Value(defaultOCUUtilizationPeriodInMinutes)
+	}
+
+	// OCUs are shared by all collections in the account and are reported against the account's client ID.
+	conn := d.Meta().CloudWatchConn(ctx)
+	period := time.Duration(data.PeriodInMinutes.ValueInt64()) * time.Minute
+
+	for metricName, v := range map[string]*types.Float64{
+		ocuMetricNameIndexing: &data.IndexingOCU,
+		ocuMetricNameSearch:   &data.SearchOCU,
+	} {
+		value, err := findMaximumOCUMetric(ctx, conn, accountID, metricName, period)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameOCUUtilization, accountID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		*v = types.Float64Value(value)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findMaximumOCUMetric returns the maximum value of the specified OCU metric over the period ending now.
+// Zero is returned if no datapoints have been published, e.g. when the account has no collections.
+func findMaximumOCUMetric(ctx context.Context, conn *cloudwatch.CloudWatch, accountID, metricName string, period time.Duration) (float64, error) {
+	endTime := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: []*cloudwatch.Dimension{{
+			Name:  aws.String("ClientId"),
+			Value: aws.String(accountID),
+		}},
+		EndTime:    aws.Time(endTime),
+		MetricName: aws.String(metricName),
+		Namespace:  aws.String(ocuMetricNamespace),
+		Period:     aws.Int64(int64(period.Seconds())),
+		StartTime:  aws.Time(endTime.Add(-period)),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticMaximum}),
+	}
+
+	output, err := conn.GetMetricStatisticsWithContext(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var maximum float64
+
+	for _, v := range output.Datapoints {
+		if v := aws.Float64Value(v.Maximum); v > maximum {
+			maximum = v
+		}
+	}
+
+	return maximum, nil
+}
+
+type dataSourceOCUUtilizationData struct {
+	ID                       types.String  `tfsdk:"id"`
+	IndexingOCU              types.Float64 `tfsdk:"indexing_ocu"`
+	MaxIndexingCapacityInOCU types.Int64   `tfsdk:"max_indexing_capacity_in_ocu"`
+	MaxSearchCapacityInOCU   types.Int64   `tfsdk:"max_search_capacity_in_ocu"`
+	PeriodInMinutes          types.Int64   `tfsdk:"period_in_minutes"`
+	SearchOCU                types.Float64 `tfsdk:"search_ocu"`
+}
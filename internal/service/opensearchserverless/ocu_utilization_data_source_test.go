@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessOCUUtilizationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_opensearchserverless_ocu_utilization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOCUUtilizationDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "indexing_ocu"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_indexing_capacity_in_ocu"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_search_capacity_in_ocu"),
+					resource.TestCheckResourceAttr(dataSourceName, "period_in_minutes", "120"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "search_ocu"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOCUUtilizationDataSourceConfig_basic = `
+data "aws_opensearchserverless_ocu_utilization" "test" {
+  period_in_minutes = 120
+}
+`
@@ -7,6 +7,9 @@ import (
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	opensearchserverless_sdkv2 "github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	opensearchserverless_sdkv1 "github.com/aws/aws-sdk-go/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -24,6 +27,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceCollection,
 			Name:    "Collection",
 		},
+		{
+			Factory: newDataSourceOCUUtilization,
+			Name:    "OCU Utilization",
+		},
 		{
 			Factory: newDataSourceSecurityConfig,
 			Name:    "Security Config",
@@ -43,6 +50,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory: newResourceLifecyclePolicy,
+		},
 		{
 			Factory: newResourceSecurityConfig,
 		},
@@ -76,6 +86,13 @@ func (p *servicePackage) ServicePackageName() string {
 	return names.OpenSearchServerless
 }
 
+// NewConn returns a new AWS SDK for Go v1 client for this service package's AWS API.
+func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*opensearchserverless_sdkv1.OpenSearchServerless, error) {
+	sess := config["session"].(*session_sdkv1.Session)
+
+	return opensearchserverless_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
+}
+
 // NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
 func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*opensearchserverless_sdkv2.Client, error) {
 	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
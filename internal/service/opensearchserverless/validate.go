@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// policyDocumentValidator validates that a string is a JSON policy document.
+type policyDocumentValidator struct{}
+
+func (v policyDocumentValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON document"
+}
+
+func (v policyDocumentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyDocumentValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if !json.Valid([]byte(value)) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+func policyDocumentIsJSON() validator.String {
+	return policyDocumentValidator{}
+}
+
+type lifecyclePolicyDocument struct {
+	Rules []lifecyclePolicyRule `json:"Rules"`
+}
+
+type lifecyclePolicyRule struct {
+	MinIndexRetention   *string  `json:"MinIndexRetention"`
+	NoMinIndexRetention *bool    `json:"NoMinIndexRetention"`
+	Resource            []string `json:"Resource"`
+	ResourceType        string   `json:"ResourceType"`
+}
+
+var (
+	indexRetentionRegexp = regexache.MustCompile(`^[1-9][0-9]*[dh]$`)
+)
+
+// lifecyclePolicyDocumentValidator validates the structure of a retention lifecycle policy document.
+type lifecyclePolicyDocumentValidator struct{}
+
+func (v lifecyclePolicyDocumentValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON retention lifecycle policy document"
+}
+
+func (v lifecyclePolicyDocumentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v lifecyclePolicyDocumentValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if err := validateLifecyclePolicyDocument(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+			value,
+		))
+	}
+}
+
+func lifecyclePolicyDocumentIsValid() validator.String {
+	return lifecyclePolicyDocumentValidator{}
+}
+
+func validateLifecyclePolicyDocument(value string) error {
+	var document lifecyclePolicyDocument
+
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		return err
+	}
+
+	if len(document.Rules) == 0 {
+		return fmt.Errorf("at least one rule is required")
+	}
+
+	for i, rule := range document.Rules {
+		if rule.ResourceType != "index" {
+			return fmt.Errorf("rule %d: ResourceType must be %q", i, "index")
+		}
+
+		if len(rule.Resource) == 0 {
+			return fmt.Errorf("rule %d: at least one Resource is required", i)
+		}
+
+		for _, resource := range rule.Resource {
+			// Index patterns have the form index/<collection-name>/<index-name>, either of which may contain wildcards.
+			if parts := strings.Split(resource, "/"); len(parts) != 3 || parts[0] != "index" || parts[1] == "" || parts[2] == "" {
+				return fmt.Errorf("rule %d: Resource %q must have the form index/<collection-name>/<index-name>", i, resource)
+			}
+		}
+
+		switch noMinIndexRetention := rule.NoMinIndexRetention != nil && *rule.NoMinIndexRetention; {
+		case rule.MinIndexRetention != nil && noMinIndexRetention:
+			return fmt.Errorf("rule %d: only one of MinIndexRetention or NoMinIndexRetention may be set", i)
+		case rule.MinIndexRetention != nil:
+			if !indexRetentionRegexp.MatchString(*rule.MinIndexRetention) {
+				return fmt.Errorf("rule %d: MinIndexRetention %q must be a number of days (d) or hours (h), for example 30d", i, *rule.MinIndexRetention)
+			}
+		case !noMinIndexRetention:
+			return fmt.Errorf("rule %d: one of MinIndexRetention or NoMinIndexRetention must be set", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"testing"
+
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+)
+
+func TestValidateLifecyclePolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"invalid JSON": {
+			value:       `{"Rules": [`,
+			expectError: true,
+		},
+		"no rules": {
+			value:       `{"Rules": []}`,
+			expectError: true,
+		},
+		"min retention in days": {
+			value: `{"Rules": [{"ResourceType": "index", "Resource": ["index/logs/*"], "MinIndexRetention": "30d"}]}`,
+		},
+		"min retention in hours": {
+			value: `{"Rules": [{"ResourceType": "index", "Resource": ["index/logs/app-*"], "MinIndexRetention": "24h"}]}`,
+		},
+		"no min retention": {
+			value: `{"Rules": [{"ResourceType": "index", "Resource": ["index/*/*"], "NoMinIndexRetention": true}]}`,
+		},
+		"invalid retention": {
+			value:       `{"Rules": [{"ResourceType": "index", "Resource": ["index/logs/*"], "MinIndexRetention": "30"}]}`,
+			expectError: true,
+		},
+		"both retention settings": {
+			value:       `{"Rules": [{"ResourceType": "index", "Resource": ["index/logs/*"], "MinIndexRetention": "30d", "NoMinIndexRetention": true}]}`,
+			expectError: true,
+		},
+		"no retention settings": {
+			value:       `{"Rules": [{"ResourceType": "index", "Resource": ["index/logs/*"]}]}`,
+			expectError: true,
+		},
+		"invalid resource type": {
+			value:       `{"Rules": [{"ResourceType": "collection", "Resource": ["collection/logs"], "MinIndexRetention": "30d"}]}`,
+			expectError: true,
+		},
+		"invalid resource": {
+			value:       `{"Rules": [{"ResourceType": "index", "Resource": ["logs/*"], "MinIndexRetention": "30d"}]}`,
+			expectError: true,
+		},
+		"no resources": {
+			value:       `{"Rules": [{"ResourceType": "index", "MinIndexRetention": "30d"}]}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfopensearchserverless.ValidateLifecyclePolicyDocument(testCase.value)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
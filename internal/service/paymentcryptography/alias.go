@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_paymentcryptography_alias", name="Alias")
+func ResourceAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAliasCreate,
+		ReadWithoutTimeout:   resourceAliasRead,
+		UpdateWithoutTimeout: resourceAliasUpdate,
+		DeleteWithoutTimeout: resourceAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(7, 256),
+					validation.StringMatch(regexache.MustCompile(`^alias/[0-9A-Za-z_/-]+$`), "must begin with alias/ and contain only alphanumeric characters, forward slashes, underscores and hyphens"),
+				),
+			},
+			"key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	name := d.Get("alias_name").(string)
+	input := &paymentcryptography.CreateAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("key_arn"); ok {
+		input.KeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAliasWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Payment Cryptography Alias (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Alias.AliasName))
+
+	return append(diags, resourceAliasRead(ctx, d, meta)...)
+}
+
+func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	alias, err := FindAliasByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Payment Cryptography Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias_name", alias.AliasName)
+	d.Set("key_arn", alias.KeyArn)
+
+	return diags
+}
+
+func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	// Omitting the key ARN disassociates the alias from its key.
+	input := &paymentcryptography.UpdateAliasInput{
+		AliasName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("key_arn"); ok {
+		input.KeyArn = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateAliasWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Payment Cryptography Alias (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAliasRead(ctx, d, meta)...)
+}
+
+func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Payment Cryptography Alias: %s", d.Id())
+	_, err := conn.DeleteAliasWithContext(ctx, &paymentcryptography.DeleteAliasInput{
+		AliasName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Payment Cryptography Alias (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAliasByName(ctx context.Context, conn *paymentcryptography.PaymentCryptography, name string) (*paymentcryptography.Alias, error) {
+	input := &paymentcryptography.GetAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	output, err := conn.GetAliasWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Alias == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Alias, nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pcaconnectorad_directory_registration", name="Directory Registration")
+// @Tags(identifierAttribute="arn")
+func ResourceDirectoryRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryRegistrationCreate,
+		ReadWithoutTimeout:   resourceDirectoryRegistrationRead,
+		UpdateWithoutTimeout: resourceDirectoryRegistrationUpdate,
+		DeleteWithoutTimeout: resourceDirectoryRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDirectoryRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	input := &pcaconnectorad.CreateDirectoryRegistrationInput{
+		DirectoryId: aws.String(directoryID),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateDirectoryRegistrationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating PCA Connector for AD Directory Registration (%s): %s", directoryID, err)
+	}
+
+	d.SetId(aws.StringValue(output.DirectoryRegistrationArn))
+
+	if _, err := waitDirectoryRegistrationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for PCA Connector for AD Directory Registration (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectoryRegistrationRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	registration, err := FindDirectoryRegistrationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] PCA Connector for AD Directory Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading PCA Connector for AD Directory Registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", registration.Arn)
+	d.Set("directory_id", registration.DirectoryId)
+	d.Set("status", registration.Status)
+
+	return diags
+}
+
+func resourceDirectoryRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDirectoryRegistrationRead(ctx, d, meta)
+}
+
+func resourceDirectoryRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	log.Printf("[DEBUG] Deleting PCA Connector for AD Directory Registration: %s", d.Id())
+	_, err := conn.DeleteDirectoryRegistrationWithContext(ctx, &pcaconnectorad.DeleteDirectoryRegistrationInput{
+		DirectoryRegistrationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting PCA Connector for AD Directory Registration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDirectoryRegistrationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for PCA Connector for AD Directory Registration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDirectoryRegistrationByARN(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) (*pcaconnectorad.DirectoryRegistration, error) {
+	input := &pcaconnectorad.GetDirectoryRegistrationInput{
+		DirectoryRegistrationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDirectoryRegistrationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DirectoryRegistration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DirectoryRegistration, nil
+}
+
+func statusDirectoryRegistration(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDirectoryRegistrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDirectoryRegistrationCreated(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string, timeout time.Duration) (*pcaconnectorad.DirectoryRegistration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pcaconnectorad.DirectoryRegistrationStatusCreating},
+		Target:  []string{pcaconnectorad.DirectoryRegistrationStatusActive},
+		Refresh: statusDirectoryRegistration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.DirectoryRegistration); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDirectoryRegistrationDeleted(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string, timeout time.Duration) (*pcaconnectorad.DirectoryRegistration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pcaconnectorad.DirectoryRegistrationStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDirectoryRegistration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.DirectoryRegistration); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
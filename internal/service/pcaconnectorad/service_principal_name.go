@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	servicePrincipalNameResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_pcaconnectorad_service_principal_name", name="Service Principal Name")
+func ResourceServicePrincipalName() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServicePrincipalNameCreate,
+		ReadWithoutTimeout:   resourceServicePrincipalNameRead,
+		DeleteWithoutTimeout: resourceServicePrincipalNameDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connector_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"directory_registration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServicePrincipalNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	connectorARN := d.Get("connector_arn").(string)
+	directoryRegistrationARN := d.Get("directory_registration_arn").(string)
+	id, err := flex.FlattenResourceId([]string{directoryRegistrationARN, connectorARN}, servicePrincipalNameResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &pcaconnectorad.CreateServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+
+	_, err = conn.CreateServicePrincipalNameWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating PCA Connector for AD Service Principal Name (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitServicePrincipalNameCreated(ctx, conn, directoryRegistrationARN, connectorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for PCA Connector for AD Service Principal Name (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceServicePrincipalNameRead(ctx, d, meta)...)
+}
+
+func resourceServicePrincipalNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), servicePrincipalNameResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	spn, err := FindServicePrincipalNameByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] PCA Connector for AD Service Principal Name (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading PCA Connector for AD Service Principal Name (%s): %s", d.Id(), err)
+	}
+
+	d.Set("connector_arn", spn.ConnectorArn)
+	d.Set("directory_registration_arn", spn.DirectoryRegistrationArn)
+	d.Set("status", spn.Status)
+
+	return diags
+}
+
+func resourceServicePrincipalNameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), servicePrincipalNameResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting PCA Connector for AD Service Principal Name: %s", d.Id())
+	_, err = conn.DeleteServicePrincipalNameWithContext(ctx, &pcaconnectorad.DeleteServicePrincipalNameInput{
+		ConnectorArn:             aws.String(parts[1]),
+		DirectoryRegistrationArn: aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting PCA Connector for AD Service Principal Name (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitServicePrincipalNameDeleted(ctx, conn, parts[0], parts[1], d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for PCA Connector for AD Service Principal Name (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindServicePrincipalNameByTwoPartKey(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string) (*pcaconnectorad.ServicePrincipalName, error) {
+	input := &pcaconnectorad.GetServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+
+	output, err := conn.GetServicePrincipalNameWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServicePrincipalName == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServicePrincipalName, nil
+}
+
+func statusServicePrincipalName(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServicePrincipalNameByTwoPartKey(ctx, conn, directoryRegistrationARN, connectorARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitServicePrincipalNameCreated(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string, timeout time.Duration) (*pcaconnectorad.ServicePrincipalName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pcaconnectorad.ServicePrincipalNameStatusCreating},
+		Target:  []string{pcaconnectorad.ServicePrincipalNameStatusActive},
+		Refresh: statusServicePrincipalName(ctx, conn, directoryRegistrationARN, connectorARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.ServicePrincipalName); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServicePrincipalNameDeleted(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string, timeout time.Duration) (*pcaconnectorad.ServicePrincipalName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pcaconnectorad.ServicePrincipalNameStatusDeleting},
+		Target:  []string{},
+		Refresh: statusServicePrincipalName(ctx, conn, directoryRegistrationARN, connectorARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.ServicePrincipalName); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
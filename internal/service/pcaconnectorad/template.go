@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pcaconnectorad_template", name="Template")
+// @Tags(identifierAttribute="arn")
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateCreate,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplateUpdate,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connector_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"definition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validTemplateDefinition,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := equivalentTemplateDefinitionJSON(old, new)
+
+					return equal
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"object_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_schema": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"reenroll_all_certificate_holders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	name := d.Get("name").(string)
+	definition, err := expandTemplateDefinition(d.Get("definition").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating PCA Connector for AD Template (%s): %s", name, err)
+	}
+
+	input := &pcaconnectorad.CreateTemplateInput{
+		ConnectorArn: aws.String(d.Get("connector_arn").(string)),
+		Definition:   definition,
+		Name:         aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating PCA Connector for AD Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TemplateArn))
+
+	return append(diags, resourceTemplateRead(ctx, d, meta)...)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	template, err := FindTemplateByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] PCA Connector for AD Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading PCA Connector for AD Template (%s): %s", d.Id(), err)
+	}
+
+	definition, err := flattenTemplateDefinition(template.Definition)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading PCA Connector for AD Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("connector_arn", template.ConnectorArn)
+	d.Set("definition", definition)
+	d.Set("name", template.Name)
+	d.Set("object_identifier", template.ObjectIdentifier)
+	d.Set("policy_schema", template.PolicySchema)
+	d.Set("status", template.Status)
+
+	return diags
+}
+
+func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	if d.HasChange("definition") {
+		definition, err := expandTemplateDefinition(d.Get("definition").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating PCA Connector for AD Template (%s): %s", d.Id(), err)
+		}
+
+		input := &pcaconnectorad.UpdateTemplateInput{
+			Definition:                    definition,
+			ReenrollAllCertificateHolders: aws.Bool(d.Get("reenroll_all_certificate_holders").(bool)),
+			TemplateArn:                   aws.String(d.Id()),
+		}
+
+		_, err = conn.UpdateTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating PCA Connector for AD Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTemplateRead(ctx, d, meta)...)
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn(ctx)
+
+	log.Printf("[DEBUG] Deleting PCA Connector for AD Template: %s", d.Id())
+	_, err := conn.DeleteTemplateWithContext(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting PCA Connector for AD Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTemplateByARN(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) (*pcaconnectorad.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Template.Status); status == pcaconnectorad.TemplateStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Template, nil
+}
+
+// validTemplateDefinition checks that the value is a template definition containing exactly one
+// of the templateV2, templateV3 or templateV4 schema versions.
+func validTemplateDefinition(v interface{}, k string) (ws []string, errors []error) {
+	definition, err := expandTemplateDefinition(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid template definition: %w", k, err))
+		return
+	}
+
+	n := 0
+	for _, ok := range []bool{definition.TemplateV2 != nil, definition.TemplateV3 != nil, definition.TemplateV4 != nil} {
+		if ok {
+			n++
+		}
+	}
+
+	if n != 1 {
+		errors = append(errors, fmt.Errorf("%q must contain exactly one of templateV2, templateV3 or templateV4", k))
+		return
+	}
+
+	if err := definition.Validate(); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid template definition: %w", k, err))
+	}
+
+	return
+}
+
+func expandTemplateDefinition(s string) (*pcaconnectorad.TemplateDefinition, error) {
+	var definition pcaconnectorad.TemplateDefinition
+
+	if err := json.Unmarshal([]byte(s), &definition); err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+func flattenTemplateDefinition(apiObject *pcaconnectorad.TemplateDefinition) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+// equivalentTemplateDefinitionJSON compares two template definitions in their canonical API form,
+// so that differences in key case or ordering are ignored.
+func equivalentTemplateDefinitionJSON(str1, str2 string) (bool, error) {
+	if str1 == "" {
+		str1 = "{}"
+	}
+
+	if str2 == "" {
+		str2 = "{}"
+	}
+
+	var canonical [2][]byte
+
+	for i, s := range []string{str1, str2} {
+		definition, err := expandTemplateDefinition(s)
+
+		if err != nil {
+			return false, err
+		}
+
+		canonical[i], err = jsonutil.BuildJSON(definition)
+
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return bytes.Equal(canonical[0], canonical[1]), nil
+}
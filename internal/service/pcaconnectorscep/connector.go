@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorscep
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pcaconnectorscep_connector", name="Connector")
+// @Tags(identifierAttribute="arn")
+func ResourceConnector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConnectorCreate,
+		ReadWithoutTimeout:   resourceConnectorRead,
+		UpdateWithoutTimeout: resourceConnectorUpdate,
+		DeleteWithoutTimeout: resourceConnectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mobile_device_management": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intune": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"azure_application_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(15, 100),
+									},
+									"domain": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"open_id_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audience": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn(ctx)
+
+	caARN := d.Get("certificate_authority_arn").(string)
+	input := &pcaconnectorscep.CreateConnectorInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("mobile_device_management"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MobileDeviceManagement = expandMobileDeviceManagement(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateConnectorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating PCA Connector for SCEP Connector (%s): %s", caARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorArn))
+
+	if _, err := waitConnectorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for PCA Connector for SCEP Connector (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConnectorRead(ctx, d, meta)...)
+}
+
+func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn(ctx)
+
+	connector, err := FindConnectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] PCA Connector for SCEP Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading PCA Connector for SCEP Connector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", connector.Arn)
+	d.Set("certificate_authority_arn", connector.CertificateAuthorityArn)
+	d.Set("endpoint", connector.Endpoint)
+	if connector.MobileDeviceManagement != nil {
+		if err := d.Set("mobile_device_management", []interface{}{flattenMobileDeviceManagement(connector.MobileDeviceManagement)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting mobile_device_management: %s", err)
+		}
+	} else {
+		d.Set("mobile_device_management", nil)
+	}
+	if connector.OpenIdConfiguration != nil {
+		if err := d.Set("open_id_configuration", []interface{}{flattenOpenIDConfiguration(connector.OpenIdConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting open_id_configuration: %s", err)
+		}
+	} else {
+		d.Set("open_id_configuration", nil)
+	}
+	d.Set("status", connector.Status)
+	d.Set("type", connector.Type)
+
+	return diags
+}
+
+func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceConnectorRead(ctx, d, meta)
+}
+
+func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn(ctx)
+
+	log.Printf("[DEBUG] Deleting PCA Connector for SCEP Connector: %s", d.Id())
+	_, err := conn.DeleteConnectorWithContext(ctx, &pcaconnectorscep.DeleteConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting PCA Connector for SCEP Connector (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConnectorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for PCA Connector for SCEP Connector (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConnectorByARN(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string) (*pcaconnectorscep.Connector, error) {
+	input := &pcaconnectorscep.GetConnectorInput{
+		ConnectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConnectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Connector == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Connector, nil
+}
+
+func statusConnector(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConnectorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitConnectorCreated(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string, timeout time.Duration) (*pcaconnectorscep.Connector, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pcaconnectorscep.ConnectorStatusCreating},
+		Target:  []string{pcaconnectorscep.ConnectorStatusActive},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorscep.Connector); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorDeleted(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string, timeout time.Duration) (*pcaconnectorscep.Connector, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pcaconnectorscep.ConnectorStatusDeleting},
+		Target:  []string{},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorscep.Connector); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandMobileDeviceManagement(tfMap map[string]interface{}) *pcaconnectorscep.MobileDeviceManagement {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pcaconnectorscep.MobileDeviceManagement{}
+
+	if v, ok := tfMap["intune"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Intune = &pcaconnectorscep.IntuneConfiguration{
+			AzureApplicationId: aws.String(tfMap["azure_application_id"].(string)),
+			Domain:             aws.String(tfMap["domain"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMobileDeviceManagement(apiObject *pcaconnectorscep.MobileDeviceManagement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Intune; v != nil {
+		tfMap["intune"] = []interface{}{map[string]interface{}{
+			"azure_application_id": aws.StringValue(v.AzureApplicationId),
+			"domain":               aws.StringValue(v.Domain),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenOpenIDConfiguration(apiObject *pcaconnectorscep.OpenIdConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"audience": aws.StringValue(apiObject.Audience),
+		"issuer":   aws.StringValue(apiObject.Issuer),
+		"subject":  aws.StringValue(apiObject.Subject),
+	}
+}
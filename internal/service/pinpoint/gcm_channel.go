@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
@@ -34,15 +35,29 @@ func ResourceGCMChannel() *schema.Resource {
 				ForceNew: true,
 			},
 			"api_key": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				AtLeastOneOf: []string{"api_key", "service_json"},
+			},
+			"default_authentication_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"KEY", "TOKEN"}, false),
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
+			"service_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsJSON,
+				AtLeastOneOf: []string{"api_key", "service_json"},
+			},
 		},
 	}
 }
@@ -55,9 +70,20 @@ func resourceGCMChannelUpsert(ctx context.Context, d *schema.ResourceData, meta
 
 	params := &pinpoint.GCMChannelRequest{}
 
-	params.ApiKey = aws.String(d.Get("api_key").(string))
+	if v, ok := d.GetOk("api_key"); ok {
+		params.ApiKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_authentication_method"); ok {
+		params.DefaultAuthenticationMethod = aws.String(v.(string))
+	}
+
 	params.Enabled = aws.Bool(d.Get("enabled").(bool))
 
+	if v, ok := d.GetOk("service_json"); ok {
+		params.ServiceJson = aws.String(v.(string))
+	}
+
 	req := pinpoint.UpdateGcmChannelInput{
 		ApplicationId:     aws.String(applicationId),
 		GCMChannelRequest: params,
@@ -93,8 +119,9 @@ func resourceGCMChannelRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set("application_id", output.GCMChannelResponse.ApplicationId)
+	d.Set("default_authentication_method", output.GCMChannelResponse.DefaultAuthenticationMethod)
 	d.Set("enabled", output.GCMChannelResponse.Enabled)
-	// api_key is never returned
+	// api_key and service_json are never returned
 
 	return diags
 }
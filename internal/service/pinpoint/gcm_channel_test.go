@@ -22,6 +22,7 @@ import (
  Before running this test, the following ENV variable must be set:
 
  GCM_API_KEY - Google Cloud Messaging Api Key
+ GCM_SERVICE_JSON_FILE - Path to a Firebase Cloud Messaging service account JSON file
 **/
 
 func TestAccPinpointGCMChannel_basic(t *testing.T) {
@@ -65,6 +66,45 @@ func TestAccPinpointGCMChannel_basic(t *testing.T) {
 	})
 }
 
+func TestAccPinpointGCMChannel_serviceJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel pinpoint.GCMChannelResponse
+	resourceName := "aws_pinpoint_gcm_channel.test_gcm_channel"
+
+	serviceJSONFile := os.Getenv("GCM_SERVICE_JSON_FILE")
+	if serviceJSONFile == "" {
+		t.Skipf("GCM_SERVICE_JSON_FILE env missing, skip test")
+	}
+
+	serviceJSON, err := os.ReadFile(serviceJSONFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGCMChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCMChannelConfig_serviceJSON(string(serviceJSON)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGCMChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "default_authentication_method", "TOKEN"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_json"},
+			},
+		},
+	})
+}
+
 func testAccCheckGCMChannelExists(ctx context.Context, n string, channel *pinpoint.GCMChannelResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -106,6 +146,19 @@ resource "aws_pinpoint_gcm_channel" "test_gcm_channel" {
 `, apiKey)
 }
 
+func testAccGCMChannelConfig_serviceJSON(serviceJSON string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test_app" {}
+
+resource "aws_pinpoint_gcm_channel" "test_gcm_channel" {
+  application_id                = aws_pinpoint_app.test_app.application_id
+  enabled                       = "false"
+  default_authentication_method = "TOKEN"
+  service_json                  = %[1]q
+}
+`, serviceJSON)
+}
+
 func testAccCheckGCMChannelDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn(ctx)
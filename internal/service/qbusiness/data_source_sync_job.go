@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	dataSourceSyncJobResourceIDPartCount = 4
+)
+
+// @SDKResource("aws_qbusiness_data_source_sync_job", name="Data Source Sync Job")
+func ResourceDataSourceSyncJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataSourceSyncJobCreate,
+		ReadWithoutTimeout:   resourceDataSourceSyncJobRead,
+		DeleteWithoutTimeout: resourceDataSourceSyncJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDataSourceSyncJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"documents_added": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documents_deleted": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documents_failed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documents_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documents_scanned": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceDataSourceSyncJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	applicationID := d.Get("application_id").(string)
+	dataSourceID := d.Get("data_source_id").(string)
+	indexID := d.Get("index_id").(string)
+	input := &qbusiness.StartDataSourceSyncJobInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	// Only one sync job can run for a data source at a time.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.StartDataSourceSyncJobWithContext(ctx, input)
+	}, qbusiness.ErrCodeConflictException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Q Business Data Source (%s) sync job: %s", dataSourceID, err)
+	}
+
+	executionID := aws.StringValue(outputRaw.(*qbusiness.StartDataSourceSyncJobOutput).ExecutionId)
+	id, err := flex.FlattenResourceId([]string{executionID, dataSourceID, indexID, applicationID}, dataSourceSyncJobResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitDataSourceSyncJobSucceeded(ctx, conn, executionID, dataSourceID, indexID, applicationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Q Business Data Source Sync Job (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataSourceSyncJobRead(ctx, d, meta)...)
+}
+
+func resourceDataSourceSyncJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataSourceSyncJobResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	executionID, dataSourceID, indexID, applicationID := parts[0], parts[1], parts[2], parts[3]
+	output, err := FindDataSourceSyncJobByFourPartKey(ctx, conn, executionID, dataSourceID, indexID, applicationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q Business Data Source Sync Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Data Source Sync Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("data_source_id", dataSourceID)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	}
+	d.Set("execution_id", output.ExecutionId)
+	d.Set("index_id", indexID)
+	if metrics := output.Metrics; metrics != nil {
+		d.Set("documents_added", metrics.DocumentsAdded)
+		d.Set("documents_deleted", metrics.DocumentsDeleted)
+		d.Set("documents_failed", metrics.DocumentsFailed)
+		d.Set("documents_modified", metrics.DocumentsModified)
+		d.Set("documents_scanned", metrics.DocumentsScanned)
+	}
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	}
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceDataSourceSyncJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	switch d.Get("status").(string) {
+	case qbusiness.DataSourceSyncJobStatusSyncing, qbusiness.DataSourceSyncJobStatusSyncingIndexing:
+	default:
+		return diags
+	}
+
+	log.Printf("[INFO] Stopping Q Business Data Source Sync Job: %s", d.Id())
+	_, err := conn.StopDataSourceSyncJobWithContext(ctx, &qbusiness.StopDataSourceSyncJobInput{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		DataSourceId:  aws.String(d.Get("data_source_id").(string)),
+		IndexId:       aws.String(d.Get("index_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping Q Business Data Source Sync Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDataSourceSyncJobImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := flex.ExpandResourceId(d.Id(), dataSourceSyncJobResourceIDPartCount, false); err != nil {
+		return nil, err
+	}
+
+	d.Set("wait_for_completion", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindDataSourceSyncJobByFourPartKey(ctx context.Context, conn *qbusiness.QBusiness, executionID, dataSourceID, indexID, applicationID string) (*qbusiness.DataSourceSyncJob, error) {
+	input := &qbusiness.ListDataSourceSyncJobsInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+	var output *qbusiness.DataSourceSyncJob
+
+	err := conn.ListDataSourceSyncJobsPagesWithContext(ctx, input, func(page *qbusiness.ListDataSourceSyncJobsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.History {
+			if v != nil && aws.StringValue(v.ExecutionId) == executionID {
+				output = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			Message:     fmt.Sprintf("No sync job %q for data source %q", executionID, dataSourceID),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusDataSourceSyncJob(ctx context.Context, conn *qbusiness.QBusiness, executionID, dataSourceID, indexID, applicationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataSourceSyncJobByFourPartKey(ctx, conn, executionID, dataSourceID, indexID, applicationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDataSourceSyncJobSucceeded(ctx context.Context, conn *qbusiness.QBusiness, executionID, dataSourceID, indexID, applicationID string, timeout time.Duration) (*qbusiness.DataSourceSyncJob, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.DataSourceSyncJobStatusSyncing, qbusiness.DataSourceSyncJobStatusSyncingIndexing},
+		Target:  []string{qbusiness.DataSourceSyncJobStatusSucceeded},
+		Refresh: statusDataSourceSyncJob(ctx, conn, executionID, dataSourceID, indexID, applicationID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+		// The sync job can take a short time to appear in the data source's history after it is started.
+		NotFoundChecks: 30,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.DataSourceSyncJob); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
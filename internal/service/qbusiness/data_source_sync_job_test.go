@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Data sources cannot be created by this provider, so an existing one must be supplied.
+func TestAccQBusinessDataSourceSyncJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	applicationID, indexID, dataSourceID := testAccPreCheckDataSource(t)
+	var executionID1, executionID2 string
+	resourceName := "aws_qbusiness_data_source_sync_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, qbusiness.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, qbusiness.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSyncJobConfig_basic(applicationID, indexID, dataSourceID, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceSyncJobExecutionID(resourceName, &executionID1),
+					resource.TestCheckResourceAttr(resourceName, "application_id", applicationID),
+					resource.TestCheckResourceAttr(resourceName, "data_source_id", dataSourceID),
+					resource.TestCheckResourceAttrSet(resourceName, "documents_scanned"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "index_id", indexID),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", qbusiness.DataSourceSyncJobStatusSucceeded),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccDataSourceSyncJobConfig_basic(applicationID, indexID, dataSourceID, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceSyncJobExecutionID(resourceName, &executionID2),
+					resource.TestCheckResourceAttr(resourceName, "status", qbusiness.DataSourceSyncJobStatusSucceeded),
+					func(*terraform.State) error {
+						if executionID1 == executionID2 {
+							return fmt.Errorf("Q Business Data Source not synced again")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckDataSource(t *testing.T) (string, string, string) {
+	t.Helper()
+
+	var values []string
+
+	for _, key := range []string{"QBUSINESS_APPLICATION_ID", "QBUSINESS_INDEX_ID", "QBUSINESS_DATA_SOURCE_ID"} {
+		v := os.Getenv(key)
+		if v == "" {
+			t.Skipf("Environment variable %s is not set", key)
+		}
+
+		values = append(values, v)
+	}
+
+	return values[0], values[1], values[2]
+}
+
+func testAccCheckDataSourceSyncJobExecutionID(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["execution_id"] == "" {
+			return fmt.Errorf("No Q Business Data Source Sync Job execution ID is set")
+		}
+
+		*v = rs.Primary.Attributes["execution_id"]
+
+		return nil
+	}
+}
+
+func testAccDataSourceSyncJobConfig_basic(applicationID, indexID, dataSourceID, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_qbusiness_data_source_sync_job" "test" {
+  application_id = %[1]q
+  data_source_id = %[3]q
+  index_id       = %[2]q
+
+  triggers = {
+    run = %[4]q
+  }
+}
+`, applicationID, indexID, dataSourceID, trigger)
+}
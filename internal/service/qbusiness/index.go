@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	indexResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_qbusiness_index", name="Index")
+// @Tags(identifierAttribute="arn")
+func ResourceIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIndexCreate,
+		ReadWithoutTimeout:   resourceIndexRead,
+		UpdateWithoutTimeout: resourceIndexUpdate,
+		DeleteWithoutTimeout: resourceIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"units": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(qbusiness.IndexType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("display_name").(string)
+	input := &qbusiness.CreateIndexInput{
+		ApplicationId: aws.String(applicationID),
+		DisplayName:   aws.String(name),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("capacity_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CapacityConfiguration = expandIndexCapacityConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIndexWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Q Business Index (%s): %s", name, err)
+	}
+
+	indexID := aws.StringValue(output.IndexId)
+	id, err := flex.FlattenResourceId([]string{indexID, applicationID}, indexResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitIndexCreated(ctx, conn, indexID, applicationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Index (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIndexRead(ctx, d, meta)...)
+}
+
+func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), indexResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	indexID, applicationID := parts[0], parts[1]
+	output, err := FindIndexByTwoPartKey(ctx, conn, indexID, applicationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q Business Index (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Index (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", output.IndexArn)
+	if err := d.Set("capacity_configuration", flattenIndexCapacityConfiguration(output.CapacityConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting capacity_configuration: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("display_name", output.DisplayName)
+	d.Set("index_id", output.IndexId)
+	d.Set("type", output.Type)
+
+	return diags
+}
+
+func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	if d.HasChanges("capacity_configuration", "description", "display_name") {
+		parts, err := flex.ExpandResourceId(d.Id(), indexResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		indexID, applicationID := parts[0], parts[1]
+		input := &qbusiness.UpdateIndexInput{
+			ApplicationId: aws.String(applicationID),
+			IndexId:       aws.String(indexID),
+		}
+
+		if d.HasChange("capacity_configuration") {
+			if v, ok := d.GetOk("capacity_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CapacityConfiguration = expandIndexCapacityConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		_, err = conn.UpdateIndexWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Q Business Index (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitIndexUpdated(ctx, conn, indexID, applicationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Q Business Index (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIndexRead(ctx, d, meta)...)
+}
+
+func resourceIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), indexResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	indexID, applicationID := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting Q Business Index: %s", d.Id())
+	_, err = conn.DeleteIndexWithContext(ctx, &qbusiness.DeleteIndexInput{
+		ApplicationId: aws.String(applicationID),
+		IndexId:       aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Q Business Index (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIndexDeleted(ctx, conn, indexID, applicationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Index (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindIndexByTwoPartKey(ctx context.Context, conn *qbusiness.QBusiness, indexID, applicationID string) (*qbusiness.GetIndexOutput, error) {
+	input := &qbusiness.GetIndexInput{
+		ApplicationId: aws.String(applicationID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetIndexWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIndex(ctx context.Context, conn *qbusiness.QBusiness, indexID, applicationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindIndexByTwoPartKey(ctx, conn, indexID, applicationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitIndexCreated(ctx context.Context, conn *qbusiness.QBusiness, indexID, applicationID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.IndexStatusCreating},
+		Target:  []string{qbusiness.IndexStatusActive},
+		Refresh: statusIndex(ctx, conn, indexID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexUpdated(ctx context.Context, conn *qbusiness.QBusiness, indexID, applicationID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.IndexStatusUpdating},
+		Target:  []string{qbusiness.IndexStatusActive},
+		Refresh: statusIndex(ctx, conn, indexID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexDeleted(ctx context.Context, conn *qbusiness.QBusiness, indexID, applicationID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.IndexStatusActive, qbusiness.IndexStatusDeleting},
+		Target:  []string{},
+		Refresh: statusIndex(ctx, conn, indexID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandIndexCapacityConfiguration(tfMap map[string]interface{}) *qbusiness.IndexCapacityConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &qbusiness.IndexCapacityConfiguration{}
+
+	if v, ok := tfMap["units"].(int); ok && v != 0 {
+		apiObject.Units = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenIndexCapacityConfiguration(apiObject *qbusiness.IndexCapacityConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"units": aws.Int64Value(apiObject.Units),
+	}
+
+	return []interface{}{tfMap}
+}
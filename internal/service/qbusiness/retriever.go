@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	retrieverResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_qbusiness_retriever", name="Retriever")
+// @Tags(identifierAttribute="arn")
+func ResourceRetriever() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRetrieverCreate,
+		ReadWithoutTimeout:   resourceRetrieverRead,
+		UpdateWithoutTimeout: resourceRetrieverUpdate,
+		DeleteWithoutTimeout: resourceRetrieverDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kendra_index_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.kendra_index_configuration", "configuration.0.native_index_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"native_index_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.kendra_index_configuration", "configuration.0.native_index_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"retriever_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(qbusiness.RetrieverType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRetrieverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("display_name").(string)
+	input := &qbusiness.CreateRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		Configuration: expandRetrieverConfiguration(d.Get("configuration").([]interface{})),
+		DisplayName:   aws.String(name),
+		Tags:          getTagsIn(ctx),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRetrieverWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Q Business Retriever (%s): %s", name, err)
+	}
+
+	retrieverID := aws.StringValue(output.RetrieverId)
+	id, err := flex.FlattenResourceId([]string{retrieverID, applicationID}, retrieverResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitRetrieverCreated(ctx, conn, retrieverID, applicationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Retriever (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRetrieverRead(ctx, d, meta)...)
+}
+
+func resourceRetrieverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), retrieverResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	retrieverID, applicationID := parts[0], parts[1]
+	output, err := FindRetrieverByTwoPartKey(ctx, conn, retrieverID, applicationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q Business Retriever (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", output.RetrieverArn)
+	if err := d.Set("configuration", flattenRetrieverConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("display_name", output.DisplayName)
+	d.Set("retriever_id", output.RetrieverId)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("type", output.Type)
+
+	return diags
+}
+
+func resourceRetrieverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	if d.HasChanges("configuration", "display_name", "role_arn") {
+		parts, err := flex.ExpandResourceId(d.Id(), retrieverResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		retrieverID, applicationID := parts[0], parts[1]
+		input := &qbusiness.UpdateRetrieverInput{
+			ApplicationId: aws.String(applicationID),
+			Configuration: expandRetrieverConfiguration(d.Get("configuration").([]interface{})),
+			DisplayName:   aws.String(d.Get("display_name").(string)),
+			RetrieverId:   aws.String(retrieverID),
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateRetrieverWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Q Business Retriever (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRetrieverRead(ctx, d, meta)...)
+}
+
+func resourceRetrieverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), retrieverResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	retrieverID, applicationID := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting Q Business Retriever: %s", d.Id())
+	_, err = conn.DeleteRetrieverWithContext(ctx, &qbusiness.DeleteRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRetrieverByTwoPartKey(ctx context.Context, conn *qbusiness.QBusiness, retrieverID, applicationID string) (*qbusiness.GetRetrieverOutput, error) {
+	input := &qbusiness.GetRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	output, err := conn.GetRetrieverWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRetriever(ctx context.Context, conn *qbusiness.QBusiness, retrieverID, applicationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRetrieverByTwoPartKey(ctx, conn, retrieverID, applicationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitRetrieverCreated(ctx context.Context, conn *qbusiness.QBusiness, retrieverID, applicationID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.RetrieverStatusCreating},
+		Target:  []string{qbusiness.RetrieverStatusActive},
+		Refresh: statusRetriever(ctx, conn, retrieverID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandRetrieverConfiguration(tfList []interface{}) *qbusiness.RetrieverConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &qbusiness.RetrieverConfiguration{}
+
+	if v, ok := tfMap["kendra_index_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KendraIndexConfiguration = &qbusiness.KendraIndexConfiguration{
+			IndexId: aws.String(v[0].(map[string]interface{})["index_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["native_index_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NativeIndexConfiguration = &qbusiness.NativeIndexConfiguration{
+			IndexId: aws.String(v[0].(map[string]interface{})["index_id"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRetrieverConfiguration(apiObject *qbusiness.RetrieverConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KendraIndexConfiguration; v != nil {
+		tfMap["kendra_index_configuration"] = []interface{}{map[string]interface{}{
+			"index_id": aws.StringValue(v.IndexId),
+		}}
+	}
+
+	if v := apiObject.NativeIndexConfiguration; v != nil {
+		tfMap["native_index_configuration"] = []interface{}{map[string]interface{}{
+			"index_id": aws.StringValue(v.IndexId),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	webExperienceResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_qbusiness_web_experience", name="Web Experience")
+// @Tags(identifierAttribute="arn")
+func ResourceWebExperience() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebExperienceCreate,
+		ReadWithoutTimeout:   resourceWebExperienceRead,
+		UpdateWithoutTimeout: resourceWebExperienceUpdate,
+		DeleteWithoutTimeout: resourceWebExperienceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sample_prompts_control_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(qbusiness.WebExperienceSamplePromptsControlMode_Values(), false),
+			},
+			"subtitle": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"web_experience_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"welcome_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 300),
+			},
+		},
+	}
+}
+
+func resourceWebExperienceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	applicationID := d.Get("application_id").(string)
+	input := &qbusiness.CreateWebExperienceInput{
+		ApplicationId: aws.String(applicationID),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_prompts_control_mode"); ok {
+		input.SamplePromptsControlMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subtitle"); ok {
+		input.Subtitle = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("welcome_message"); ok {
+		input.WelcomeMessage = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateWebExperienceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Q Business Web Experience (%s): %s", applicationID, err)
+	}
+
+	webExperienceID := aws.StringValue(output.WebExperienceId)
+	id, err := flex.FlattenResourceId([]string{webExperienceID, applicationID}, webExperienceResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitWebExperienceCreated(ctx, conn, webExperienceID, applicationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Web Experience (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWebExperienceRead(ctx, d, meta)...)
+}
+
+func resourceWebExperienceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), webExperienceResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	webExperienceID, applicationID := parts[0], parts[1]
+	output, err := FindWebExperienceByTwoPartKey(ctx, conn, webExperienceID, applicationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q Business Web Experience (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Web Experience (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", output.WebExperienceArn)
+	d.Set("default_endpoint", output.DefaultEndpoint)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("sample_prompts_control_mode", output.SamplePromptsControlMode)
+	d.Set("subtitle", output.Subtitle)
+	d.Set("title", output.Title)
+	d.Set("web_experience_id", output.WebExperienceId)
+	d.Set("welcome_message", output.WelcomeMessage)
+
+	return diags
+}
+
+func resourceWebExperienceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), webExperienceResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		webExperienceID, applicationID := parts[0], parts[1]
+		input := &qbusiness.UpdateWebExperienceInput{
+			ApplicationId:   aws.String(applicationID),
+			WebExperienceId: aws.String(webExperienceID),
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("sample_prompts_control_mode") {
+			input.SamplePromptsControlMode = aws.String(d.Get("sample_prompts_control_mode").(string))
+		}
+
+		if d.HasChange("subtitle") {
+			input.Subtitle = aws.String(d.Get("subtitle").(string))
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		if d.HasChange("welcome_message") {
+			input.WelcomeMessage = aws.String(d.Get("welcome_message").(string))
+		}
+
+		_, err = conn.UpdateWebExperienceWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Q Business Web Experience (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWebExperienceRead(ctx, d, meta)...)
+}
+
+func resourceWebExperienceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), webExperienceResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	webExperienceID, applicationID := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting Q Business Web Experience: %s", d.Id())
+	_, err = conn.DeleteWebExperienceWithContext(ctx, &qbusiness.DeleteWebExperienceInput{
+		ApplicationId:   aws.String(applicationID),
+		WebExperienceId: aws.String(webExperienceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Q Business Web Experience (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitWebExperienceDeleted(ctx, conn, webExperienceID, applicationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Web Experience (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindWebExperienceByTwoPartKey(ctx context.Context, conn *qbusiness.QBusiness, webExperienceID, applicationID string) (*qbusiness.GetWebExperienceOutput, error) {
+	input := &qbusiness.GetWebExperienceInput{
+		ApplicationId:   aws.String(applicationID),
+		WebExperienceId: aws.String(webExperienceID),
+	}
+
+	output, err := conn.GetWebExperienceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusWebExperience(ctx context.Context, conn *qbusiness.QBusiness, webExperienceID, applicationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindWebExperienceByTwoPartKey(ctx, conn, webExperienceID, applicationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitWebExperienceCreated(ctx context.Context, conn *qbusiness.QBusiness, webExperienceID, applicationID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.WebExperienceStatusCreating},
+		// A web experience without an identity provider waits for its authentication configuration.
+		Target:  []string{qbusiness.WebExperienceStatusActive, qbusiness.WebExperienceStatusPendingAuthConfig},
+		Refresh: statusWebExperience(ctx, conn, webExperienceID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitWebExperienceDeleted(ctx context.Context, conn *qbusiness.QBusiness, webExperienceID, applicationID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qbusiness.WebExperienceStatusActive, qbusiness.WebExperienceStatusDeleting, qbusiness.WebExperienceStatusPendingAuthConfig},
+		Target:  []string{},
+		Refresh: statusWebExperience(ctx, conn, webExperienceID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
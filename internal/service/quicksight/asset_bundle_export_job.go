@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_quicksight_asset_bundle_export_job", name="Asset Bundle Export Job")
+func ResourceAssetBundleExportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetBundleExportJobCreate,
+		ReadWithoutTimeout:   resourceAssetBundleExportJobRead,
+		DeleteWithoutTimeout: resourceAssetBundleExportJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_bundle_export_job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 512),
+					validation.StringMatch(regexache.MustCompile(`^[\w\-]+$`), "must contain only alphanumeric characters, hyphens, and underscores"),
+				),
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"download_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.AssetBundleExportFormat_Values(), false),
+			},
+			"include_all_dependencies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"include_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"include_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameAssetBundleExportJob = "Asset Bundle Export Job"
+)
+
+func resourceAssetBundleExportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	jobID := d.Get("asset_bundle_export_job_id").(string)
+	id := createAssetBundleJobID(awsAccountID, jobID)
+
+	input := &quicksight.StartAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+		ExportFormat:           aws.String(d.Get("export_format").(string)),
+		IncludeAllDependencies: aws.Bool(d.Get("include_all_dependencies").(bool)),
+		IncludePermissions:     aws.Bool(d.Get("include_permissions").(bool)),
+		IncludeTags:            aws.Bool(d.Get("include_tags").(bool)),
+		ResourceArns:           flex.ExpandStringSet(d.Get("resource_arns").(*schema.Set)),
+	}
+
+	_, err := conn.StartAssetBundleExportJobWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAssetBundleExportJob, id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAssetBundleExportJobSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionWaitingForCreation, ResNameAssetBundleExportJob, d.Id(), err)
+	}
+
+	return resourceAssetBundleExportJobRead(ctx, d, meta)
+}
+
+func resourceAssetBundleExportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	out, err := FindAssetBundleExportJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Asset Bundle Export Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameAssetBundleExportJob, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("asset_bundle_export_job_id", out.AssetBundleExportJobId)
+	d.Set("aws_account_id", out.AwsAccountId)
+	if out.CreatedTime != nil {
+		d.Set("created_time", out.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("download_url", out.DownloadUrl)
+	d.Set("export_format", out.ExportFormat)
+	d.Set("include_all_dependencies", out.IncludeAllDependencies)
+	d.Set("include_permissions", out.IncludePermissions)
+	d.Set("include_tags", out.IncludeTags)
+	d.Set("job_status", out.JobStatus)
+	d.Set("resource_arns", aws.StringValueSlice(out.ResourceArns))
+
+	return nil
+}
+
+// Asset bundle export jobs can't be deleted; QuickSight expires them 14 days after completion.
+func resourceAssetBundleExportJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] QuickSight Asset Bundle Export Job (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func FindAssetBundleExportJobByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	awsAccountID, jobID, err := ParseAssetBundleJobID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &quicksight.DescribeAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	out, err := conn.DescribeAssetBundleExportJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return out, nil
+}
+
+func statusAssetBundleExportJob(ctx context.Context, conn *quicksight.QuickSight, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindAssetBundleExportJobByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.JobStatus), nil
+	}
+}
+
+func waitAssetBundleExportJobSucceeded(ctx context.Context, conn *quicksight.QuickSight, id string, timeout time.Duration) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{quicksight.AssetBundleExportJobStatusQueuedForImmediateExecution, quicksight.AssetBundleExportJobStatusInProgress},
+		Target:         []string{quicksight.AssetBundleExportJobStatusSuccessful},
+		Refresh:        statusAssetBundleExportJob(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*quicksight.DescribeAssetBundleExportJobOutput); ok {
+		var errs []error
+		for _, v := range out.Errors {
+			errs = append(errs, fmt.Errorf("%s: %s: %s", aws.StringValue(v.Type), aws.StringValue(v.Arn), aws.StringValue(v.Message)))
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func ParseAssetBundleJobID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,ASSET_BUNDLE_JOB_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func createAssetBundleJobID(awsAccountID, jobID string) string {
+	return fmt.Sprintf("%s,%s", awsAccountID, jobID)
+}
@@ -0,0 +1,710 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_quicksight_asset_bundle_import_job", name="Asset Bundle Import Job")
+func ResourceAssetBundleImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetBundleImportJobCreate,
+		ReadWithoutTimeout:   resourceAssetBundleImportJobRead,
+		DeleteWithoutTimeout: resourceAssetBundleImportJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			nameSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				}
+			}
+			idSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"asset_bundle_import_job_id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 512),
+						validation.StringMatch(regexache.MustCompile(`^[\w\-]+$`), "must contain only alphanumeric characters, hyphens, and underscores"),
+					),
+				},
+				"asset_bundle_import_source": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsBase64,
+								ExactlyOneOf: []string{"asset_bundle_import_source.0.body", "asset_bundle_import_source.0.s3_uri"},
+							},
+							"s3_uri": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://`), "must be an S3 URI"),
+								ExactlyOneOf: []string{"asset_bundle_import_source.0.body", "asset_bundle_import_source.0.s3_uri"},
+							},
+						},
+					},
+				},
+				"aws_account_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"created_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"failure_action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.AssetBundleImportFailureAction_Values(), false),
+				},
+				"job_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"override_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"analyses": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"analysis_id": idSchema(),
+										"name":        nameSchema(),
+									},
+								},
+							},
+							"dashboards": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dashboard_id": idSchema(),
+										"name":         nameSchema(),
+									},
+								},
+							},
+							"data_sets": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"data_set_id": idSchema(),
+										"name":        nameSchema(),
+									},
+								},
+							},
+							"data_sources": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"credentials": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"credential_pair": {
+														Type:     schema.TypeList,
+														Optional: true,
+														ForceNew: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"password": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ForceNew:     true,
+																	Sensitive:    true,
+																	ValidateFunc: validation.StringLenBetween(1, 1024),
+																},
+																"username": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ForceNew:     true,
+																	Sensitive:    true,
+																	ValidateFunc: validation.StringLenBetween(1, 64),
+																},
+															},
+														},
+													},
+													"secret_arn": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ForceNew:     true,
+														ValidateFunc: verify.ValidARN,
+													},
+												},
+											},
+										},
+										"data_source_id": idSchema(),
+										"name":           nameSchema(),
+										"parameters": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem:     dataSourceParametersSchema(),
+										},
+										"ssl_properties": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"disable_ssl": {
+														Type:     schema.TypeBool,
+														Required: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"vpc_connection_properties": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"vpc_connection_arn": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ForceNew:     true,
+														ValidateFunc: verify.ValidARN,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"refresh_schedules": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"data_set_id": idSchema(),
+										"schedule_id": idSchema(),
+										"start_after_date_time": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.IsRFC3339Time,
+										},
+									},
+								},
+							},
+							"resource_id_override_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"prefix_for_all_resources": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+							"themes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name":     nameSchema(),
+										"theme_id": idSchema(),
+									},
+								},
+							},
+							"vpc_connections": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dns_resolvers": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 15,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"name": nameSchema(),
+										"role_arn": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"security_group_ids": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MinItems: 1,
+											MaxItems: 16,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"subnet_ids": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MinItems: 2,
+											MaxItems: 15,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"vpc_connection_id": idSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+const (
+	ResNameAssetBundleImportJob = "Asset Bundle Import Job"
+)
+
+func resourceAssetBundleImportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	jobID := d.Get("asset_bundle_import_job_id").(string)
+	id := createAssetBundleJobID(awsAccountID, jobID)
+
+	input := &quicksight.StartAssetBundleImportJobInput{
+		AssetBundleImportJobId:  aws.String(jobID),
+		AssetBundleImportSource: expandAssetBundleImportSource(d.Get("asset_bundle_import_source").([]interface{})),
+		AwsAccountId:            aws.String(awsAccountID),
+	}
+
+	if v, ok := d.GetOk("failure_action"); ok {
+		input.FailureAction = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("override_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OverrideParameters = expandAssetBundleImportJobOverrideParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.StartAssetBundleImportJobWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAssetBundleImportJob, id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAssetBundleImportJobSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionWaitingForCreation, ResNameAssetBundleImportJob, d.Id(), err)
+	}
+
+	return resourceAssetBundleImportJobRead(ctx, d, meta)
+}
+
+func resourceAssetBundleImportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	out, err := FindAssetBundleImportJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Asset Bundle Import Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameAssetBundleImportJob, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("asset_bundle_import_job_id", out.AssetBundleImportJobId)
+	d.Set("aws_account_id", out.AwsAccountId)
+	if out.CreatedTime != nil {
+		d.Set("created_time", out.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("failure_action", out.FailureAction)
+	d.Set("job_status", out.JobStatus)
+
+	// The import source body and any data source credentials are not returned by the API,
+	// so the configured values are kept as-is.
+
+	return nil
+}
+
+// Asset bundle import jobs can't be deleted and the imported assets are owned by the
+// target account, so removing this resource only removes it from state.
+func resourceAssetBundleImportJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] QuickSight Asset Bundle Import Job (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func FindAssetBundleImportJobByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	awsAccountID, jobID, err := ParseAssetBundleJobID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &quicksight.DescribeAssetBundleImportJobInput{
+		AssetBundleImportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	out, err := conn.DescribeAssetBundleImportJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return out, nil
+}
+
+func statusAssetBundleImportJob(ctx context.Context, conn *quicksight.QuickSight, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindAssetBundleImportJobByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.JobStatus), nil
+	}
+}
+
+func waitAssetBundleImportJobSucceeded(ctx context.Context, conn *quicksight.QuickSight, id string, timeout time.Duration) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			quicksight.AssetBundleImportJobStatusQueuedForImmediateExecution,
+			quicksight.AssetBundleImportJobStatusInProgress,
+			quicksight.AssetBundleImportJobStatusFailedRollbackInProgress,
+		},
+		Target:         []string{quicksight.AssetBundleImportJobStatusSuccessful},
+		Refresh:        statusAssetBundleImportJob(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*quicksight.DescribeAssetBundleImportJobOutput); ok {
+		var errs []error
+		for _, v := range append(out.Errors, out.RollbackErrors...) {
+			errs = append(errs, fmt.Errorf("%s: %s: %s", aws.StringValue(v.Type), aws.StringValue(v.Arn), aws.StringValue(v.Message)))
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandAssetBundleImportSource(tfList []interface{}) *quicksight.AssetBundleImportSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.AssetBundleImportSource{}
+
+	if v, ok := tfMap["body"].(string); ok && v != "" {
+		// Validated by validation.StringIsBase64.
+		body, _ := base64.StdEncoding.DecodeString(v)
+		apiObject.Body = body
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAssetBundleImportJobOverrideParameters(tfMap map[string]interface{}) *quicksight.AssetBundleImportJobOverrideParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.AssetBundleImportJobOverrideParameters{}
+
+	if v, ok := tfMap["analyses"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := &quicksight.AssetBundleImportJobAnalysisOverrideParameters{
+				AnalysisId: aws.String(tfMap["analysis_id"].(string)),
+			}
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				item.Name = aws.String(v)
+			}
+
+			apiObject.Analyses = append(apiObject.Analyses, item)
+		}
+	}
+
+	if v, ok := tfMap["dashboards"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := &quicksight.AssetBundleImportJobDashboardOverrideParameters{
+				DashboardId: aws.String(tfMap["dashboard_id"].(string)),
+			}
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				item.Name = aws.String(v)
+			}
+
+			apiObject.Dashboards = append(apiObject.Dashboards, item)
+		}
+	}
+
+	if v, ok := tfMap["data_sets"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := &quicksight.AssetBundleImportJobDataSetOverrideParameters{
+				DataSetId: aws.String(tfMap["data_set_id"].(string)),
+			}
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				item.Name = aws.String(v)
+			}
+
+			apiObject.DataSets = append(apiObject.DataSets, item)
+		}
+	}
+
+	if v, ok := tfMap["data_sources"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.DataSources = append(apiObject.DataSources, expandAssetBundleImportJobDataSourceOverrideParameters(tfMap))
+		}
+	}
+
+	if v, ok := tfMap["refresh_schedules"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := &quicksight.AssetBundleImportJobRefreshScheduleOverrideParameters{
+				DataSetId:  aws.String(tfMap["data_set_id"].(string)),
+				ScheduleId: aws.String(tfMap["schedule_id"].(string)),
+			}
+			if v, ok := tfMap["start_after_date_time"].(string); ok && v != "" {
+				t, _ := time.Parse(time.RFC3339, v) // Validated by validation.IsRFC3339Time.
+				item.StartAfterDateTime = aws.Time(t)
+			}
+
+			apiObject.RefreshSchedules = append(apiObject.RefreshSchedules, item)
+		}
+	}
+
+	if v, ok := tfMap["resource_id_override_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ResourceIdOverrideConfiguration = &quicksight.AssetBundleImportJobResourceIdOverrideConfiguration{}
+
+		if v, ok := tfMap["prefix_for_all_resources"].(string); ok && v != "" {
+			apiObject.ResourceIdOverrideConfiguration.PrefixForAllResources = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["themes"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := &quicksight.AssetBundleImportJobThemeOverrideParameters{
+				ThemeId: aws.String(tfMap["theme_id"].(string)),
+			}
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				item.Name = aws.String(v)
+			}
+
+			apiObject.Themes = append(apiObject.Themes, item)
+		}
+	}
+
+	if v, ok := tfMap["vpc_connections"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := &quicksight.AssetBundleImportJobVPCConnectionOverrideParameters{
+				VPCConnectionId: aws.String(tfMap["vpc_connection_id"].(string)),
+			}
+			if v, ok := tfMap["dns_resolvers"].([]interface{}); ok && len(v) > 0 {
+				item.DnsResolvers = flex.ExpandStringList(v)
+			}
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				item.Name = aws.String(v)
+			}
+			if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+				item.RoleArn = aws.String(v)
+			}
+			if v, ok := tfMap["security_group_ids"].([]interface{}); ok && len(v) > 0 {
+				item.SecurityGroupIds = flex.ExpandStringList(v)
+			}
+			if v, ok := tfMap["subnet_ids"].([]interface{}); ok && len(v) > 0 {
+				item.SubnetIds = flex.ExpandStringList(v)
+			}
+
+			apiObject.VPCConnections = append(apiObject.VPCConnections, item)
+		}
+	}
+
+	return apiObject
+}
+
+func expandAssetBundleImportJobDataSourceOverrideParameters(tfMap map[string]interface{}) *quicksight.AssetBundleImportJobDataSourceOverrideParameters {
+	apiObject := &quicksight.AssetBundleImportJobDataSourceOverrideParameters{
+		DataSourceId: aws.String(tfMap["data_source_id"].(string)),
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Credentials = &quicksight.AssetBundleImportJobDataSourceCredentials{}
+
+		if v, ok := tfMap["credential_pair"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Credentials.CredentialPair = &quicksight.AssetBundleImportJobDataSourceCredentialPair{
+				Password: aws.String(tfMap["password"].(string)),
+				Username: aws.String(tfMap["username"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+			apiObject.Credentials.SecretArn = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataSourceParameters = expandDataSourceParameters(v)
+	}
+
+	if v, ok := tfMap["ssl_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SslProperties = expandDataSourceSSLProperties(v)
+	}
+
+	if v, ok := tfMap["vpc_connection_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.VpcConnectionProperties = expandDataSourceVPCConnectionProperties(v)
+	}
+
+	return apiObject
+}
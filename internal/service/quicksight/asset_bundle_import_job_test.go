@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envVarAssetBundleS3URI             = "AWS_QUICKSIGHT_ASSET_BUNDLE_S3_URI"
+	envVarAssetBundleS3URIMessageError = "Environment variable AWS_QUICKSIGHT_ASSET_BUNDLE_S3_URI is not set. " +
+		"It must be the S3 URI of a QuickSight asset bundle exported in QUICKSIGHT_JSON format."
+)
+
+func TestAccQuickSightAssetBundleImportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	s3URI := envvar.SkipIfEmpty(t, envVarAssetBundleS3URI, envVarAssetBundleS3URIMessageError)
+	var job quicksight.DescribeAssetBundleImportJobOutput
+	resourceName := "aws_quicksight_asset_bundle_import_job.test"
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetBundleImportJobConfig_basic(rId, s3URI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetBundleImportJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "asset_bundle_import_job_id", rId),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("asset-bundle-import-job/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "failure_action", quicksight.AssetBundleImportFailureActionRollback),
+					resource.TestCheckResourceAttr(resourceName, "job_status", quicksight.AssetBundleImportJobStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "override_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "override_parameters.0.resource_id_override_configuration.0.prefix_for_all_resources", rId),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"asset_bundle_import_source", "override_parameters"},
+			},
+		},
+	})
+}
+
+func testAccCheckAssetBundleImportJobExists(ctx context.Context, resourceName string, job *quicksight.DescribeAssetBundleImportJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+		output, err := tfquicksight.FindAssetBundleImportJobByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleImportJob, rs.Primary.ID, err)
+		}
+
+		*job = *output
+
+		return nil
+	}
+}
+
+func testAccAssetBundleImportJobConfig_basic(rId, s3URI string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_import_job" "test" {
+  asset_bundle_import_job_id = %[1]q
+  failure_action             = "ROLLBACK"
+
+  asset_bundle_import_source {
+    s3_uri = %[2]q
+  }
+
+  override_parameters {
+    resource_id_override_configuration {
+      prefix_for_all_resources = %[1]q
+    }
+  }
+}
+`, rId, s3URI)
+}
@@ -113,432 +113,7 @@ func ResourceDataSource() *schema.Resource {
 					Required: true,
 					MaxItems: 1,
 					MinItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"amazon_elasticsearch": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"domain": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"athena": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"work_group": {
-											Type:         schema.TypeString,
-											Optional:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"aurora": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"aurora_postgresql": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"aws_iot_analytics": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"data_set_name": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"jira": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"site_base_url": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"maria_db": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"mysql": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"oracle": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"postgresql": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"presto": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"catalog": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"rds": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"instance_id": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"redshift": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"cluster_id": {
-											Type:         schema.TypeString,
-											Optional:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:     schema.TypeString,
-											Optional: true,
-										},
-										"port": {
-											Type:     schema.TypeInt,
-											Optional: true,
-										},
-									},
-								},
-							},
-							"s3": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"manifest_file_location": {
-											Type:     schema.TypeList,
-											Required: true,
-											MaxItems: 1,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													"bucket": {
-														Type:         schema.TypeString,
-														Required:     true,
-														ValidateFunc: validation.NoZeroValues,
-													},
-													"key": {
-														Type:         schema.TypeString,
-														Required:     true,
-														ValidateFunc: validation.NoZeroValues,
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-							"service_now": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"site_base_url": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"snowflake": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"warehouse": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-							"spark": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"sql_server": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"teradata": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"database": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"host": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-										"port": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-							"twitter": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"max_rows": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-										"query": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.NoZeroValues,
-										},
-									},
-								},
-							},
-						},
-					},
+					Elem:     dataSourceParametersSchema(),
 				},
 
 				"permission": {
@@ -609,6 +184,435 @@ func ResourceDataSource() *schema.Resource {
 	}
 }
 
+func dataSourceParametersSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"amazon_elasticsearch": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"athena": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"work_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"aurora": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"aurora_postgresql": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"aws_iot_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_set_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"jira": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_base_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"maria_db": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"mysql": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"oracle": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"postgresql": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"presto": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"rds": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"instance_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"redshift": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"s3": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manifest_file_location": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_now": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_base_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"snowflake": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"warehouse": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"spark": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"sql_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"teradata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"twitter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_rows": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"query": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
 
@@ -61,6 +61,11 @@ const (
 	endpointAccessStatusModifying = "modifying"
 )
 
+const (
+	reservedNodeStateActive         = "active"
+	reservedNodeStatePaymentPending = "pending-payment"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
@@ -419,3 +419,32 @@ func FindClusterSnapshotByID(ctx context.Context, conn *redshift.Redshift, id st
 
 	return output.Snapshots[0], nil
 }
+
+func FindReservedNodeByID(ctx context.Context, conn *redshift.Redshift, id string) (*redshift.ReservedNode, error) {
+	input := &redshift.DescribeReservedNodesInput{
+		ReservedNodeId: aws.String(id),
+	}
+
+	output, err := conn.DescribeReservedNodesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeReservedNodeNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ReservedNodes) == 0 || output.ReservedNodes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ReservedNodes); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ReservedNodes[0], nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_redshift_reserved_node_offering")
+func DataSourceReservedNodeOffering() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReservedNodeOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"All Upfront",
+					"No Upfront",
+					"Partial Upfront",
+				}, false),
+			},
+			"reserved_node_offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedNodeOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	duration := int64(d.Get("duration").(int))
+	nodeType := d.Get("node_type").(string)
+	offeringType := d.Get("offering_type").(string)
+
+	// DescribeReservedNodeOfferings has no server-side filters.
+	offering, err := findReservedNodeOffering(ctx, conn, &redshift.DescribeReservedNodeOfferingsInput{}, func(v *redshift.ReservedNodeOffering) bool {
+		return aws.Int64Value(v.Duration) == duration && aws.StringValue(v.NodeType) == nodeType && aws.StringValue(v.OfferingType) == offeringType
+	})
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Redshift Reserved Node Offering", err))
+	}
+
+	d.SetId(aws.StringValue(offering.ReservedNodeOfferingId))
+	d.Set("currency_code", offering.CurrencyCode)
+	d.Set("duration", offering.Duration)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("node_type", offering.NodeType)
+	d.Set("offering_id", offering.ReservedNodeOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("reserved_node_offering_type", offering.ReservedNodeOfferingType)
+	d.Set("usage_price", offering.UsagePrice)
+
+	return diags
+}
+
+func findReservedNodeOffering(ctx context.Context, conn *redshift.Redshift, input *redshift.DescribeReservedNodeOfferingsInput, filter func(*redshift.ReservedNodeOffering) bool) (*redshift.ReservedNodeOffering, error) {
+	var output []*redshift.ReservedNodeOffering
+
+	err := conn.DescribeReservedNodeOfferingsPagesWithContext(ctx, input, func(page *redshift.DescribeReservedNodeOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReservedNodeOfferings {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+)
+
+func TestAccRedshiftReservedNode_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RUN_REDSHIFT_RESERVED_NODE_TESTS"
+	if os.Getenv(key) != "true" {
+		t.Skipf("Environment variable %s is not set to true", key)
+	}
+
+	var reservation redshift.ReservedNode
+	resourceName := "aws_redshift_reserved_node.test"
+	dataSourceName := "data.aws_redshift_reserved_node_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedNodeConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservedNodeExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttrPair(dataSourceName, "currency_code", resourceName, "currency_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "duration", resourceName, "duration"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fixed_price", resourceName, "fixed_price"),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "node_type", resourceName, "node_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_type", resourceName, "offering_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReservedNodeExists(ctx context.Context, n string, v *redshift.ReservedNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Reserved Node ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn(ctx)
+
+		output, err := tfredshift.FindReservedNodeByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedNodeConfig_basic() string {
+	return `
+data "aws_redshift_reserved_node_offering" "test" {
+  duration      = 31536000
+  node_type     = "ra3.xlplus"
+  offering_type = "No Upfront"
+}
+
+resource "aws_redshift_reserved_node" "test" {
+  offering_id = data.aws_redshift_reserved_node_offering.test.offering_id
+}
+`
+}
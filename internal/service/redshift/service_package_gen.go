@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceOrderableCluster,
 			TypeName: "aws_redshift_orderable_cluster",
 		},
+		{
+			Factory:  DataSourceReservedNodeOffering,
+			TypeName: "aws_redshift_reserved_node_offering",
+		},
 		{
 			Factory:  DataSourceServiceAccount,
 			TypeName: "aws_redshift_service_account",
@@ -118,6 +122,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePartner,
 			TypeName: "aws_redshift_partner",
 		},
+		{
+			Factory:  ResourceReservedNode,
+			TypeName: "aws_redshift_reserved_node",
+			Name:     "Reserved Node",
+		},
 		{
 			Factory:  ResourceScheduledAction,
 			TypeName: "aws_redshift_scheduled_action",
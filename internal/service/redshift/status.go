@@ -107,3 +107,19 @@ func statusClusterSnapshot(ctx context.Context, conn *redshift.Redshift, id stri
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusReservedNode(ctx context.Context, conn *redshift.Redshift, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReservedNodeByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -218,3 +218,25 @@ func waitClusterSnapshotDeleted(ctx context.Context, conn *redshift.Redshift, id
 
 	return nil, err
 }
+
+func waitReservedNodeCreated(ctx context.Context, conn *redshift.Redshift, id string, timeout time.Duration) (*redshift.ReservedNode, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{reservedNodeStatePaymentPending},
+		Target:         []string{reservedNodeStateActive},
+		Refresh:        statusReservedNode(ctx, conn, id),
+		NotFoundChecks: 5,
+		Timeout:        timeout,
+		MinTimeout:     10 * time.Second,
+		Delay:          30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshift.ReservedNode); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.State)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repostspace
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/repostspace"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The API doesn't model space statuses as an enum.
+const (
+	spaceStatusCreated  = "CREATED"
+	spaceStatusCreating = "CREATING"
+	spaceStatusDeleted  = "DELETED"
+	spaceStatusDeleting = "DELETING"
+)
+
+// @SDKResource("aws_repostspace_space", name="Space")
+// @Tags(identifierAttribute="arn")
+func ResourceSpace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSpaceCreate,
+		ReadWithoutTimeout:   resourceSpaceRead,
+		UpdateWithoutTimeout: resourceSpaceUpdate,
+		DeleteWithoutTimeout: resourceSpaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"group_admins": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 30),
+			},
+			"random_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"storage_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"subdomain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(repostspace.TierLevel_Values(), false),
+			},
+			"user_admins": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"user_kms_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"vanity_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vanity_domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &repostspace.CreateSpaceInput{
+		Name:      aws.String(name),
+		Subdomain: aws.String(d.Get("subdomain").(string)),
+		Tags:      getTagsIn(ctx),
+		Tier:      aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_kms_key"); ok {
+		input.UserKMSKey = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSpaceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating re:Post Private Space (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SpaceId))
+
+	if _, err := waitSpaceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for re:Post Private Space (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSpaceRead(ctx, d, meta)...)
+}
+
+func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	space, err := FindSpaceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] re:Post Private Space (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading re:Post Private Space (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", space.Arn)
+	d.Set("client_id", space.ClientId)
+	d.Set("configuration_status", space.ConfigurationStatus)
+	d.Set("description", space.Description)
+	d.Set("group_admins", aws.StringValueSlice(space.GroupAdmins))
+	d.Set("name", space.Name)
+	d.Set("random_domain", space.RandomDomain)
+	d.Set("role_arn", space.CustomerRoleArn)
+	d.Set("storage_limit", space.StorageLimit)
+	d.Set("tier", space.Tier)
+	d.Set("user_admins", aws.StringValueSlice(space.UserAdmins))
+	d.Set("user_count", space.UserCount)
+	d.Set("user_kms_key", space.UserKMSKey)
+	d.Set("vanity_domain", space.VanityDomain)
+	d.Set("vanity_domain_status", space.VanityDomainStatus)
+
+	return diags
+}
+
+func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	if d.HasChanges("description", "role_arn", "tier") {
+		input := &repostspace.UpdateSpaceInput{
+			SpaceId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("tier") {
+			input.Tier = aws.String(d.Get("tier").(string))
+		}
+
+		_, err := conn.UpdateSpaceWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating re:Post Private Space (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSpaceRead(ctx, d, meta)...)
+}
+
+func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	log.Printf("[DEBUG] Deleting re:Post Private Space: %s", d.Id())
+	_, err := conn.DeleteSpaceWithContext(ctx, &repostspace.DeleteSpaceInput{
+		SpaceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, repostspace.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting re:Post Private Space (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSpaceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for re:Post Private Space (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSpaceByID(ctx context.Context, conn *repostspace.Repostspace, id string) (*repostspace.GetSpaceOutput, error) {
+	input := &repostspace.GetSpaceInput{
+		SpaceId: aws.String(id),
+	}
+
+	output, err := conn.GetSpaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, repostspace.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == spaceStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusSpace(ctx context.Context, conn *repostspace.Repostspace, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSpaceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitSpaceCreated(ctx context.Context, conn *repostspace.Repostspace, id string, timeout time.Duration) (*repostspace.GetSpaceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{spaceStatusCreating},
+		Target:  []string{spaceStatusCreated},
+		Refresh: statusSpace(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*repostspace.GetSpaceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSpaceDeleted(ctx context.Context, conn *repostspace.Repostspace, id string, timeout time.Duration) (*repostspace.GetSpaceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{spaceStatusCreated, spaceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSpace(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*repostspace.GetSpaceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
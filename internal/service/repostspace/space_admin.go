@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repostspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/repostspace"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	spaceAdminResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_repostspace_space_admin", name="Space Admin")
+func ResourceSpaceAdmin() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSpaceAdminCreate,
+		ReadWithoutTimeout:   resourceSpaceAdminRead,
+		DeleteWithoutTimeout: resourceSpaceAdminDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSpaceAdminCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	spaceID, adminID := d.Get("space_id").(string), d.Get("admin_id").(string)
+	id, err := flex.FlattenResourceId([]string{spaceID, adminID}, spaceAdminResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &repostspace.RegisterAdminInput{
+		AdminId: aws.String(adminID),
+		SpaceId: aws.String(spaceID),
+	}
+
+	_, err = conn.RegisterAdminWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating re:Post Private Space Admin (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSpaceAdminRead(ctx, d, meta)...)
+}
+
+func resourceSpaceAdminRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), spaceAdminResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	spaceID, adminID := parts[0], parts[1]
+	err = FindSpaceAdminByTwoPartKey(ctx, conn, spaceID, adminID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] re:Post Private Space Admin (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading re:Post Private Space Admin (%s): %s", d.Id(), err)
+	}
+
+	d.Set("admin_id", adminID)
+	d.Set("space_id", spaceID)
+
+	return diags
+}
+
+func resourceSpaceAdminDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RePostSpaceConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), spaceAdminResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting re:Post Private Space Admin: %s", d.Id())
+	_, err = conn.DeregisterAdminWithContext(ctx, &repostspace.DeregisterAdminInput{
+		AdminId: aws.String(parts[1]),
+		SpaceId: aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, repostspace.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting re:Post Private Space Admin (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// GetSpace returns both user and group administrators; there's no API to describe a single admin.
+func FindSpaceAdminByTwoPartKey(ctx context.Context, conn *repostspace.Repostspace, spaceID, adminID string) error {
+	space, err := FindSpaceByID(ctx, conn, spaceID)
+
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(aws.StringValueSlice(space.UserAdmins), adminID) && !slices.Contains(aws.StringValueSlice(space.GroupAdmins), adminID) {
+		return &retry.NotFoundError{}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repostspace_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/repostspace"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrepostspace "github.com/hashicorp/terraform-provider-aws/internal/service/repostspace"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRePostSpaceSpaceAdmin_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	subdomain := sdkacctest.RandString(20)
+	resourceName := "aws_repostspace_space_admin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, repostspace.EndpointsID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, repostspace.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpaceAdminDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpaceAdminConfig_basic(rName, subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpaceAdminExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "admin_id", "aws_identitystore_user.test", "user_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "space_id", "aws_repostspace_space.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSpaceAdminExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RePostSpaceConn(ctx)
+
+		return tfrepostspace.FindSpaceAdminByTwoPartKey(ctx, conn, rs.Primary.Attributes["space_id"], rs.Primary.Attributes["admin_id"])
+	}
+}
+
+func testAccCheckSpaceAdminDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RePostSpaceConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_repostspace_space_admin" {
+				continue
+			}
+
+			err := tfrepostspace.FindSpaceAdminByTwoPartKey(ctx, conn, rs.Primary.Attributes["space_id"], rs.Primary.Attributes["admin_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("re:Post Private Space Admin %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSpaceAdminConfig_basic(rName, subdomain string) string {
+	return acctest.ConfigCompose(testAccSpaceConfig_basic(rName, subdomain, "test", "BASIC"), fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_repostspace_space_admin" "test" {
+  space_id = aws_repostspace_space.test.id
+  admin_id = aws_identitystore_user.test.user_id
+}
+`, rName))
+}
@@ -6,6 +6,7 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
@@ -15,12 +16,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// notificationSettingConfiguredByService is the principal reported for default notification settings.
+	notificationSettingConfiguredByService = "rolesanywhere.amazonaws.com"
+)
+
 // @SDKResource("aws_rolesanywhere_trust_anchor", name="Trust Anchor")
 // @Tags(identifierAttribute="arn")
 func ResourceTrustAnchor() *schema.Resource {
@@ -48,6 +56,41 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      notificationSettingHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      types.NotificationChannelAll,
+							ValidateFunc: validation.StringInSlice(enum.Values[types.NotificationChannel](), false),
+						},
+						"configured_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enum.Values[types.NotificationEvent](), false),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			"source": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -101,6 +144,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -132,6 +179,10 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("enabled", trustAnchor.Enabled)
 	d.Set("name", trustAnchor.Name)
 
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchor.NotificationSettings)); err != nil {
+		return diag.Errorf("setting notification_settings: %s", err)
+	}
+
 	if err := d.Set("source", flattenSource(trustAnchor.Source)); err != nil {
 		return diag.Errorf("setting source: %s", err)
 	}
@@ -142,7 +193,7 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChanges("name", "source") {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get("name").(string)),
@@ -155,17 +206,49 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return diag.Errorf("updating RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
 		}
+	}
+
+	if d.HasChange("enabled") {
+		_, n := d.GetChange("enabled")
+		if n == true {
+			if err := enableTrustAnchor(ctx, d.Id(), meta); err != nil {
+				return diag.Errorf("enabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableTrustAnchor(ctx, d.Id(), meta); err != nil {
+				return diag.Errorf("disabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Settings that are no longer configured revert to the IAM Roles Anywhere defaults.
+		if keys := expandNotificationSettingKeys(os.Difference(ns).List()); len(keys) > 0 {
+			input := &rolesanywhere.ResetNotificationSettingsInput{
+				NotificationSettingKeys: keys,
+				TrustAnchorId:           aws.String(d.Id()),
+			}
+
+			_, err := conn.ResetNotificationSettings(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("resetting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
 
-		if d.HasChange("enabled") {
-			_, n := d.GetChange("enabled")
-			if n == true {
-				if err := enableTrustAnchor(ctx, d.Id(), meta); err != nil {
-					diag.Errorf("enabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
-				}
-			} else {
-				if err := disableTrustAnchor(ctx, d.Id(), meta); err != nil {
-					diag.Errorf("disabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
-				}
+		if ns.Len() > 0 {
+			input := &rolesanywhere.PutNotificationSettingsInput{
+				NotificationSettings: expandNotificationSettings(ns.List()),
+				TrustAnchorId:        aws.String(d.Id()),
+			}
+
+			_, err := conn.PutNotificationSettings(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
 			}
 		}
 	}
@@ -227,6 +310,79 @@ func flattenSourceData(apiObject types.SourceData) []interface{} {
 	return []interface{}{m}
 }
 
+// notificationSettingHash identifies a notification setting by its event and channel, as the API does.
+func notificationSettingHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s-%s", tfMap["event"].(string), tfMap["channel"].(string)))
+}
+
+func flattenNotificationSettingDetails(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Only settings that have been customized are reported; the rest are IAM Roles Anywhere defaults.
+		if aws.StringValue(apiObject.ConfiguredBy) == notificationSettingConfiguredByService {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":       string(apiObject.Channel),
+			"configured_by": aws.StringValue(apiObject.ConfiguredBy),
+			"enabled":       aws.BoolValue(apiObject.Enabled),
+			"event":         string(apiObject.Event),
+			"threshold":     int(aws.Int32Value(apiObject.Threshold)),
+		})
+	}
+
+	return tfList
+}
+
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Channel: types.NotificationChannel(tfMap["channel"].(string)),
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNotificationSettingKeys(tfList []interface{}) []types.NotificationSettingKey {
+	var apiObjects []types.NotificationSettingKey
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.NotificationSettingKey{
+			Channel: types.NotificationChannel(tfMap["channel"].(string)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandSource(tfList []interface{}) *types.Source {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
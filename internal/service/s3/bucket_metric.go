@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+var metricsFilterAtLeastOneOfKeys = []string{"filter.0.access_point", "filter.0.prefix", "filter.0.tags"}
+
 // @SDKResource("aws_s3_bucket_metric")
 func ResourceBucketMetric() *schema.Resource {
 	return &schema.Resource{
@@ -45,16 +48,22 @@ func ResourceBucketMetric() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"access_point": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validMetricsFilterAccessPointARN,
+							AtLeastOneOf: metricsFilterAtLeastOneOfKeys,
+						},
 						"prefix": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							AtLeastOneOf: filterAtLeastOneOfKeys,
+							AtLeastOneOf: metricsFilterAtLeastOneOfKeys,
 						},
 						"tags": {
 							Type:         schema.TypeMap,
 							Optional:     true,
 							Elem:         &schema.Schema{Type: schema.TypeString},
-							AtLeastOneOf: filterAtLeastOneOfKeys,
+							AtLeastOneOf: metricsFilterAtLeastOneOfKeys,
 						},
 					},
 				},
@@ -202,6 +211,11 @@ func resourceBucketMetricRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func ExpandMetricsFilter(ctx context.Context, m map[string]interface{}) *s3.MetricsFilter {
+	var accessPoint string
+	if v, ok := m["access_point"]; ok {
+		accessPoint = v.(string)
+	}
+
 	var prefix string
 	if v, ok := m["prefix"]; ok {
 		prefix = v.(string)
@@ -212,16 +226,29 @@ func ExpandMetricsFilter(ctx context.Context, m map[string]interface{}) *s3.Metr
 		tags = Tags(tftags.New(ctx, v).IgnoreAWS())
 	}
 
+	// A filter with more than one predicate must be expressed as a conjunction.
+	predicates := len(tags)
+	if accessPoint != "" {
+		predicates++
+	}
+	if prefix != "" {
+		predicates++
+	}
+
 	metricsFilter := &s3.MetricsFilter{}
-	if prefix != "" && len(tags) > 0 {
-		metricsFilter.And = &s3.MetricsAndOperator{
-			Prefix: aws.String(prefix),
-			Tags:   tags,
+	if predicates > 1 {
+		metricsFilter.And = &s3.MetricsAndOperator{}
+		if accessPoint != "" {
+			metricsFilter.And.AccessPointArn = aws.String(accessPoint)
+		}
+		if prefix != "" {
+			metricsFilter.And.Prefix = aws.String(prefix)
 		}
-	} else if len(tags) > 1 {
-		metricsFilter.And = &s3.MetricsAndOperator{
-			Tags: tags,
+		if len(tags) > 0 {
+			metricsFilter.And.Tags = tags
 		}
+	} else if accessPoint != "" {
+		metricsFilter.AccessPointArn = aws.String(accessPoint)
 	} else if len(tags) == 1 {
 		metricsFilter.Tag = tags[0]
 	} else {
@@ -234,12 +261,17 @@ func FlattenMetricsFilter(ctx context.Context, metricsFilter *s3.MetricsFilter)
 	m := make(map[string]interface{})
 
 	if and := metricsFilter.And; and != nil {
+		if and.AccessPointArn != nil {
+			m["access_point"] = aws.StringValue(and.AccessPointArn)
+		}
 		if and.Prefix != nil {
 			m["prefix"] = aws.StringValue(and.Prefix)
 		}
 		if and.Tags != nil {
 			m["tags"] = KeyValueTags(ctx, and.Tags).IgnoreAWS().Map()
 		}
+	} else if metricsFilter.AccessPointArn != nil {
+		m["access_point"] = aws.StringValue(metricsFilter.AccessPointArn)
 	} else if metricsFilter.Prefix != nil {
 		m["prefix"] = aws.StringValue(metricsFilter.Prefix)
 	} else if metricsFilter.Tag != nil {
@@ -251,6 +283,25 @@ func FlattenMetricsFilter(ctx context.Context, metricsFilter *s3.MetricsFilter)
 	return m
 }
 
+// validMetricsFilterAccessPointARN validates that a metrics filter access point is a single-Region S3 access point.
+// Multi-Region and Object Lambda access points are not supported in metrics filters.
+func validMetricsFilterAccessPointARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != s3.ServiceName || parsedARN.Region == "" || parsedARN.AccountID == "" || !strings.HasPrefix(parsedARN.Resource, "accesspoint/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of a single-Region S3 access point", k, value))
+	}
+
+	return
+}
+
 func BucketMetricParseID(id string) (string, string, error) {
 	idParts := strings.Split(id, ":")
 	if len(idParts) != 2 {
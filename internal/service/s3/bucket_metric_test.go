@@ -119,6 +119,45 @@ func TestExpandMetricsFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+				"prefix":       "prefix/",
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+					Prefix:         aws.String("prefix/"),
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -235,6 +274,26 @@ func TestFlattenMetricsFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			S3MetricsFilter: &s3.MetricsFilter{
+				AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+			},
+			ExpectedConfig: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+			},
+		},
+		{
+			S3MetricsFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					AccessPointArn: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test"),
+					Prefix:         aws.String("prefix/"),
+				},
+			},
+			ExpectedConfig: map[string]interface{}{
+				"access_point": "arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+				"prefix":       "prefix/",
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -246,6 +305,35 @@ func TestFlattenMetricsFilter(t *testing.T) {
 	}
 }
 
+func TestValidMetricsFilterAccessPointARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/test",
+		"arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/test-ap",
+	}
+	for _, v := range validARNs {
+		_, errors := tfs3.ValidMetricsFilterAccessPointARN(v, "access_point")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid metrics filter access point ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"test",
+		"arn:aws:s3:::bucket",
+		"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+		"arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/test",
+		"arn:aws:sqs:us-west-2:123456789012:accesspoint/test",
+	}
+	for _, v := range invalidARNs {
+		_, errors := tfs3.ValidMetricsFilterAccessPointARN(v, "access_point")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid metrics filter access point ARN", v)
+		}
+	}
+}
+
 func TestBucketMetricParseID(t *testing.T) {
 	t.Parallel()
 
@@ -483,6 +571,71 @@ func TestAccS3BucketMetric_withFilterPrefixAndSingleTag(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketMetric_withFilterAccessPointAndPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf s3.MetricsConfiguration
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_s3_bucket_metric.test"
+	accessPointResourceName := "aws_s3_access_point.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	metricName := t.Name()
+	prefix := fmt.Sprintf("prefix-%d/", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetricDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetricConfig_filterAccessPoint(bucketName, metricName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetricsExistsConfig(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter.0.access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", ""),
+				),
+			},
+			{
+				Config: testAccBucketMetricConfig_filterAccessPointAndPrefix(bucketName, metricName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetricsExistsConfig(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter.0.access_point", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefix),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetric_withFilterMultiRegionAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	rInt := sdkacctest.RandInt()
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	metricName := t.Name()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetricDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketMetricConfig_filterMultiRegionAccessPoint(bucketName, metricName),
+				ExpectError: regexache.MustCompile(`must be the ARN of a single-Region S3 access point`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketMetric_withFilterMultipleTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf s3.MetricsConfiguration
@@ -729,6 +882,62 @@ resource "aws_s3_bucket_metric" "test" {
 `, metricName, prefix, tag))
 }
 
+func testAccBucketMetricConfig_filterAccessPoint(bucketName, metricName string) string {
+	return acctest.ConfigCompose(
+		testAccBucketMetricsBucketConfig(bucketName),
+		fmt.Sprintf(`
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+}
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[2]q
+
+  filter {
+    access_point = aws_s3_access_point.test.arn
+  }
+}
+`, bucketName, metricName))
+}
+
+func testAccBucketMetricConfig_filterAccessPointAndPrefix(bucketName, metricName, prefix string) string {
+	return acctest.ConfigCompose(
+		testAccBucketMetricsBucketConfig(bucketName),
+		fmt.Sprintf(`
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+}
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[2]q
+
+  filter {
+    access_point = aws_s3_access_point.test.arn
+    prefix       = %[3]q
+  }
+}
+`, bucketName, metricName, prefix))
+}
+
+func testAccBucketMetricConfig_filterMultiRegionAccessPoint(bucketName, metricName string) string {
+	return acctest.ConfigCompose(
+		testAccBucketMetricsBucketConfig(bucketName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+
+  filter {
+    access_point = "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"
+  }
+}
+`, metricName))
+}
+
 func testAccBucketMetricConfig_filterMultipleTags(bucketName, metricName, tag1, tag2 string) string {
 	return acctest.ConfigCompose(
 		testAccBucketMetricsBucketConfig(bucketName),
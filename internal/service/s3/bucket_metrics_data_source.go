@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_s3_bucket_metrics")
+func DataSourceBucketMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_point": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tags": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBucketMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+
+	metricsConfigurations, err := findBucketMetricsConfigurations(ctx, conn, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing S3 Bucket (%s) Metrics Configurations: %s", bucket, err)
+	}
+
+	tfList := make([]interface{}, 0, len(metricsConfigurations))
+	for _, v := range metricsConfigurations {
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(v.Id),
+		}
+
+		if v.Filter != nil {
+			tfMap["filter"] = []interface{}{FlattenMetricsFilter(ctx, v.Filter)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(bucket)
+	if err := d.Set("metric_configuration", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func findBucketMetricsConfigurations(ctx context.Context, conn *s3.S3, bucket string) ([]*s3.MetricsConfiguration, error) {
+	input := &s3.ListBucketMetricsConfigurationsInput{
+		Bucket: aws.String(bucket),
+	}
+	var output []*s3.MetricsConfiguration
+
+	for {
+		page, err := conn.ListBucketMetricsConfigurationsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricsConfigurationList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return output, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketMetricsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_metrics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetricsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metric_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "metric_configuration.*", map[string]string{
+						"name":     "EntireBucket",
+						"filter.#": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "metric_configuration.*", map[string]string{
+						"name":            "Documents",
+						"filter.#":        "1",
+						"filter.0.prefix": "documents/",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketMetricsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_metric" "entire_bucket" {
+  bucket = aws_s3_bucket.test.id
+  name   = "EntireBucket"
+}
+
+resource "aws_s3_bucket_metric" "documents" {
+  bucket = aws_s3_bucket.test.id
+  name   = "Documents"
+
+  filter {
+    prefix = "documents/"
+  }
+}
+
+data "aws_s3_bucket_metrics" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [aws_s3_bucket_metric.entire_bucket, aws_s3_bucket_metric.documents]
+}
+`, rName)
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	bucketNotificationVerificationKeyPrefix = "terraform-notification-verification/"
+)
+
+// @SDKDataSource("aws_s3_bucket_notification_verification")
+func DataSourceBucketNotificationVerification() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketNotificationVerificationRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"delivery_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(1, 900),
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"lambda_function_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"queue": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivered": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"queue_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"topic_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceBucketNotificationVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+	sqsConn := meta.(*conns.AWSClient).SQSConn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	if key == "" {
+		key = bucketNotificationVerificationKeyPrefix + id.UniqueId()
+	}
+
+	notificationConfigs, err := conn.GetBucketNotificationConfigurationWithContext(ctx, &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification Configuration (%s): %s", bucket, err)
+	}
+
+	var queueARNs []string
+	for _, v := range notificationConfigs.QueueConfigurations {
+		if v == nil || !notificationMatchesObjectCreated(aws.StringValueSlice(v.Events), v.Filter, key) {
+			continue
+		}
+
+		queueARNs = append(queueARNs, aws.StringValue(v.QueueArn))
+	}
+
+	var topicARNs []string
+	for _, v := range notificationConfigs.TopicConfigurations {
+		if v == nil || !notificationMatchesObjectCreated(aws.StringValueSlice(v.Events), v.Filter, key) {
+			continue
+		}
+
+		topicARNs = append(topicARNs, aws.StringValue(v.TopicArn))
+	}
+
+	var lambdaFunctionARNs []string
+	for _, v := range notificationConfigs.LambdaFunctionConfigurations {
+		if v == nil || !notificationMatchesObjectCreated(aws.StringValueSlice(v.Events), v.Filter, key) {
+			continue
+		}
+
+		lambdaFunctionARNs = append(lambdaFunctionARNs, aws.StringValue(v.LambdaFunctionArn))
+	}
+
+	output, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   strings.NewReader(""),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	// Remove the marker object, including its version in versioned buckets, whatever the outcome of the verification.
+	defer func() {
+		input := &s3.DeleteObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: output.VersionId,
+		}
+
+		if _, err := conn.DeleteObjectWithContext(ctx, input); err != nil {
+			log.Printf("[WARN] Deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+	}()
+
+	deadline := time.Now().Add(time.Duration(d.Get("delivery_timeout").(int)) * time.Second)
+	queues := make([]interface{}, 0, len(queueARNs))
+
+	for _, queueARN := range queueARNs {
+		delivered, err := waitBucketNotificationDelivered(ctx, sqsConn, queueARN, bucket, key, deadline)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Bucket (%s) notification delivery to SQS Queue (%s): %s", bucket, queueARN, err)
+		}
+
+		if !delivered {
+			diags = sdkdiag.AppendErrorf(diags, "S3 Bucket (%s) Object (%s) event was not delivered to SQS Queue (%s) within %d seconds", bucket, key, queueARN, d.Get("delivery_timeout").(int))
+		}
+
+		queues = append(queues, map[string]interface{}{
+			"delivered": delivered,
+			"queue_arn": queueARN,
+		})
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, key))
+	d.Set("key", key)
+	d.Set("lambda_function_arns", lambdaFunctionARNs)
+	if err := d.Set("queue", queues); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting queue: %s", err)
+	}
+	d.Set("topic_arns", topicARNs)
+
+	return diags
+}
+
+// notificationMatchesObjectCreated returns whether a notification configuration fires for a PUT of the specified key.
+func notificationMatchesObjectCreated(events []string, filter *s3.NotificationConfigurationFilter, key string) bool {
+	var created bool
+	for _, event := range events {
+		if event == s3.EventS3ObjectCreated || event == s3.EventS3ObjectCreatedPut {
+			created = true
+			break
+		}
+	}
+
+	if !created {
+		return false
+	}
+
+	if filter == nil || filter.Key == nil {
+		return true
+	}
+
+	for _, rule := range filter.Key.FilterRules {
+		if rule == nil {
+			continue
+		}
+
+		switch value := aws.StringValue(rule.Value); strings.ToLower(aws.StringValue(rule.Name)) {
+		case s3.FilterRuleNamePrefix:
+			if !strings.HasPrefix(key, value) {
+				return false
+			}
+		case s3.FilterRuleNameSuffix:
+			if !strings.HasSuffix(key, value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// waitBucketNotificationDelivered polls the SQS queue until it receives the event for the specified object or the deadline passes.
+// Unrelated messages are made visible again immediately so that other consumers of the queue are not delayed.
+func waitBucketNotificationDelivered(ctx context.Context, conn *sqs.SQS, queueARN, bucket, key string, deadline time.Time) (bool, error) {
+	parsedARN, err := arn.Parse(queueARN)
+
+	if err != nil {
+		return false, err
+	}
+
+	queueURL, err := conn.GetQueueUrlWithContext(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              aws.String(parsedARN.Resource),
+		QueueOwnerAWSAccountId: aws.String(parsedARN.AccountID),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("reading SQS Queue URL: %w", err)
+	}
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false, nil
+		}
+
+		waitTime := int64(remaining / time.Second)
+		if waitTime > 20 {
+			waitTime = 20
+		}
+
+		output, err := conn.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+			MaxNumberOfMessages: aws.Int64(10),
+			QueueUrl:            queueURL.QueueUrl,
+			WaitTimeSeconds:     aws.Int64(waitTime),
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("receiving SQS messages: %w", err)
+		}
+
+		var delivered bool
+		for _, message := range output.Messages {
+			if bucketNotificationMessageMatches(aws.StringValue(message.Body), bucket, key) {
+				delivered = true
+
+				if _, err := conn.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
+					QueueUrl:      queueURL.QueueUrl,
+					ReceiptHandle: message.ReceiptHandle,
+				}); err != nil {
+					return false, fmt.Errorf("deleting SQS message: %w", err)
+				}
+
+				continue
+			}
+
+			if _, err := conn.ChangeMessageVisibilityWithContext(ctx, &sqs.ChangeMessageVisibilityInput{
+				QueueUrl:          queueURL.QueueUrl,
+				ReceiptHandle:     message.ReceiptHandle,
+				VisibilityTimeout: aws.Int64(0),
+			}); err != nil {
+				log.Printf("[WARN] Releasing SQS message (%s): %s", aws.StringValue(message.MessageId), err)
+			}
+		}
+
+		if delivered {
+			return true, nil
+		}
+	}
+}
+
+type bucketNotificationEvent struct {
+	Records []struct {
+		S3 struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key string `json:"key"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// bucketNotificationMessageMatches returns whether an SQS message body is an S3 event for the specified object.
+// Object keys in S3 event notifications are URL encoded.
+func bucketNotificationMessageMatches(body, bucket, key string) bool {
+	var event bucketNotificationEvent
+
+	if err := json.Unmarshal([]byte(body), &event); err != nil {
+		return false
+	}
+
+	for _, record := range event.Records {
+		if record.S3.Bucket.Name != bucket {
+			continue
+		}
+
+		if v, err := url.QueryUnescape(record.S3.Object.Key); err == nil && v == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketNotificationVerificationDataSource_queue(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_notification_verification.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationVerificationDataSourceConfig_queue(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key", "tf-acc-test/marker.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "lambda_function_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "queue.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "queue.0.delivered", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "queue.0.queue_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "topic_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketNotificationVerificationDataSourceConfig_queue(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "s3.amazonaws.com" }
+      Action    = "sqs:SendMessage"
+      Resource  = "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s"
+      Condition = {
+        ArnEquals = {
+          "aws:SourceArn" = aws_s3_bucket.test.arn
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_notification" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  queue {
+    queue_arn     = aws_sqs_queue.test.arn
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "tf-acc-test/"
+  }
+}
+
+data "aws_s3_bucket_notification_verification" "test" {
+  bucket           = aws_s3_bucket_notification.test.bucket
+  key              = "tf-acc-test/marker.txt"
+  delivery_timeout = 120
+}
+`, rName)
+}
@@ -5,7 +5,9 @@ package s3
 
 // Exports for use in tests only.
 var (
-	DeleteAllObjectVersions  = deleteAllObjectVersions
-	FindObjectByBucketAndKey = findObjectByBucketAndKey
-	SDKv1CompatibleCleanKey  = sdkv1CompatibleCleanKey
+	DeleteAllObjectVersions          = deleteAllObjectVersions
+	FindObjectByBucketAndKey         = findObjectByBucketAndKey
+	ObjectComplianceRetainUntil      = objectComplianceRetainUntil
+	SDKv1CompatibleCleanKey          = sdkv1CompatibleCleanKey
+	ValidMetricsFilterAccessPointARN = validMetricsFilterAccessPointARN
 )
@@ -195,6 +195,11 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"skip_destroy_when_compliance_locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"storage_class": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -281,6 +286,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	if !d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		return append(diags, resourceObjectUpdateTagsOnly(ctx, d, meta)...)
+	}
+
 	if hasObjectContentChanges(d) {
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
@@ -358,6 +367,25 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// resourceObjectUpdateTagsOnly handles updates where only tags have changed.
+// The object itself is unchanged, so the HeadObject and default KMS key lookups done by Read are skipped.
+func resourceObjectUpdateTagsOnly(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
+	o, n := d.GetChange(names.AttrTagsAll)
+
+	if err := ObjectUpdateTags(ctx, conn, bucket, key, o, n); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating tags: %s", err)
+	}
+
+	setTagsOut(ctx, Tags(tftags.New(ctx, n)))
+
+	return diags
+}
+
 func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
@@ -365,6 +393,15 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	bucket := d.Get("bucket").(string)
 	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 
+	if retainUntil, ok := objectComplianceRetainUntil(d.Get("object_lock_mode").(string), d.Get("object_lock_retain_until_date").(string), time.Now()); ok {
+		if d.Get("skip_destroy_when_compliance_locked").(bool) {
+			log.Printf("[WARN] S3 Bucket (%s) Object (%s) is under COMPLIANCE mode retention until %s, removing from state", bucket, key, retainUntil)
+			return sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) Object (%s) is under COMPLIANCE mode retention until %s and has been removed from state without being deleted", bucket, key, retainUntil)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): object is under COMPLIANCE mode retention until %s; set skip_destroy_when_compliance_locked to remove it from state without deleting it", bucket, key, retainUntil)
+	}
+
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
 		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false)
@@ -394,6 +431,7 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 	d.SetId(key)
 	d.Set("bucket", bucket)
 	d.Set("key", key)
+	d.Set("skip_destroy_when_compliance_locked", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -565,7 +603,23 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 	return
 }
 
-func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+func resourceObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceObjectCustomizeDiffBucketOwnerEnforced(ctx, d, meta); err != nil {
+		return err
+	}
+
+	// Replacing an object deletes the existing one, which S3 refuses while it is under COMPLIANCE mode retention.
+	// Destroy-only plans never reach CustomizeDiff, so resourceObjectDelete performs the same check.
+	if d.Id() != "" && d.HasChanges("bucket", "key") && !d.Get("skip_destroy_when_compliance_locked").(bool) {
+		mode, _ := d.GetChange("object_lock_mode")
+		retainUntilDate, _ := d.GetChange("object_lock_retain_until_date")
+
+		if retainUntil, ok := objectComplianceRetainUntil(mode.(string), retainUntilDate.(string), time.Now()); ok {
+			o, _ := d.GetChange("key")
+			return fmt.Errorf("S3 Object (%s) cannot be replaced: it is under COMPLIANCE mode retention until %s; set skip_destroy_when_compliance_locked to remove it from state without deleting it", o, retainUntil)
+		}
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -578,6 +632,46 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// resourceObjectCustomizeDiffBucketOwnerEnforced reports at plan time an ACL that the target bucket would reject
+// with AccessControlListNotSupported because its Object Ownership is BucketOwnerEnforced.
+func resourceObjectCustomizeDiffBucketOwnerEnforced(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.GetRawConfig().GetAttr("acl").IsNull() || !d.NewValueKnown("acl") || !d.NewValueKnown("bucket") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("acl", "bucket") {
+		return nil
+	}
+
+	// Buckets with ACLs disabled still accept the bucket-owner-full-control canned ACL.
+	acl := types.ObjectCannedACL(d.Get("acl").(string))
+	if acl == types.ObjectCannedACLBucketOwnerFullControl {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	bucket := d.Get("bucket").(string)
+
+	ownership, err := findBucketObjectOwnership(ctx, conn, bucket)
+
+	// The bucket may not exist yet or may have no ownership controls.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	// The ownership controls may not be readable, e.g. without s3:GetBucketOwnershipControls; leave any error to apply time.
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) Ownership Controls during plan, skipping ACL check: %s", bucket, err)
+		return nil
+	}
+
+	if ownership == types.ObjectOwnershipBucketOwnerEnforced {
+		return fmt.Errorf("acl (%s) cannot be set on objects in S3 Bucket (%s): the bucket's Object Ownership is %s, which disables ACLs. Remove acl or set it to %s", acl, bucket, ownership, types.ObjectCannedACLBucketOwnerFullControl)
+	}
+
+	return nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
@@ -605,6 +699,21 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// objectComplianceRetainUntil returns the retain until date of an object that is under unexpired COMPLIANCE mode retention.
+func objectComplianceRetainUntil(mode, retainUntilDate string, now time.Time) (string, bool) {
+	if types.ObjectLockMode(mode) != types.ObjectLockModeCompliance {
+		return "", false
+	}
+
+	t, err := time.Parse(time.RFC3339, retainUntilDate)
+
+	if err != nil || !t.After(now) {
+		return "", false
+	}
+
+	return retainUntilDate, true
+}
+
 func findObjectByBucketAndKey(ctx context.Context, conn *s3.Client, bucket, key, etag, checksumAlgorithm string) (*s3.HeadObjectOutput, error) {
 	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
@@ -641,6 +750,31 @@ func findObject(ctx context.Context, conn *s3.Client, input *s3.HeadObjectInput)
 	return output, nil
 }
 
+func findBucketObjectOwnership(ctx context.Context, conn *s3.Client, bucket string) (types.ObjectOwnership, error) {
+	input := &s3.GetBucketOwnershipControlsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketOwnershipControls(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeOwnershipControlsNotFoundError) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.OwnershipControls == nil || len(output.OwnershipControls.Rules) == 0 {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OwnershipControls.Rules[0].ObjectOwnership, nil
+}
+
 // deleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
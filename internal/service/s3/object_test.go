@@ -14,12 +14,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -104,6 +106,59 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestObjectComplianceRetainUntil(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		mode            string
+		retainUntilDate string
+		want            string
+		wantOK          bool
+	}{
+		{
+			name: "no retention",
+		},
+		{
+			name:            "governance",
+			mode:            "GOVERNANCE",
+			retainUntilDate: "2024-02-01T00:00:00Z",
+		},
+		{
+			name:            "compliance expired",
+			mode:            "COMPLIANCE",
+			retainUntilDate: "2023-12-01T00:00:00Z",
+		},
+		{
+			name:            "compliance invalid date",
+			mode:            "COMPLIANCE",
+			retainUntilDate: "not-a-date",
+		},
+		{
+			name:            "compliance active",
+			mode:            "COMPLIANCE",
+			retainUntilDate: "2024-02-01T00:00:00Z",
+			want:            "2024-02-01T00:00:00Z",
+			wantOK:          true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := tfs3.ObjectComplianceRetainUntil(testCase.mode, testCase.retainUntilDate, now)
+
+			if got != testCase.want || ok != testCase.wantOK {
+				t.Errorf("ObjectComplianceRetainUntil(%q, %q) = %q, %t, want %q, %t", testCase.mode, testCase.retainUntilDate, got, ok, testCase.want, testCase.wantOK)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -648,6 +703,54 @@ func TestAccS3Object_kms(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_kmsTagsOnlyUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	kmsKeyResourceName := "aws_kms_key.kms_key_1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_kmsIDTags(rName, source, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectSSE(ctx, resourceName, "aws:kms"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_kmsIDTags(rName, source, "value2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectSSE(ctx, resourceName, "aws:kms"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_sse(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -734,6 +837,39 @@ func TestAccS3Object_acl(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_aclBucketOwnerEnforced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_bucketOwnerEnforced(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_bucketOwnerEnforced(rName, string(types.ObjectCannedACLPublicRead)),
+				ExpectError: regexache.MustCompile(`Object Ownership is BucketOwnerEnforced, which disables ACLs`),
+			},
+			{
+				Config: testAccObjectConfig_bucketOwnerEnforced(rName, string(types.ObjectCannedACLBucketOwnerFullControl)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "acl", string(types.ObjectCannedACLBucketOwnerFullControl)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_metadata(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1886,6 +2022,27 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_kmsIDTags(rName, source, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "kms_key_1" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket     = aws_s3_bucket.test.bucket
+  key        = "test-key"
+  source     = %[2]q
+  kms_key_id = aws_kms_key.kms_key_1.arn
+
+  tags = {
+    Key1 = %[3]q
+  }
+}
+`, rName, source, tagValue)
+}
+
 func testAccObjectConfig_sse(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1901,6 +2058,35 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_bucketOwnerEnforced(rName, acl string) string {
+	aclArgument := "null"
+	if acl != "" {
+		aclArgument = fmt.Sprintf("%q", acl)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+  rule {
+    object_ownership = "BucketOwnerEnforced"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  depends_on = [aws_s3_bucket_ownership_controls.test]
+
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = "some_bucket_content"
+  acl     = %[2]s
+}
+`, rName, aclArgument)
+}
+
 func testAccObjectConfig_acl(rName, content, acl string, blockPublicAccess bool) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -30,6 +30,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceBucket,
 			TypeName: "aws_s3_bucket",
 		},
+		{
+			Factory:  DataSourceBucketMetrics,
+			TypeName: "aws_s3_bucket_metrics",
+		},
+		{
+			Factory:  DataSourceBucketNotificationVerification,
+			TypeName: "aws_s3_bucket_notification_verification",
+		},
 		{
 			Factory:  DataSourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -224,6 +224,12 @@ func ResourceEndpointConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -320,10 +326,54 @@ func ResourceEndpointConfiguration() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(60, 3600),
 						},
+						"managed_instance_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.ManagedInstanceScalingStatus_Values(), false),
+									},
+								},
+							},
+						},
 						"model_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"routing_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
 							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"routing_strategy": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.RoutingStrategy_Values(), false),
+									},
+								},
+							},
 						},
 						"serverless_config": {
 							Type:     schema.TypeList,
@@ -443,11 +493,55 @@ func ResourceEndpointConfiguration() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(60, 3600),
 						},
+						"managed_instance_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.ManagedInstanceScalingStatus_Values(), false),
+									},
+								},
+							},
+						},
 						"model_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 						},
+						"routing_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"routing_strategy": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.RoutingStrategy_Values(), false),
+									},
+								},
+							},
+						},
 						"serverless_config": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -511,6 +605,10 @@ func resourceEndpointConfigurationCreate(ctx context.Context, d *schema.Resource
 		Tags:               getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		createOpts.ExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("kms_key_arn"); ok {
 		createOpts.KmsKeyId = aws.String(v.(string))
 	}
@@ -556,6 +654,7 @@ func resourceEndpointConfigurationRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("arn", endpointConfig.EndpointConfigArn)
 	d.Set("name", endpointConfig.EndpointConfigName)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(endpointConfig.EndpointConfigName)))
+	d.Set("execution_role_arn", endpointConfig.ExecutionRoleArn)
 	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
 
 	if err := d.Set("production_variants", flattenProductionVariants(endpointConfig.ProductionVariants)); err != nil {
@@ -613,8 +712,10 @@ func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionV
 	for _, lRaw := range configured {
 		data := lRaw.(map[string]interface{})
 
-		l := &sagemaker.ProductionVariant{
-			ModelName: aws.String(data["model_name"].(string)),
+		l := &sagemaker.ProductionVariant{}
+
+		if v, ok := data["model_name"].(string); ok && v != "" {
+			l.ModelName = aws.String(v)
 		}
 
 		if v, ok := data["initial_instance_count"].(int); ok && v > 0 {
@@ -663,6 +764,14 @@ func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionV
 			l.EnableSSMAccess = aws.Bool(v)
 		}
 
+		if v, ok := data["managed_instance_scaling"].([]interface{}); ok && len(v) > 0 {
+			l.ManagedInstanceScaling = expandProductionVariantManagedInstanceScaling(v)
+		}
+
+		if v, ok := data["routing_config"].([]interface{}); ok && len(v) > 0 {
+			l.RoutingConfig = expandProductionVariantRoutingConfig(v)
+		}
+
 		containers = append(containers, l)
 	}
 
@@ -712,11 +821,81 @@ func flattenProductionVariants(list []*sagemaker.ProductionVariant) []map[string
 			l["enable_ssm_access"] = aws.BoolValue(i.EnableSSMAccess)
 		}
 
+		if i.ManagedInstanceScaling != nil {
+			l["managed_instance_scaling"] = flattenProductionVariantManagedInstanceScaling(i.ManagedInstanceScaling)
+		}
+
+		if i.RoutingConfig != nil {
+			l["routing_config"] = flattenProductionVariantRoutingConfig(i.RoutingConfig)
+		}
+
 		result = append(result, l)
 	}
 	return result
 }
 
+func expandProductionVariantManagedInstanceScaling(configured []interface{}) *sagemaker.ProductionVariantManagedInstanceScaling {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	m := configured[0].(map[string]interface{})
+
+	c := &sagemaker.ProductionVariantManagedInstanceScaling{}
+
+	if v, ok := m["max_instance_count"].(int); ok && v > 0 {
+		c.MaxInstanceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["min_instance_count"].(int); ok && v > 0 {
+		c.MinInstanceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["status"].(string); ok && v != "" {
+		c.Status = aws.String(v)
+	}
+
+	return c
+}
+
+func flattenProductionVariantManagedInstanceScaling(config *sagemaker.ProductionVariantManagedInstanceScaling) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	cfg := map[string]interface{}{
+		"max_instance_count": aws.Int64Value(config.MaxInstanceCount),
+		"min_instance_count": aws.Int64Value(config.MinInstanceCount),
+		"status":             aws.StringValue(config.Status),
+	}
+
+	return []map[string]interface{}{cfg}
+}
+
+func expandProductionVariantRoutingConfig(configured []interface{}) *sagemaker.ProductionVariantRoutingConfig {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	m := configured[0].(map[string]interface{})
+
+	return &sagemaker.ProductionVariantRoutingConfig{
+		RoutingStrategy: aws.String(m["routing_strategy"].(string)),
+	}
+}
+
+func flattenProductionVariantRoutingConfig(config *sagemaker.ProductionVariantRoutingConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	cfg := map[string]interface{}{
+		"routing_strategy": aws.StringValue(config.RoutingStrategy),
+	}
+
+	return []map[string]interface{}{cfg}
+}
+
 func expandDataCaptureConfig(configured []interface{}) *sagemaker.DataCaptureConfig {
 	if len(configured) == 0 {
 		return nil
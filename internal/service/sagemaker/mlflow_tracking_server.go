@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_mlflow_tracking_server", name="MLflow Tracking Server")
+// @Tags(identifierAttribute="arn")
+func ResourceMlflowTrackingServer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMlflowTrackingServerCreate,
+		ReadWithoutTimeout:   resourceMlflowTrackingServerRead,
+		UpdateWithoutTimeout: resourceMlflowTrackingServerUpdate,
+		DeleteWithoutTimeout: resourceMlflowTrackingServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"artifact_store_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://`), "must be an Amazon S3 URI"),
+			},
+			"automatic_model_registration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"mlflow_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tracking_server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z](-*[0-9A-Za-z])*$`), "Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"tracking_server_size": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sagemaker.TrackingServerSizeSmall,
+				ValidateFunc: validation.StringInSlice(sagemaker.TrackingServerSize_Values(), false),
+			},
+			"tracking_server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weekly_maintenance_window_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(Mon|Tue|Wed|Thu|Fri|Sat|Sun):([01]\d|2[0-3]):([0-5]\d)$`), "must be in the format ddd:hh:mm, e.g. Tue:03:30"),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMlflowTrackingServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	name := d.Get("tracking_server_name").(string)
+	input := &sagemaker.CreateMlflowTrackingServerInput{
+		ArtifactStoreUri:           aws.String(d.Get("artifact_store_uri").(string)),
+		AutomaticModelRegistration: aws.Bool(d.Get("automatic_model_registration").(bool)),
+		RoleArn:                    aws.String(d.Get("role_arn").(string)),
+		Tags:                       getTagsIn(ctx),
+		TrackingServerName:         aws.String(name),
+		TrackingServerSize:         aws.String(d.Get("tracking_server_size").(string)),
+	}
+
+	if v, ok := d.GetOk("mlflow_version"); ok {
+		input.MlflowVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("weekly_maintenance_window_start"); ok {
+		input.WeeklyMaintenanceWindowStart = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateMlflowTrackingServerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker MLflow Tracking Server (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := WaitMlflowTrackingServerCreated(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker MLflow Tracking Server (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMlflowTrackingServerRead(ctx, d, meta)...)
+}
+
+func resourceMlflowTrackingServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	output, err := FindMlflowTrackingServerByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker MLflow Tracking Server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker MLflow Tracking Server (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TrackingServerArn)
+	d.Set("artifact_store_uri", output.ArtifactStoreUri)
+	d.Set("automatic_model_registration", output.AutomaticModelRegistration)
+	d.Set("mlflow_version", output.MlflowVersion)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("tracking_server_name", output.TrackingServerName)
+	d.Set("tracking_server_size", output.TrackingServerSize)
+	d.Set("tracking_server_url", output.TrackingServerUrl)
+	d.Set("weekly_maintenance_window_start", output.WeeklyMaintenanceWindowStart)
+
+	return diags
+}
+
+func resourceMlflowTrackingServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &sagemaker.UpdateMlflowTrackingServerInput{
+			TrackingServerName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("artifact_store_uri") {
+			input.ArtifactStoreUri = aws.String(d.Get("artifact_store_uri").(string))
+		}
+
+		if d.HasChange("automatic_model_registration") {
+			input.AutomaticModelRegistration = aws.Bool(d.Get("automatic_model_registration").(bool))
+		}
+
+		if d.HasChange("tracking_server_size") {
+			input.TrackingServerSize = aws.String(d.Get("tracking_server_size").(string))
+		}
+
+		if d.HasChange("weekly_maintenance_window_start") {
+			input.WeeklyMaintenanceWindowStart = aws.String(d.Get("weekly_maintenance_window_start").(string))
+		}
+
+		if _, err := conn.UpdateMlflowTrackingServerWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker MLflow Tracking Server (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitMlflowTrackingServerUpdated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker MLflow Tracking Server (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMlflowTrackingServerRead(ctx, d, meta)...)
+}
+
+func resourceMlflowTrackingServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	log.Printf("[INFO] Deleting SageMaker MLflow Tracking Server: %s", d.Id())
+	_, err := conn.DeleteMlflowTrackingServerWithContext(ctx, &sagemaker.DeleteMlflowTrackingServerInput{
+		TrackingServerName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker MLflow Tracking Server (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitMlflowTrackingServerDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker MLflow Tracking Server (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -156,6 +156,11 @@ func init() {
 		Name: "aws_sagemaker_pipeline",
 		F:    sweepPipelines,
 	})
+
+	resource.AddTestSweepers("aws_sagemaker_mlflow_tracking_server", &resource.Sweeper{
+		Name: "aws_sagemaker_mlflow_tracking_server",
+		F:    sweepMlflowTrackingServers,
+	})
 }
 
 func sweepAppImagesConfig(region string) error {
@@ -1038,3 +1043,43 @@ func sweepPipelines(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepMlflowTrackingServers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.SageMakerConn(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListMlflowTrackingServersPagesWithContext(ctx, &sagemaker.ListMlflowTrackingServersInput{}, func(page *sagemaker.ListMlflowTrackingServersOutput, lastPage bool) bool {
+		for _, trackingServer := range page.TrackingServerSummaries {
+			name := aws.StringValue(trackingServer.TrackingServerName)
+
+			r := ResourceMlflowTrackingServer()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker MLflow Tracking Server sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker MLflow Tracking Servers: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker MLflow Tracking Servers: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
@@ -0,0 +1,653 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// EventBridge Scheduler has no batch operations, so schedules are
+	// created, updated, read and deleted with this many concurrent requests.
+	schedulesConcurrency = 10
+)
+
+// @SDKResource("aws_scheduler_schedules", name="Schedules")
+func resourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchedulesCreate,
+		ReadWithoutTimeout:   resourceSchedulesRead,
+		UpdateWithoutTimeout: resourceSchedulesUpdate,
+		DeleteWithoutTimeout: resourceSchedulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSchedulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flexible_time_window": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_window_in_minutes": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 1440)),
+						},
+						"mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.FlexibleTimeWindowMode](),
+						},
+					},
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"kms_key_arn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+			},
+			"schedule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 512)),
+						},
+						"input": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+								validation.StringLenBetween(1, 64),
+								validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), `The name must consist of alphanumerics, hyphens, and underscores.`),
+							)),
+						},
+						"schedule_expression": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+						},
+					},
+				},
+			},
+			"schedule_arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule_expression_timezone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "UTC",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 50)),
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.ScheduleStateEnabled,
+				ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+						},
+						"dead_letter_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+									},
+								},
+							},
+						},
+						"retry_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum_event_age_in_seconds": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          86400,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(60, 86400)),
+									},
+									"maximum_retry_attempts": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          185,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 185)),
+									},
+								},
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								if k != "target.0.retry_policy.#" {
+									return false
+								}
+
+								return verify.SuppressMissingOptionalConfigurationBlock(k, old, new, d)
+							},
+						},
+						"role_arn": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: resourceSchedulesCustomizeDiff,
+	}
+}
+
+const (
+	ResNameSchedules = "Schedules"
+)
+
+// schedulesSharedKeys are the attributes applied to every schedule in the group.
+var schedulesSharedKeys = []string{
+	"flexible_time_window",
+	"kms_key_arn",
+	"schedule_expression_timezone",
+	"state",
+	"target",
+}
+
+func resourceSchedulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Get("group_name").(string)
+	entries := expandSchedulesEntries(d.Get("schedule").(*schema.Set).List())
+	shared := expandSchedulesShared(d)
+
+	err := forEachSchedulesEntry(ctx, entries, func(ctx context.Context, entry schedulesEntry) error {
+		return createSchedulesEntry(ctx, conn, shared, groupName, entry)
+	})
+
+	// Record the group so that any schedules that were created are tracked in state.
+	d.SetId(groupName)
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionCreating, ResNameSchedules, groupName, err)
+	}
+
+	return resourceSchedulesRead(ctx, d, meta)
+}
+
+func resourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+
+	if _, err := findScheduleGroupByName(ctx, conn, groupName); err != nil {
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] EventBridge Scheduler Schedules (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return create.DiagError(names.Scheduler, create.ErrActionReading, ResNameSchedules, d.Id(), err)
+	}
+
+	entries := expandSchedulesEntries(d.Get("schedule").(*schema.Set).List())
+
+	var mu sync.Mutex
+	outputs := make(map[string]*scheduler.GetScheduleOutput, len(entries))
+
+	err := forEachSchedulesEntry(ctx, entries, func(ctx context.Context, entry schedulesEntry) error {
+		out, err := findScheduleByTwoPartKey(ctx, conn, groupName, entry.name)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] EventBridge Scheduler Schedule (%s/%s) not found, removing from state", groupName, entry.name)
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		outputs[entry.name] = out
+
+		return nil
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionReading, ResNameSchedules, d.Id(), err)
+	}
+
+	if err := setSchedulesOutputs(ctx, d, outputs); err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionSetting, ResNameSchedules, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSchedulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	o, n := d.GetChange("schedule")
+	oldEntries := schedulesEntriesByName(expandSchedulesEntries(o.(*schema.Set).List()))
+	newEntries := schedulesEntriesByName(expandSchedulesEntries(n.(*schema.Set).List()))
+	shared := expandSchedulesShared(d)
+	sharedChanged := d.HasChanges(schedulesSharedKeys...)
+
+	var del, add, upd []schedulesEntry
+
+	for name, entry := range oldEntries {
+		if _, ok := newEntries[name]; !ok {
+			del = append(del, entry)
+		}
+	}
+
+	for name, entry := range newEntries {
+		if old, ok := oldEntries[name]; !ok {
+			add = append(add, entry)
+		} else if sharedChanged || old != entry {
+			upd = append(upd, entry)
+		}
+	}
+
+	if err := forEachSchedulesEntry(ctx, del, func(ctx context.Context, entry schedulesEntry) error {
+		return deleteSchedulesEntry(ctx, conn, groupName, entry.name)
+	}); err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	if err := forEachSchedulesEntry(ctx, upd, func(ctx context.Context, entry schedulesEntry) error {
+		return updateSchedulesEntry(ctx, conn, shared, groupName, entry)
+	}); err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	if err := forEachSchedulesEntry(ctx, add, func(ctx context.Context, entry schedulesEntry) error {
+		return createSchedulesEntry(ctx, conn, shared, groupName, entry)
+	}); err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionUpdating, ResNameSchedules, d.Id(), err)
+	}
+
+	return resourceSchedulesRead(ctx, d, meta)
+}
+
+func resourceSchedulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	entries := expandSchedulesEntries(d.Get("schedule").(*schema.Set).List())
+
+	log.Printf("[INFO] Deleting EventBridge Scheduler Schedules %s", d.Id())
+
+	err := forEachSchedulesEntry(ctx, entries, func(ctx context.Context, entry schedulesEntry) error {
+		return deleteSchedulesEntry(ctx, conn, groupName, entry.name)
+	})
+
+	if err != nil {
+		return create.DiagError(names.Scheduler, create.ErrActionDeleting, ResNameSchedules, d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceSchedulesImport imports every schedule in the group.
+func resourceSchedulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName := d.Id()
+	scheduleNames, err := findScheduleNamesByGroupName(ctx, conn, groupName)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing EventBridge Scheduler Schedules (%s): %w", groupName, err)
+	}
+
+	schedules := make([]interface{}, 0, len(scheduleNames))
+	for _, name := range scheduleNames {
+		schedules = append(schedules, schedulesEntry{name: name}.flatten())
+	}
+
+	d.Set("group_name", groupName)
+	if err := d.Set("schedule", schedules); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSchedulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	seen := make(map[string]bool)
+
+	for _, entry := range expandSchedulesEntries(diff.Get("schedule").(*schema.Set).List()) {
+		// Names are unknown when they are interpolated from values computed during apply.
+		if entry.name == "" {
+			continue
+		}
+
+		if seen[entry.name] {
+			return fmt.Errorf("schedule name (%s) must be unique", entry.name)
+		}
+
+		seen[entry.name] = true
+	}
+
+	return nil
+}
+
+type schedulesEntry struct {
+	description        string
+	input              string
+	name               string
+	scheduleExpression string
+}
+
+func (entry schedulesEntry) flatten() map[string]interface{} {
+	return map[string]interface{}{
+		"description":         entry.description,
+		"input":               entry.input,
+		"name":                entry.name,
+		"schedule_expression": entry.scheduleExpression,
+	}
+}
+
+func expandSchedulesEntries(tfList []interface{}) []schedulesEntry {
+	entries := make([]schedulesEntry, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, schedulesEntry{
+			description:        tfMap["description"].(string),
+			input:              tfMap["input"].(string),
+			name:               tfMap["name"].(string),
+			scheduleExpression: tfMap["schedule_expression"].(string),
+		})
+	}
+
+	return entries
+}
+
+func schedulesEntriesByName(entries []schedulesEntry) map[string]schedulesEntry {
+	m := make(map[string]schedulesEntry, len(entries))
+
+	for _, entry := range entries {
+		m[entry.name] = entry
+	}
+
+	return m
+}
+
+// schedulesShared holds the settings applied to every schedule in the group.
+// It is expanded once so that the resource data is not read concurrently.
+type schedulesShared struct {
+	flexibleTimeWindow *types.FlexibleTimeWindow
+	kmsKeyARN          *string
+	timezone           *string
+	state              types.ScheduleState
+	target             map[string]interface{}
+}
+
+func expandSchedulesShared(d *schema.ResourceData) schedulesShared {
+	shared := schedulesShared{
+		flexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})[0].(map[string]interface{})),
+		timezone:           aws.String(d.Get("schedule_expression_timezone").(string)),
+		state:              types.ScheduleState(d.Get("state").(string)),
+		target:             d.Get("target").([]interface{})[0].(map[string]interface{}),
+	}
+
+	if v, ok := d.Get("kms_key_arn").(string); ok && v != "" {
+		shared.kmsKeyARN = aws.String(v)
+	}
+
+	return shared
+}
+
+// expandTarget returns the shared target with the schedule's input.
+func (shared schedulesShared) expandTarget(ctx context.Context, entry schedulesEntry) *types.Target {
+	target := expandTarget(ctx, shared.target)
+
+	if entry.input != "" {
+		target.Input = aws.String(entry.input)
+	}
+
+	return target
+}
+
+func createSchedulesEntry(ctx context.Context, conn *scheduler.Client, shared schedulesShared, groupName string, entry schedulesEntry) error {
+	in := &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow:         shared.flexibleTimeWindow,
+		GroupName:                  aws.String(groupName),
+		KmsKeyArn:                  shared.kmsKeyARN,
+		Name:                       aws.String(entry.name),
+		ScheduleExpression:         aws.String(entry.scheduleExpression),
+		ScheduleExpressionTimezone: shared.timezone,
+		State:                      shared.state,
+		Target:                     shared.expandTarget(ctx, entry),
+	}
+
+	if entry.description != "" {
+		in.Description = aws.String(entry.description)
+	}
+
+	_, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
+		return conn.CreateSchedule(ctx, in)
+	})
+
+	if err != nil {
+		return fmt.Errorf("creating schedule (%s): %w", entry.name, err)
+	}
+
+	return nil
+}
+
+func updateSchedulesEntry(ctx context.Context, conn *scheduler.Client, shared schedulesShared, groupName string, entry schedulesEntry) error {
+	in := &scheduler.UpdateScheduleInput{
+		FlexibleTimeWindow:         shared.flexibleTimeWindow,
+		GroupName:                  aws.String(groupName),
+		KmsKeyArn:                  shared.kmsKeyARN,
+		Name:                       aws.String(entry.name),
+		ScheduleExpression:         aws.String(entry.scheduleExpression),
+		ScheduleExpressionTimezone: shared.timezone,
+		State:                      shared.state,
+		Target:                     shared.expandTarget(ctx, entry),
+	}
+
+	if entry.description != "" {
+		in.Description = aws.String(entry.description)
+	}
+
+	_, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.UpdateScheduleOutput, error) {
+		return conn.UpdateSchedule(ctx, in)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating schedule (%s): %w", entry.name, err)
+	}
+
+	return nil
+}
+
+func deleteSchedulesEntry(ctx context.Context, conn *scheduler.Client, groupName, name string) error {
+	_, err := conn.DeleteSchedule(ctx, &scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting schedule (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// setSchedulesOutputs sets the schedules that were found. The shared attributes
+// are read from the first schedule by name, as they are the same for every schedule.
+func setSchedulesOutputs(ctx context.Context, d *schema.ResourceData, outputs map[string]*scheduler.GetScheduleOutput) error {
+	scheduleNames := make([]string, 0, len(outputs))
+	for name := range outputs {
+		scheduleNames = append(scheduleNames, name)
+	}
+	sort.Strings(scheduleNames)
+
+	arns := make(map[string]string, len(scheduleNames))
+	schedules := make([]interface{}, 0, len(scheduleNames))
+
+	for _, name := range scheduleNames {
+		out := outputs[name]
+		entry := schedulesEntry{
+			description:        aws.ToString(out.Description),
+			name:               name,
+			scheduleExpression: aws.ToString(out.ScheduleExpression),
+		}
+
+		if out.Target != nil {
+			entry.input = aws.ToString(out.Target.Input)
+		}
+
+		arns[name] = aws.ToString(out.Arn)
+		schedules = append(schedules, entry.flatten())
+	}
+
+	if len(scheduleNames) > 0 {
+		out := outputs[scheduleNames[0]]
+
+		if err := d.Set("flexible_time_window", []interface{}{flattenFlexibleTimeWindow(out.FlexibleTimeWindow)}); err != nil {
+			return err
+		}
+
+		d.Set("kms_key_arn", out.KmsKeyArn)
+		d.Set("schedule_expression_timezone", out.ScheduleExpressionTimezone)
+		d.Set("state", string(out.State))
+
+		// Only the attributes in the shared target schema are kept; input is set per schedule.
+		target := flattenTarget(ctx, out.Target)
+		for _, k := range []string{"ecs_parameters", "eventbridge_parameters", "input", "kinesis_parameters", "sagemaker_pipeline_parameters", "sqs_parameters"} {
+			delete(target, k)
+		}
+
+		if err := d.Set("target", []interface{}{target}); err != nil {
+			return err
+		}
+	}
+
+	d.Set("group_name", d.Id())
+	if err := d.Set("schedule", schedules); err != nil {
+		return err
+	}
+	d.Set("schedule_arns", arns)
+
+	return nil
+}
+
+// forEachSchedulesEntry calls f for each entry with bounded concurrency and returns all errors.
+func forEachSchedulesEntry(ctx context.Context, entries []schedulesEntry, f func(context.Context, schedulesEntry) error) error {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errList []error
+	)
+	sem := make(chan struct{}, schedulesConcurrency)
+
+	for _, entry := range entries {
+		entry := entry
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := f(ctx, entry); err != nil {
+				mu.Lock()
+				errList = append(errList, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errList...)
+}
+
+func findScheduleNamesByGroupName(ctx context.Context, conn *scheduler.Client, groupName string) ([]string, error) {
+	var names []string
+
+	paginator := scheduler.NewListSchedulesPaginator(conn, &scheduler.ListSchedulesInput{
+		GroupName: aws.String(groupName),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Schedules {
+			names = append(names, aws.ToString(v.Name))
+		}
+	}
+
+	return names, nil
+}
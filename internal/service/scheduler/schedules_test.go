@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_scheduler_schedule_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                "nightly",
+						"schedule_expression": "cron(0 2 * * ? *)",
+						"input":               `{"job":"nightly"}`,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                "hourly",
+						"schedule_expression": "rate(1 hour)",
+						"description":         "Hourly job",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "schedule_arns.%", "2"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "schedule_arns.nightly", "scheduler", regexache.MustCompile(`schedule/`+name+`/nightly$`)),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchedulesConfig_updated(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExist(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "FLEXIBLE"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                "nightly",
+						"schedule_expression": "cron(30 3 * * ? *)",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schedule.*", map[string]string{
+						"name":                "weekly",
+						"schedule_expression": "cron(0 4 ? * MON *)",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "schedule_arns.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "schedule_arns.hourly"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "Europe/Amsterdam"),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedules_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSchedulesConfig_duplicateName(name),
+				ExpectError: regexache.MustCompile(`schedule name \(nightly\) must be unique`),
+			},
+		},
+	})
+}
+
+// testAccScheduleNamesFromState returns the names of the schedules recorded in the schedule_arns attribute.
+func testAccScheduleNamesFromState(rs *terraform.ResourceState) []string {
+	var scheduleNames []string
+
+	for k := range rs.Primary.Attributes {
+		if name, ok := strings.CutPrefix(k, "schedule_arns."); ok && name != "%" {
+			scheduleNames = append(scheduleNames, name)
+		}
+	}
+
+	return scheduleNames
+}
+
+func testAccCheckSchedulesDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(t).SchedulerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_scheduler_schedules" {
+				continue
+			}
+
+			for _, scheduleName := range testAccScheduleNamesFromState(rs) {
+				_, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, rs.Primary.ID, scheduleName)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("%s %s %s/%s still exists", names.Scheduler, tfscheduler.ResNameSchedules, rs.Primary.ID, scheduleName)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchedulesExist(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(t).SchedulerClient(ctx)
+
+		for _, scheduleName := range testAccScheduleNamesFromState(rs) {
+			if _, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, rs.Primary.ID, scheduleName); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccSchedulesConfig_base(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+`, name),
+	)
+}
+
+func testAccSchedulesConfig_basic(name string) string {
+	return acctest.ConfigCompose(
+		testAccSchedulesConfig_base(name),
+		`
+resource "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  schedule {
+    name                = "nightly"
+    schedule_expression = "cron(0 2 * * ? *)"
+    input               = jsonencode({ job = "nightly" })
+  }
+
+  schedule {
+    name                = "hourly"
+    schedule_expression = "rate(1 hour)"
+    description         = "Hourly job"
+  }
+}
+`,
+	)
+}
+
+func testAccSchedulesConfig_updated(name string) string {
+	return acctest.ConfigCompose(
+		testAccSchedulesConfig_base(name),
+		`
+resource "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  schedule_expression_timezone = "Europe/Amsterdam"
+  state                        = "DISABLED"
+
+  flexible_time_window {
+    maximum_window_in_minutes = 15
+    mode                      = "FLEXIBLE"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  schedule {
+    name                = "nightly"
+    schedule_expression = "cron(30 3 * * ? *)"
+  }
+
+  schedule {
+    name                = "weekly"
+    schedule_expression = "cron(0 4 ? * MON *)"
+  }
+}
+`,
+	)
+}
+
+func testAccSchedulesConfig_duplicateName(name string) string {
+	return acctest.ConfigCompose(
+		testAccSchedulesConfig_base(name),
+		`
+resource "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  schedule {
+    name                = "nightly"
+    schedule_expression = "cron(0 2 * * ? *)"
+  }
+
+  schedule {
+    name                = "nightly"
+    schedule_expression = "cron(0 3 * * ? *)"
+  }
+}
+`,
+	)
+}
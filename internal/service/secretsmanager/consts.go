@@ -10,3 +10,8 @@ import (
 const (
 	PropagationTimeout = 2 * time.Minute
 )
+
+const (
+	secretVersionStageCurrent = "AWSCURRENT"
+	secretVersionStagePending = "AWSPENDING"
+)
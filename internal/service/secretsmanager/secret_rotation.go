@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -28,17 +29,38 @@ func ResourceSecretRotation() *schema.Resource {
 		DeleteWithoutTimeout: resourceSecretRotationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("rotate_immediately", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
+			"last_rotated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owning_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotate_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"rotation_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"rotation_lambda_arn": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"rotation_rules": {
 				Type:     schema.TypeList,
@@ -85,27 +107,17 @@ func ResourceSecretRotation() *schema.Resource {
 func resourceSecretRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecretsManagerConn(ctx)
-	secretID := d.Get("secret_id").(string)
-
-	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
-		input := &secretsmanager.RotateSecretInput{
-			RotationLambdaARN: aws.String(v.(string)),
-			RotationRules:     expandRotationRules(d.Get("rotation_rules").([]interface{})),
-			SecretId:          aws.String(secretID),
-		}
 
-		// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
-			return conn.RotateSecretWithContext(ctx, input)
-		}, "AccessDeniedException")
+	secretID := d.Get("secret_id").(string)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "creating Secrets Manager Secret Rotation (%s): %s", secretID, err)
-		}
+	output, err := rotateSecret(ctx, conn, d, secretID)
 
-		d.SetId(aws.StringValue(outputRaw.(*secretsmanager.RotateSecretOutput).ARN))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Secrets Manager Secret Rotation (%s): %s", secretID, err)
 	}
 
+	d.SetId(aws.StringValue(output.ARN))
+
 	return append(diags, resourceSecretRotationRead(ctx, d, meta)...)
 }
 
@@ -130,6 +142,17 @@ func resourceSecretRotationRead(ctx context.Context, d *schema.ResourceData, met
 	output := outputRaw.(*secretsmanager.DescribeSecretOutput)
 
 	d.Set("secret_id", d.Id())
+	if output.LastRotatedDate != nil {
+		d.Set("last_rotated_date", aws.TimeValue(output.LastRotatedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_rotated_date", nil)
+	}
+	if output.NextRotationDate != nil {
+		d.Set("next_rotation_date", aws.TimeValue(output.NextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+	d.Set("owning_service", output.OwningService)
 	d.Set("rotation_enabled", output.RotationEnabled)
 	if aws.BoolValue(output.RotationEnabled) {
 		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
@@ -147,34 +170,10 @@ func resourceSecretRotationRead(ctx context.Context, d *schema.ResourceData, met
 func resourceSecretRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecretsManagerConn(ctx)
-	secretID := d.Get("secret_id").(string)
 
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
-		if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
-			input := &secretsmanager.RotateSecretInput{
-				RotationLambdaARN: aws.String(v.(string)),
-				RotationRules:     expandRotationRules(d.Get("rotation_rules").([]interface{})),
-				SecretId:          aws.String(secretID),
-			}
-
-			// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-			_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
-				return conn.RotateSecretWithContext(ctx, input)
-			}, "AccessDeniedException")
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating Secrets Manager Secret Rotation (%s): %s", d.Id(), err)
-			}
-		} else {
-			input := &secretsmanager.CancelRotateSecretInput{
-				SecretId: aws.String(d.Id()),
-			}
-
-			_, err := conn.CancelRotateSecretWithContext(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "cancelling Secrets Manager Secret Rotation (%s): %s", d.Id(), err)
-			}
+		if _, err := rotateSecret(ctx, conn, d, d.Get("secret_id").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Secrets Manager Secret Rotation (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -196,6 +195,42 @@ func resourceSecretRotationDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// rotateSecret configures rotation of the specified secret.
+// When no rotation Lambda function is configured the secret must use managed rotation,
+// which is only available for secrets owned by another AWS service, e.g. RDS master user secrets.
+func rotateSecret(ctx context.Context, conn *secretsmanager.SecretsManager, d *schema.ResourceData, secretID string) (*secretsmanager.RotateSecretOutput, error) {
+	input := &secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
+		RotationRules:     expandRotationRules(d.Get("rotation_rules").([]interface{})),
+		SecretId:          aws.String(secretID),
+	}
+
+	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
+		input.RotationLambdaARN = aws.String(v.(string))
+	} else {
+		output, err := FindSecretByID(ctx, conn, secretID)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading Secrets Manager Secret (%s): %w", secretID, err)
+		}
+
+		if aws.StringValue(output.OwningService) == "" {
+			return nil, fmt.Errorf("rotation_lambda_arn is required unless the secret is managed by another AWS service")
+		}
+	}
+
+	// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
+		return conn.RotateSecretWithContext(ctx, input)
+	}, "AccessDeniedException")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*secretsmanager.RotateSecretOutput), nil
+}
+
 func expandRotationRules(l []interface{}) *secretsmanager.RotationRulesType {
 	if len(l) == 0 {
 		return nil
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -39,6 +40,11 @@ func ResourceSecretVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"promote_to_stage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
 			"secret_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -67,6 +73,28 @@ func ResourceSecretVersion() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
+				// Staging labels moved by promote_to_stage are managed by it and don't appear in the configured stages.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if d.Id() == "" {
+						return false
+					}
+
+					if k == "version_stages.#" {
+						o, n := d.GetChange("version_stages")
+
+						return secretVersionStagesExceptPromoted(d, o.(*schema.Set)).Equal(secretVersionStagesExceptPromoted(d, n.(*schema.Set)))
+					}
+
+					if new == "" {
+						return isSecretVersionStagePromoted(d, old)
+					}
+
+					if old == "" {
+						return isSecretVersionStagePromoted(d, new)
+					}
+
+					return false
+				},
 			},
 		},
 	}
@@ -110,7 +138,14 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "putting Secrets Manager Secret value: %s", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s|%s", secretID, aws.StringValue(output.VersionId)))
+	versionID := aws.StringValue(output.VersionId)
+	d.SetId(fmt.Sprintf("%s|%s", secretID, versionID))
+
+	if v, ok := d.GetOk("promote_to_stage"); ok {
+		if err := promoteSecretVersion(ctx, conn, secretID, versionID, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting Secrets Manager Secret (%s) Version (%s): %s", secretID, versionID, err)
+		}
+	}
 
 	return append(diags, resourceSecretVersionRead(ctx, d, meta)...)
 }
@@ -197,9 +232,15 @@ func resourceSecretVersionUpdate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "updating Secrets Manager Secret Version (%s): %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("promote_to_stage"); ok && d.HasChange("promote_to_stage") {
+		if err := promoteSecretVersion(ctx, conn, secretID, versionID, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting Secrets Manager Secret (%s) Version (%s): %s", secretID, versionID, err)
+		}
+	}
+
 	o, n := d.GetChange("version_stages")
-	os := o.(*schema.Set)
-	ns := n.(*schema.Set)
+	os := secretVersionStagesExceptPromoted(d, o.(*schema.Set))
+	ns := secretVersionStagesExceptPromoted(d, n.(*schema.Set))
 	stagesToAdd := ns.Difference(os).List()
 	stagesToRemove := os.Difference(ns).List()
 
@@ -276,6 +317,80 @@ func resourceSecretVersionDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+// isSecretVersionStagePromoted returns whether the staging label is moved by promote_to_stage.
+// Promoting a version to AWSCURRENT also moves AWSPENDING off it.
+func isSecretVersionStagePromoted(d *schema.ResourceData, stage string) bool {
+	promoteToStage := d.Get("promote_to_stage").(string)
+
+	if promoteToStage == "" {
+		return false
+	}
+
+	return stage == promoteToStage || (promoteToStage == secretVersionStageCurrent && stage == secretVersionStagePending)
+}
+
+func secretVersionStagesExceptPromoted(d *schema.ResourceData, stages *schema.Set) *schema.Set {
+	result := schema.NewSet(stages.F, nil)
+
+	for _, stage := range stages.List() {
+		if !isSecretVersionStagePromoted(d, stage.(string)) {
+			result.Add(stage)
+		}
+	}
+
+	return result
+}
+
+// promoteSecretVersion moves the specified staging label to the secret version, removing it from the version that currently has it.
+// Promoting a version to AWSCURRENT also removes AWSPENDING from it, completing a rotation in the same way a rotation function does.
+func promoteSecretVersion(ctx context.Context, conn *secretsmanager.SecretsManager, secretID, versionID, stage string) error {
+	secret, err := FindSecretByID(ctx, conn, secretID)
+
+	if err != nil {
+		return fmt.Errorf("reading Secrets Manager Secret (%s): %w", secretID, err)
+	}
+
+	input := &secretsmanager.UpdateSecretVersionStageInput{
+		MoveToVersionId: aws.String(versionID),
+		SecretId:        aws.String(secretID),
+		VersionStage:    aws.String(stage),
+	}
+
+	var hasPending bool
+
+	for id, stages := range secret.VersionIdsToStages {
+		for _, v := range aws.StringValueSlice(stages) {
+			if v == stage && id != versionID {
+				input.RemoveFromVersionId = aws.String(id)
+			}
+
+			if v == secretVersionStagePending && id == versionID {
+				hasPending = true
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Secrets Manager Secret Version Stage: %s", input)
+	if _, err := conn.UpdateSecretVersionStageWithContext(ctx, input); err != nil {
+		return fmt.Errorf("moving stage %s: %w", stage, err)
+	}
+
+	if stage == secretVersionStageCurrent && hasPending {
+		input := &secretsmanager.UpdateSecretVersionStageInput{
+			RemoveFromVersionId: aws.String(versionID),
+			SecretId:            aws.String(secretID),
+			VersionStage:        aws.String(secretVersionStagePending),
+		}
+
+		log.Printf("[DEBUG] Updating Secrets Manager Secret Version Stage: %s", input)
+		if _, err := conn.UpdateSecretVersionStageWithContext(ctx, input); err != nil {
+			return fmt.Errorf("removing stage %s: %w", secretVersionStagePending, err)
+		}
+	}
+
+	return nil
+}
+
 func DecodeSecretVersionID(id string) (string, string, error) {
 	idParts := strings.Split(id, "|")
 	if len(idParts) != 2 {
@@ -138,6 +138,56 @@ func TestAccSecretsManagerSecretVersion_versionStages(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersion_promoteToStage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version secretsmanager.GetSecretValueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+	currentResourceName := "aws_secretsmanager_secret_version.current"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_promoteToStage(rName, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "version_stages.*", "AWSPENDING"),
+					resource.TestCheckTypeSetElemAttr(currentResourceName, "version_stages.*", "AWSCURRENT"),
+				),
+			},
+			{
+				Config: testAccSecretVersionConfig_promoteToStage(rName, "AWSCURRENT", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "promote_to_stage", "AWSCURRENT"),
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "version_stages.*", "AWSCURRENT"),
+				),
+			},
+			{
+				Config: testAccSecretVersionConfig_promoteToStage(rName, "AWSCURRENT", "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "version_stages.*", "AWSCURRENT"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "version_stages.*", "one"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"promote_to_stage"},
+			},
+		},
+	})
+}
+
 func testAccCheckSecretVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn(ctx)
@@ -293,3 +343,25 @@ resource "aws_secretsmanager_secret_version" "test" {
 }
 `, rName)
 }
+
+func testAccSecretVersionConfig_promoteToStage(rName, promoteToStage, additionalStage string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "current" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "current-string"
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id        = aws_secretsmanager_secret.test.id
+  secret_string    = "pending-string"
+  version_stages   = compact(["AWSPENDING", %[3]q])
+  promote_to_stage = %[2]q != "" ? %[2]q : null
+
+  depends_on = [aws_secretsmanager_secret_version.current]
+}
+`, rName, promoteToStage, additionalStage)
+}
@@ -6,18 +6,23 @@ package sfn
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -38,6 +43,9 @@ func ResourceAlias() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: resourceAliasCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -47,6 +55,36 @@ func ResourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deployment_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2100),
+						},
+						"percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(aliasDeploymentType_Values(), false),
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -60,15 +98,18 @@ func ResourceAlias() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				MinItems: 1,
+				MaxItems: 2,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"state_machine_version_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
 						},
 						"weight": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
 						},
 					},
 				},
@@ -81,6 +122,24 @@ const (
 	ResNameAlias = "Alias"
 )
 
+const (
+	aliasDeploymentTypeAllAtOnce = "ALL_AT_ONCE"
+	aliasDeploymentTypeCanary    = "CANARY"
+	aliasDeploymentTypeLinear    = "LINEAR"
+)
+
+func aliasDeploymentType_Values() []string {
+	return []string{
+		aliasDeploymentTypeAllAtOnce,
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
+
+const (
+	aliasDeploymentAlarmPollInterval = 1 * time.Minute
+)
+
 func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SFNConn(ctx)
 
@@ -151,6 +210,15 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChange("routing_configuration") {
 		in.RoutingConfiguration = expandAliasRoutingConfiguration(d.Get("routing_configuration").([]interface{}))
 		update = true
+
+		if v, ok := d.GetOk("deployment_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			o, _ := d.GetChange("routing_configuration")
+			preference := expandAliasDeploymentPreference(v.([]interface{})[0].(map[string]interface{}))
+
+			if err := deployAlias(ctx, conn, meta.(*conns.AWSClient).CloudWatchConn(ctx), d.Id(), expandAliasRoutingConfiguration(o.([]interface{})), in.RoutingConfiguration, preference, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.DiagError(names.SFN, create.ErrActionUpdating, ResNameAlias, d.Id(), err)
+			}
+		}
 	}
 
 	if !update {
@@ -181,6 +249,211 @@ func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAliasCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if v, ok := d.GetOk("deployment_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		switch tfMap["type"].(string) {
+		case aliasDeploymentTypeCanary, aliasDeploymentTypeLinear:
+			if tfMap["interval"].(int) == 0 || tfMap["percentage"].(int) == 0 {
+				return fmt.Errorf("deployment_preference.0.interval and deployment_preference.0.percentage are required for %s deployments", tfMap["type"].(string))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("routing_configuration"); ok {
+		var total int
+
+		for i, tfMapRaw := range v.([]interface{}) {
+			if !d.NewValueKnown(fmt.Sprintf("routing_configuration.%d.weight", i)) {
+				return nil
+			}
+
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				total += tfMap["weight"].(int)
+			}
+		}
+
+		if total != 100 {
+			return fmt.Errorf("routing_configuration weights must add up to 100, got %d", total)
+		}
+	}
+
+	return nil
+}
+
+type aliasDeploymentPreference struct {
+	alarms         []string
+	deploymentType string
+	interval       time.Duration
+	percentage     int
+}
+
+func expandAliasDeploymentPreference(tfMap map[string]interface{}) aliasDeploymentPreference {
+	preference := aliasDeploymentPreference{
+		deploymentType: tfMap["type"].(string),
+		interval:       time.Duration(tfMap["interval"].(int)) * time.Minute,
+		percentage:     tfMap["percentage"].(int),
+	}
+
+	if v, ok := tfMap["alarms"].(*schema.Set); ok && v.Len() > 0 {
+		preference.alarms = flex.ExpandStringValueSet(v)
+	}
+
+	return preference
+}
+
+// aliasDeploymentWeights returns the intermediate weights of the new version during a gradual deployment.
+func aliasDeploymentWeights(preference aliasDeploymentPreference) []int {
+	var weights []int
+
+	switch preference.deploymentType {
+	case aliasDeploymentTypeCanary:
+		weights = append(weights, preference.percentage)
+	case aliasDeploymentTypeLinear:
+		for weight := preference.percentage; weight < 100; weight += preference.percentage {
+			weights = append(weights, weight)
+		}
+	}
+
+	return weights
+}
+
+// deployAlias gradually shifts traffic from the alias's current version to the new version before
+// the new routing configuration is applied. A deployment is only made when the new routing configuration
+// sends all traffic to a single version that is not already the alias's main version.
+// If any of the alarms goes into ALARM state, the original routing configuration is restored.
+func deployAlias(ctx context.Context, conn *sfn.SFN, cloudwatchConn *cloudwatch.CloudWatch, aliasARN string, oldRouting, newRouting []*sfn.RoutingConfigurationListItem, preference aliasDeploymentPreference, timeout time.Duration) error {
+	weights := aliasDeploymentWeights(preference)
+
+	if len(weights) == 0 || len(newRouting) != 1 || len(oldRouting) == 0 {
+		return nil
+	}
+
+	newVersionARN := aws.StringValue(newRouting[0].StateMachineVersionArn)
+	var oldVersionARN string
+	var oldWeight int64
+
+	for _, v := range oldRouting {
+		if weight := aws.Int64Value(v.Weight); weight > oldWeight {
+			oldVersionARN, oldWeight = aws.StringValue(v.StateMachineVersionArn), weight
+		}
+	}
+
+	if oldVersionARN == "" || oldVersionARN == newVersionARN {
+		return nil
+	}
+
+	if duration := time.Duration(len(weights)) * preference.interval; duration > timeout {
+		return fmt.Errorf("%s deployment takes %s, which exceeds the update timeout of %s", preference.deploymentType, duration, timeout)
+	}
+
+	for _, weight := range weights {
+		log.Printf("[INFO] Shifting %d%% of SFN Alias (%s) traffic to %s", weight, aliasARN, newVersionARN)
+
+		_, err := conn.UpdateStateMachineAliasWithContext(ctx, &sfn.UpdateStateMachineAliasInput{
+			RoutingConfiguration: []*sfn.RoutingConfigurationListItem{
+				{
+					StateMachineVersionArn: aws.String(newVersionARN),
+					Weight:                 aws.Int64(int64(weight)),
+				},
+				{
+					StateMachineVersionArn: aws.String(oldVersionARN),
+					Weight:                 aws.Int64(int64(100 - weight)),
+				},
+			},
+			StateMachineAliasArn: aws.String(aliasARN),
+		})
+
+		if err != nil {
+			return fmt.Errorf("shifting %d%% of traffic to %s: %w", weight, newVersionARN, err)
+		}
+
+		if err := waitAliasDeploymentInterval(ctx, cloudwatchConn, preference); err != nil {
+			_, rollbackErr := conn.UpdateStateMachineAliasWithContext(ctx, &sfn.UpdateStateMachineAliasInput{
+				RoutingConfiguration: oldRouting,
+				StateMachineAliasArn: aws.String(aliasARN),
+			})
+
+			if rollbackErr != nil {
+				return errors.Join(err, fmt.Errorf("rolling back: %w", rollbackErr))
+			}
+
+			return fmt.Errorf("deployment rolled back: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// waitAliasDeploymentInterval waits for the deployment interval, checking the alarms periodically.
+func waitAliasDeploymentInterval(ctx context.Context, conn *cloudwatch.CloudWatch, preference aliasDeploymentPreference) error {
+	deadline := time.Now().Add(preference.interval)
+
+	for {
+		if err := checkAliasDeploymentAlarms(ctx, conn, preference.alarms); err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+
+		if remaining <= 0 {
+			return nil
+		}
+
+		if remaining > aliasDeploymentAlarmPollInterval {
+			remaining = aliasDeploymentAlarmPollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(remaining):
+		}
+	}
+}
+
+func checkAliasDeploymentAlarms(ctx context.Context, conn *cloudwatch.CloudWatch, alarms []string) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	var alarming []string
+
+	err := conn.DescribeAlarmsPagesWithContext(ctx, &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice(alarms),
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+	}, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricAlarms {
+			if aws.StringValue(v.StateValue) == cloudwatch.StateValueAlarm {
+				alarming = append(alarming, aws.StringValue(v.AlarmName))
+			}
+		}
+
+		for _, v := range page.CompositeAlarms {
+			if aws.StringValue(v.StateValue) == cloudwatch.StateValueAlarm {
+				alarming = append(alarming, aws.StringValue(v.AlarmName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("describing CloudWatch Alarms: %w", err)
+	}
+
+	if len(alarming) > 0 {
+		return fmt.Errorf("CloudWatch Alarms in ALARM state: %v", alarming)
+	}
+
+	return nil
+}
+
 func FindAliasByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineAliasOutput, error) {
 	in := &sfn.DescribeStateMachineAliasInput{
 		StateMachineAliasArn: aws.String(arn),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -86,6 +87,68 @@ func TestAccSFNAlias_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSFNAlias_deploymentPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var alias sfn.DescribeStateMachineAliasOutput
+	rString := sdkacctest.RandString(8)
+	stateMachineName := fmt.Sprintf("tf_acc_state_machine_alias_deploy_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_state_machine_alias_deploy_%s", rString)
+	resourceName := "aws_sfn_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineAliasConfig_deploymentPreference(stateMachineName, aliasName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.type", "CANARY"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+				),
+			},
+			{
+				// Publishes a new version and shifts traffic to it gradually.
+				Config: testAccStateMachineAliasConfig_deploymentPreference(stateMachineName, aliasName, 11),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNAlias_invalidWeights(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(8)
+	stateMachineName := fmt.Sprintf("tf_acc_state_machine_alias_weights_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_state_machine_alias_weights_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineAliasConfig_weight(stateMachineName, aliasName, 90),
+				ExpectError: regexache.MustCompile(`routing_configuration weights must add up to 100, got 90`),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasAttributes(mapping *sfn.DescribeStateMachineAliasOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		name := *mapping.Name
@@ -293,3 +356,35 @@ resource "aws_sfn_alias" "test" {
 }
 `, aliasName))
 }
+
+func testAccStateMachineAliasConfig_deploymentPreference(statemachineName string, aliasName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineAliasConfig_base(statemachineName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  deployment_preference {
+    type       = "CANARY"
+    interval   = 1
+    percentage = 10
+  }
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = 100
+  }
+}
+`, aliasName))
+}
+
+func testAccStateMachineAliasConfig_weight(statemachineName string, aliasName string, weight int) string {
+	return acctest.ConfigCompose(testAccStateMachineAliasConfig_base(statemachineName, 10), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = %[2]d
+  }
+}
+`, aliasName, weight))
+}
@@ -5,8 +5,12 @@ package sfn
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -14,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -156,12 +161,26 @@ func ResourceStateMachine() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(sfn.StateMachineType_Values(), false),
 			},
 			"version_description": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				RequiredWith: []string{"publish"},
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceStateMachineDefinitionCustomizeDiff,
+			customdiff.If(
+				func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+					// Updates of a published state machine publish a new version.
+					return d.Id() != "" && d.Get("publish").(bool) && d.HasChanges("definition", "logging_configuration", "publish", "role_arn", "tracing_configuration", "version_description")
+				},
+				func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+					return d.SetNewComputed("state_machine_version_arn")
+				},
+			),
+		),
 	}
 }
 
@@ -186,6 +205,10 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 		input.TracingConfiguration = expandTracingConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("version_description"); ok && d.Get("publish").(bool) {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
 	// This is done to deal with IAM eventual consistency.
 	// Note: the instance may be in a deleting mode, hence the retry
 	// when creating the step function. This can happen when we are
@@ -281,8 +304,8 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 			Publish:         aws.Bool(d.Get("publish").(bool)),
 		}
 
-		if v, ok := d.GetOk("publish"); ok && v == true {
-			input.VersionDescription = aws.String(d.Get("version_description").(string))
+		if v, ok := d.GetOk("version_description"); ok && d.Get("publish").(bool) {
+			input.VersionDescription = aws.String(v.(string))
 		}
 
 		if d.HasChange("logging_configuration") {
@@ -350,6 +373,135 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// resourceStateMachineDefinitionCustomizeDiff validates a new or changed definition at plan time.
+func resourceStateMachineDefinitionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("definition") || !d.NewValueKnown("type") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("definition", "type") {
+		return nil
+	}
+
+	definition := d.Get("definition").(string)
+
+	if definition == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	output, err := conn.ValidateStateMachineDefinitionWithContext(ctx, &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(definition),
+		Type:       aws.String(d.Get("type").(string)),
+	})
+
+	// Validation is best effort for principals without states:ValidateStateMachineDefinition permission.
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] Skipping Step Functions State Machine definition validation: %s", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	if aws.StringValue(output.Result) == sfn.ValidateStateMachineDefinitionResultCodeOk {
+		return nil
+	}
+
+	var errs []error
+	for _, v := range output.Diagnostics {
+		if v == nil {
+			continue
+		}
+
+		message := fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message))
+
+		if location := aws.StringValue(v.Location); location != "" {
+			if line := stateMachineDefinitionLine(definition, location); line > 0 {
+				message = fmt.Sprintf("line %d (%s): %s", line, location, message)
+			} else {
+				message = fmt.Sprintf("%s: %s", location, message)
+			}
+		}
+
+		if aws.StringValue(v.Severity) != sfn.ValidateStateMachineDefinitionSeverityError {
+			log.Printf("[WARN] Step Functions State Machine definition: %s", message)
+			continue
+		}
+
+		errs = append(errs, errors.New(message))
+	}
+
+	if len(errs) == 0 {
+		return errors.New("Step Functions State Machine definition is invalid")
+	}
+
+	return fmt.Errorf("Step Functions State Machine definition is invalid: %w", errors.Join(errs...))
+}
+
+// stateMachineDefinitionLine returns the 1-based line of the value at a diagnostic location,
+// a JSON Pointer such as "/States/FirstState/Next", or 0 if the location cannot be found.
+func stateMachineDefinitionLine(definition, location string) int {
+	offset, found, err := stateMachineDefinitionOffset(json.NewDecoder(strings.NewReader(definition)), "", location)
+
+	if err != nil || !found {
+		return 0
+	}
+
+	return strings.Count(definition[:offset], "\n") + 1
+}
+
+// stateMachineDefinitionOffset reads the next JSON value and returns the input offset
+// just past the first token of the value at location.
+func stateMachineDefinitionOffset(dec *json.Decoder, path, location string) (int64, bool, error) {
+	tok, err := dec.Token()
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	if path == location {
+		return dec.InputOffset(), true, nil
+	}
+
+	delim, ok := tok.(json.Delim)
+
+	if !ok {
+		return 0, false, nil
+	}
+
+	switch delim {
+	case '{':
+		for dec.More() {
+			tok, err := dec.Token()
+
+			if err != nil {
+				return 0, false, err
+			}
+
+			key, _ := tok.(string)
+			key = strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+
+			if offset, found, err := stateMachineDefinitionOffset(dec, path+"/"+key, location); err != nil || found {
+				return offset, found, err
+			}
+		}
+	case '[':
+		for i := 0; dec.More(); i++ {
+			if offset, found, err := stateMachineDefinitionOffset(dec, path+"/"+strconv.Itoa(i), location); err != nil || found {
+				return offset, found, err
+			}
+		}
+	}
+
+	// Closing delimiter.
+	_, err = dec.Token()
+
+	return 0, false, err
+}
+
 func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
@@ -292,6 +292,105 @@ func TestAccSFNStateMachine_publish(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_versionDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_versionDescription(rName, "version 1", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "version 1"),
+				),
+			},
+			{
+				Config: testAccStateMachineConfig_versionDescription(rName, "version 2", 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "state_machine_version_arn", regexache.MustCompile(`:2$`)),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "version 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_invalidDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_invalidDefinition(rName),
+				ExpectError: regexache.MustCompile(`line 6 \(/States/Start/Next\): [A-Z_]+: `),
+			},
+		},
+	})
+}
+
+func TestStateMachineDefinitionLine(t *testing.T) {
+	t.Parallel()
+
+	definition := `{
+  "StartAt": "Start",
+  "States": {
+    "Start": {
+      "Type": "Pass",
+      "Next": "Missing"
+    },
+    "a/b": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "X"},
+        {
+          "StartAt": "Y"
+        }
+      ]
+    }
+  }
+}`
+
+	testCases := []struct {
+		location string
+		expected int
+	}{
+		{"/StartAt", 2},
+		{"/States/Start", 4},
+		{"/States/Start/Next", 6},
+		{"/States/a~1b/Type", 9},
+		{"/States/a~1b/Branches/0/StartAt", 11},
+		{"/States/a~1b/Branches/1/StartAt", 13},
+		{"/States/Missing", 0},
+		{"States", 0},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.location, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfsfn.StateMachineDefinitionLine(definition, testCase.location), testCase.expected; got != want {
+				t.Errorf("StateMachineDefinitionLine(%q) = %d, want %d", testCase.location, got, want)
+			}
+		})
+	}
+}
+
 func TestAccSFNStateMachine_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sm sfn.DescribeStateMachineOutput
@@ -724,6 +823,63 @@ EOF
 `)
 }
 
+func testAccStateMachineConfig_versionDescription(rName, versionDescription string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  publish             = true
+  version_description = %[2]q
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": %[3]d,
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, versionDescription, rMaxAttempts))
+}
+
+func testAccStateMachineConfig_invalidDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "StartAt": "Start",
+  "States": {
+    "Start": {
+      "Type": "Pass",
+      "Next": "Missing"
+    }
+  }
+}
+EOF
+}
+`, rName))
+}
+
 func testAccStateMachineConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
 resource "aws_sfn_state_machine" "test" {
@@ -5,9 +5,11 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -117,21 +120,69 @@ var (
 			Optional: true,
 			Default:  DefaultQueueReceiveMessageWaitTimeSeconds,
 		},
+		"redrive_allow_configuration": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"redrive_allow_policy"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"redrive_permission": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(RedrivePermission_Values(), false),
+					},
+					"source_queue_arns": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						MaxItems: 10,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
 		"redrive_allow_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"redrive_allow_configuration"},
+			ValidateFunc:  validation.StringIsJSON,
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
 			},
 		},
+		"redrive_configuration": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"redrive_policy"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dead_letter_target_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"max_receive_count": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 1000),
+					},
+				},
+			},
+		},
 		"redrive_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"redrive_configuration"},
+			ValidateFunc:  validation.StringIsJSON,
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
@@ -221,6 +272,10 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	if err := expandQueueRedriveAttributes(d, attributes); err != nil {
+		return diag.FromErr(err)
+	}
+
 	input.Attributes = aws.StringMap(attributes)
 
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, queueCreatedTimeout, func() (interface{}, error) {
@@ -294,6 +349,26 @@ func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
+	redriveConfiguration, err := flattenRedrivePolicy(output[sqs.QueueAttributeNameRedrivePolicy])
+
+	if err != nil {
+		return diag.Errorf("reading SQS Queue (%s) redrive policy: %s", d.Id(), err)
+	}
+
+	if err := d.Set("redrive_configuration", redriveConfiguration); err != nil {
+		return diag.Errorf("setting redrive_configuration: %s", err)
+	}
+
+	redriveAllowConfiguration, err := flattenRedriveAllowPolicy(output[sqs.QueueAttributeNameRedriveAllowPolicy])
+
+	if err != nil {
+		return diag.Errorf("reading SQS Queue (%s) redrive allow policy: %s", d.Id(), err)
+	}
+
+	if err := d.Set("redrive_allow_configuration", redriveAllowConfiguration); err != nil {
+		return diag.Errorf("setting redrive_allow_configuration: %s", err)
+	}
+
 	// Backwards compatibility: https://github.com/hashicorp/terraform-provider-aws/issues/19786.
 	if d.Get("kms_data_key_reuse_period_seconds").(int) == 0 {
 		d.Set("kms_data_key_reuse_period_seconds", DefaultQueueKMSDataKeyReusePeriodSeconds)
@@ -320,6 +395,10 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			return diag.FromErr(err)
 		}
 
+		if err := expandQueueRedriveAttributes(d, attributes); err != nil {
+			return diag.FromErr(err)
+		}
+
 		input := &sqs.SetQueueAttributesInput{
 			Attributes: aws.StringMap(attributes),
 			QueueUrl:   aws.String(d.Id()),
@@ -399,5 +478,147 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	if v, ok := diff.GetOk("redrive_allow_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		permission := tfMap["redrive_permission"].(string)
+		sourceQueueARNs := tfMap["source_queue_arns"].(*schema.Set).Len()
+
+		if permission == RedrivePermissionByQueue && sourceQueueARNs == 0 && diff.NewValueKnown("redrive_allow_configuration.0.source_queue_arns") {
+			return fmt.Errorf("redrive_allow_configuration.source_queue_arns must be set when redrive_permission is %q", RedrivePermissionByQueue)
+		}
+
+		if permission != RedrivePermissionByQueue && sourceQueueARNs > 0 {
+			return fmt.Errorf("redrive_allow_configuration.source_queue_arns can only be set when redrive_permission is %q", RedrivePermissionByQueue)
+		}
+	}
+
+	return nil
+}
+
+// expandQueueRedriveAttributes adds the JSON redrive policies built from the structured redrive blocks to the queue attributes.
+func expandQueueRedriveAttributes(d *schema.ResourceData, attributes map[string]string) error {
+	if d.IsNewResource() || d.HasChange("redrive_configuration") {
+		if v, ok := d.GetOk("redrive_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy, err := expandRedrivePolicy(v.([]interface{})[0].(map[string]interface{}))
+
+			if err != nil {
+				return err
+			}
+
+			attributes[sqs.QueueAttributeNameRedrivePolicy] = policy
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("redrive_allow_configuration") {
+		if v, ok := d.GetOk("redrive_allow_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy, err := expandRedriveAllowPolicy(v.([]interface{})[0].(map[string]interface{}))
+
+			if err != nil {
+				return err
+			}
+
+			attributes[sqs.QueueAttributeNameRedriveAllowPolicy] = policy
+		}
+	}
+
 	return nil
 }
+
+type redrivePolicy struct {
+	DeadLetterTargetARN string      `json:"deadLetterTargetArn"`
+	MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+}
+
+type redriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueARNs   []string `json:"sourceQueueArns,omitempty"`
+}
+
+func expandRedrivePolicy(tfMap map[string]interface{}) (string, error) {
+	policy := redrivePolicy{
+		DeadLetterTargetARN: tfMap["dead_letter_target_arn"].(string),
+		MaxReceiveCount:     json.Number(strconv.Itoa(tfMap["max_receive_count"].(int))),
+	}
+
+	b, err := json.Marshal(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandRedriveAllowPolicy(tfMap map[string]interface{}) (string, error) {
+	policy := redriveAllowPolicy{
+		RedrivePermission: tfMap["redrive_permission"].(string),
+	}
+
+	if v, ok := tfMap["source_queue_arns"].(*schema.Set); ok && v.Len() > 0 {
+		policy.SourceQueueARNs = flex.ExpandStringValueSet(v)
+	}
+
+	b, err := json.Marshal(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenRedrivePolicy(apiObject string) ([]interface{}, error) {
+	if apiObject == "" {
+		return nil, nil
+	}
+
+	// maxReceiveCount may have been set as either a JSON number or a string.
+	var policy struct {
+		DeadLetterTargetARN string      `json:"deadLetterTargetArn"`
+		MaxReceiveCount     interface{} `json:"maxReceiveCount"`
+	}
+
+	if err := json.Unmarshal([]byte(apiObject), &policy); err != nil {
+		return nil, err
+	}
+
+	var maxReceiveCount int
+	switch v := policy.MaxReceiveCount.(type) {
+	case float64:
+		maxReceiveCount = int(v)
+	case string:
+		n, err := strconv.Atoi(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing maxReceiveCount (%s): %w", v, err)
+		}
+
+		maxReceiveCount = n
+	}
+
+	tfMap := map[string]interface{}{
+		"dead_letter_target_arn": policy.DeadLetterTargetARN,
+		"max_receive_count":      maxReceiveCount,
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func flattenRedriveAllowPolicy(apiObject string) ([]interface{}, error) {
+	if apiObject == "" {
+		return nil, nil
+	}
+
+	var policy redriveAllowPolicy
+
+	if err := json.Unmarshal([]byte(apiObject), &policy); err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		"redrive_permission": policy.RedrivePermission,
+		"source_queue_arns":  policy.SourceQueueARNs,
+	}
+
+	return []interface{}{tfMap}, nil
+}
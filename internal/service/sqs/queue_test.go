@@ -524,6 +524,95 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_redriveConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	dlqResourceName := "aws_sqs_queue.dlq"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_redriveConfiguration(rName, 3, "byQueue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "redrive_configuration.0.dead_letter_target_arn", dlqResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_configuration.0.max_receive_count", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_configuration.0.redrive_permission", "byQueue"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_configuration.0.source_queue_arns.#", "1"),
+					resource.TestCheckResourceAttrSet(dlqResourceName, "redrive_allow_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_redriveConfiguration(rName, 5, "allowAll"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_configuration.0.max_receive_count", "5"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_configuration.0.redrive_permission", "allowAll"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_configuration.0.source_queue_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_redrivePolicyJSONPopulatesConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_redrivePolicy(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "redrive_configuration.0.dead_letter_target_arn", "aws_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_configuration.0.max_receive_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_redriveAllowConfigurationInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_redriveAllowConfigurationNoSourceQueues(rName),
+				ExpectError: regexache.MustCompile(`source_queue_arns must be set`),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[string]string
@@ -1151,6 +1240,46 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccQueueConfig_redriveConfiguration(rName string, maxReceiveCount int, redrivePermission string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = "%[1]s-1"
+
+  redrive_configuration {
+    dead_letter_target_arn = aws_sqs_queue.dlq.arn
+    max_receive_count      = %[2]d
+  }
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+
+  redrive_allow_configuration {
+    redrive_permission = %[3]q
+    source_queue_arns  = %[3]q == "byQueue" ? ["arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-1"] : null
+  }
+}
+`, rName, maxReceiveCount, redrivePermission)
+}
+
+func testAccQueueConfig_redriveAllowConfigurationNoSourceQueues(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_allow_configuration {
+    redrive_permission = "byQueue"
+  }
+}
+`, rName)
+}
+
 func testAccQueueConfig_fifo(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
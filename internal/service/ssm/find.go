@@ -90,3 +90,49 @@ func FindServiceSettingByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm
 
 	return output.ServiceSetting, nil
 }
+
+func FindParameterVersionByLabel(ctx context.Context, conn *ssm.SSM, name, label string) (*ssm.ParameterHistory, error) {
+	input := &ssm.GetParameterHistoryInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(false),
+	}
+	var output *ssm.ParameterHistory
+
+	err := conn.GetParameterHistoryPagesWithContext(ctx, input, func(page *ssm.GetParameterHistoryOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v == nil {
+				continue
+			}
+
+			for _, l := range v.Labels {
+				if aws.StringValue(l) == label {
+					output = v
+					return false
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -5,12 +5,14 @@ package ssm
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
@@ -28,6 +30,11 @@ func DataSourceParameter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"label": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"version"},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -47,8 +54,11 @@ func DataSourceParameter() *schema.Resource {
 				Default:  true,
 			},
 			"version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"label"},
+				ValidateFunc:  validation.IntAtLeast(1),
 			},
 		},
 	}
@@ -60,8 +70,16 @@ func dataParameterRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	name := d.Get("name").(string)
 
+	// A specific version or label is read using a "name:selector" parameter name.
+	selector := name
+	if v, ok := d.GetOk("label"); ok {
+		selector = fmt.Sprintf("%s:%s", name, v.(string))
+	} else if v, ok := d.GetOk("version"); ok {
+		selector = fmt.Sprintf("%s:%d", name, v.(int))
+	}
+
 	paramInput := &ssm.GetParameterInput{
-		Name:           aws.String(name),
+		Name:           aws.String(selector),
 		WithDecryption: aws.Bool(d.Get("with_decryption").(bool)),
 	}
 
@@ -69,7 +87,7 @@ func dataParameterRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	resp, err := conn.GetParameterWithContext(ctx, paramInput)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "describing SSM parameter (%s): %s", name, err)
+		return sdkdiag.AppendErrorf(diags, "describing SSM parameter (%s): %s", selector, err)
 	}
 
 	param := resp.Parameter
@@ -96,6 +96,32 @@ func TestAccSSMParameterDataSource_insecureValue(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameterDataSource_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "data.aws_ssm_parameter.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterDataSourceConfig_version(name, "first"),
+			},
+			{
+				Config: testAccParameterDataSourceConfig_version(name, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_ssm_parameter.test", "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "value", "first"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccParameterDataSourceConfig_basic(name string, withDecryption string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
@@ -124,3 +150,20 @@ data "aws_ssm_parameter" "test" {
 }
 `, rName, pType)
 }
+
+func testAccParameterDataSourceConfig_version(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = %[2]q
+}
+
+data "aws_ssm_parameter" "test" {
+  name    = aws_ssm_parameter.test.name
+  version = 1
+
+  depends_on = [aws_ssm_parameter.test]
+}
+`, rName, value)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ssm_parameter_label", name="Parameter Label")
+func ResourceParameterLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParameterLabelPut,
+		ReadWithoutTimeout:   resourceParameterLabelRead,
+		UpdateWithoutTimeout: resourceParameterLabelPut,
+		DeleteWithoutTimeout: resourceParameterLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, periods, hyphens, or underscores"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`^[0-9]`), "must not begin with a number"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`(?i)^(aws|ssm)`), `must not begin with "aws" or "ssm"`),
+				),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceParameterLabelPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	name, label := d.Get("name").(string), d.Get("label").(string)
+	id := ParameterLabelCreateResourceID(name, label)
+
+	// Labeling a version moves the label from any other version of the parameter.
+	input := &ssm.LabelParameterVersionInput{
+		Labels: aws.StringSlice([]string{label}),
+		Name:   aws.String(name),
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		input.ParameterVersion = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.LabelParameterVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "labeling SSM Parameter version (%s): %s", id, err)
+	}
+
+	if len(output.InvalidLabels) > 0 {
+		return sdkdiag.AppendErrorf(diags, "labeling SSM Parameter version (%s): invalid labels: %s", id, strings.Join(aws.StringValueSlice(output.InvalidLabels), ", "))
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceParameterLabelRead(ctx, d, meta)...)
+}
+
+func resourceParameterLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	name, label, err := ParameterLabelParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	parameter, err := FindParameterVersionByLabel(ctx, conn, name, label)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Parameter Label (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	d.Set("label", label)
+	d.Set("name", name)
+	d.Set("version", parameter.Version)
+
+	return diags
+}
+
+func resourceParameterLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	name, label, err := ParameterLabelParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSM Parameter Label: %s", d.Id())
+	_, err = conn.UnlabelParameterVersionWithContext(ctx, &ssm.UnlabelParameterVersionInput{
+		Labels:           aws.StringSlice([]string{label}),
+		Name:             aws.String(name),
+		ParameterVersion: aws.Int64(int64(d.Get("version").(int))),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound, ssm.ErrCodeParameterVersionNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const parameterLabelResourceIDSeparator = ","
+
+func ParameterLabelCreateResourceID(name, label string) string {
+	parts := []string{name, label}
+	id := strings.Join(parts, parameterLabelResourceIDSeparator)
+
+	return id
+}
+
+func ParameterLabelParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, parameterLabelResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NAME%[2]sLABEL", id, parameterLabelResourceIDSeparator)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMParameterLabel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param ssm.ParameterHistory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_basic(rName, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "label", "live"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", "aws_ssm_parameter.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "version", "aws_ssm_parameter.test", "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterLabel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param ssm.ParameterHistory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_basic(rName, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &param),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameterLabel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterLabel_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param ssm.ParameterHistory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+	dataSourceName := "data.aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_version(rName, "blue", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccParameterLabelConfig_version(rName, "green", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr("aws_ssm_parameter.test", "version", "2"),
+				),
+			},
+			{
+				Config: testAccParameterLabelConfig_versionDataSource(rName, "green", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "blue"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccParameterLabelConfig_versionDataSource(rName, "green", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "value", "green"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParameterLabelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter_label" {
+				continue
+			}
+
+			_, err := tfssm.FindParameterVersionByLabel(ctx, conn, rs.Primary.Attributes["name"], rs.Primary.Attributes["label"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Parameter Label %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParameterLabelExists(ctx context.Context, n string, v *ssm.ParameterHistory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		output, err := tfssm.FindParameterVersionByLabel(ctx, conn, rs.Primary.Attributes["name"], rs.Primary.Attributes["label"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParameterLabelConfig_basic(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = %[2]q
+}
+
+resource "aws_ssm_parameter_label" "test" {
+  name  = aws_ssm_parameter.test.name
+  label = "live"
+}
+`, rName, value)
+}
+
+func testAccParameterLabelConfig_version(rName, value string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = %[2]q
+}
+
+resource "aws_ssm_parameter_label" "test" {
+  name    = aws_ssm_parameter.test.name
+  label   = "live"
+  version = %[3]d
+}
+`, rName, value, version)
+}
+
+func testAccParameterLabelConfig_versionDataSource(rName, value string, version int) string {
+	return acctest.ConfigCompose(testAccParameterLabelConfig_version(rName, value, version), `
+data "aws_ssm_parameter" "test" {
+  name  = aws_ssm_parameter_label.test.name
+  label = aws_ssm_parameter_label.test.label
+
+  depends_on = [aws_ssm_parameter_label.test]
+}
+`)
+}
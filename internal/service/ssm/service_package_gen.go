@@ -105,6 +105,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Parameter",
 			},
 		},
+		{
+			Factory:  ResourceParameterLabel,
+			TypeName: "aws_ssm_parameter_label",
+			Name:     "Parameter Label",
+		},
 		{
 			Factory:  ResourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmsap"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssmsap_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexache.MustCompile(`^[\w\d\.-]+$`), "must contain only alphanumeric characters, periods, underscores, and hyphens"),
+				),
+			},
+			"application_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmsap.ApplicationType_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"credential": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssmsap.CredentialTypeAdmin,
+							ValidateFunc: validation.StringInSlice(ssmsap.CredentialType_Values(), false),
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"secret_id": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"database_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"discovery_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instances": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sap_instance_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMSAPConn(ctx)
+
+	id := d.Get("application_id").(string)
+	input := &ssmsap.RegisterApplicationInput{
+		ApplicationId:   aws.String(id),
+		ApplicationType: aws.String(d.Get("application_type").(string)),
+		Instances:       flex.ExpandStringSet(d.Get("instances").(*schema.Set)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("credential"); ok && v.(*schema.Set).Len() > 0 {
+		input.Credentials = expandApplicationCredentials(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("database_arn"); ok {
+		input.DatabaseArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sap_instance_number"); ok {
+		input.SapInstanceNumber = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sid"); ok {
+		input.Sid = aws.String(v.(string))
+	}
+
+	output, err := conn.RegisterApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "registering SSM for SAP Application (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM for SAP Application (%s) register: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMSAPConn(ctx)
+
+	application, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM for SAP Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM for SAP Application (%s): %s", d.Id(), err)
+	}
+
+	// Credentials, instances, SAP instance number and SID aren't returned by the API.
+	d.Set("application_id", application.Id)
+	d.Set("application_type", application.Type)
+	d.Set("arn", application.Arn)
+	d.Set("discovery_status", application.DiscoveryStatus)
+	d.Set("status", application.Status)
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMSAPConn(ctx)
+
+	if d.HasChanges("credential", "database_arn") {
+		input := &ssmsap.UpdateApplicationSettingsInput{
+			ApplicationId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("credential") {
+			o, n := d.GetChange("credential")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if v := ns.Difference(os).List(); len(v) > 0 {
+				input.CredentialsToAddOrUpdate = expandApplicationCredentials(v)
+			}
+
+			if v := os.Difference(ns).List(); len(v) > 0 {
+				input.CredentialsToRemove = expandApplicationCredentials(v)
+			}
+		}
+
+		if d.HasChange("database_arn") {
+			input.DatabaseArn = aws.String(d.Get("database_arn").(string))
+		}
+
+		output, err := conn.UpdateApplicationSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM for SAP Application (%s): %s", d.Id(), err)
+		}
+
+		for _, v := range output.OperationIds {
+			if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(v), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for SSM for SAP Application (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMSAPConn(ctx)
+
+	log.Printf("[DEBUG] Deregistering SSM for SAP Application: %s", d.Id())
+	_, err := conn.DeregisterApplicationWithContext(ctx, &ssmsap.DeregisterApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmsap.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering SSM for SAP Application (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM for SAP Application (%s) deregister: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindApplicationByID(ctx context.Context, conn *ssmsap.SsmSap, id string) (*ssmsap.Application, error) {
+	input := &ssmsap.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmsap.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Application == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Application, nil
+}
+
+func findOperationByID(ctx context.Context, conn *ssmsap.SsmSap, id string) (*ssmsap.Operation, error) {
+	input := &ssmsap.GetOperationInput{
+		OperationId: aws.String(id),
+	}
+
+	output, err := conn.GetOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmsap.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Operation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Operation, nil
+}
+
+func statusApplication(ctx context.Context, conn *ssmsap.SsmSap, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusOperation(ctx context.Context, conn *ssmsap.SsmSap, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *ssmsap.SsmSap, id string, timeout time.Duration) (*ssmsap.Application, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: ssmsap.ApplicationStatus_Values(),
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmsap.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOperationSucceeded(ctx context.Context, conn *ssmsap.SsmSap, id string, timeout time.Duration) (*ssmsap.Operation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ssmsap.OperationStatusInprogress},
+		Target:  []string{ssmsap.OperationStatusSuccess},
+		Refresh: statusOperation(ctx, conn, id),
+		Timeout: timeout,
+		// The operation may not be visible immediately after it has been started.
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmsap.Operation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandApplicationCredentials(tfList []interface{}) []*ssmsap.ApplicationCredential {
+	var apiObjects []*ssmsap.ApplicationCredential
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ssmsap.ApplicationCredential{
+			CredentialType: aws.String(tfMap["credential_type"].(string)),
+			DatabaseName:   aws.String(tfMap["database_name"].(string)),
+			SecretId:       aws.String(tfMap["secret_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ssmsap"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfssmsap "github.com/hashicorp/terraform-provider-aws/internal/service/ssmsap"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarInstanceID             = "AWS_SSMSAP_INSTANCE_ID"
+	envVarInstanceIDMessageError = "Environment variable AWS_SSMSAP_INSTANCE_ID is not set. " +
+		"Registering an application requires an EC2 instance running SAP HANA with the SSM Agent installed."
+	envVarSecretID             = "AWS_SSMSAP_SECRET_ID"
+	envVarSecretIDMessageError = "Environment variable AWS_SSMSAP_SECRET_ID is not set. " +
+		"Registering an application requires a Secrets Manager secret holding the SAP HANA SYSTEM user credentials."
+	envVarSID                   = "AWS_SSMSAP_SID"
+	envVarSIDMessageError       = "Environment variable AWS_SSMSAP_SID is not set. It must be the SAP HANA system ID."
+	envVarInstanceNumber        = "AWS_SSMSAP_INSTANCE_NUMBER"
+	envVarInstanceNumberMessage = "Environment variable AWS_SSMSAP_INSTANCE_NUMBER is not set. It must be the SAP HANA instance number."
+)
+
+func TestAccSSMSAPApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceID := envvar.SkipIfEmpty(t, envVarInstanceID, envVarInstanceIDMessageError)
+	secretID := envvar.SkipIfEmpty(t, envVarSecretID, envVarSecretIDMessageError)
+	sid := envvar.SkipIfEmpty(t, envVarSID, envVarSIDMessageError)
+	instanceNumber := envvar.SkipIfEmpty(t, envVarInstanceNumber, envVarInstanceNumberMessage)
+	var v ssmsap.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, ssmsap.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmsap.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, instanceID, secretID, sid, instanceNumber),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "application_type", "HANA"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-sap", regexache.MustCompile(`HANA/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "credential.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credential", "instances", "sap_instance_number", "sid"},
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceID := envvar.SkipIfEmpty(t, envVarInstanceID, envVarInstanceIDMessageError)
+	secretID := envvar.SkipIfEmpty(t, envVarSecretID, envVarSecretIDMessageError)
+	sid := envvar.SkipIfEmpty(t, envVarSID, envVarSIDMessageError)
+	instanceNumber := envvar.SkipIfEmpty(t, envVarInstanceNumber, envVarInstanceNumberMessage)
+	var v ssmsap.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, ssmsap.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmsap.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, instanceID, secretID, sid, instanceNumber),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssmsap.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string, v *ssmsap.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPConn(ctx)
+
+		output, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmsap_application" {
+				continue
+			}
+
+			_, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM for SAP Application %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccApplicationConfig_basic(rName, instanceID, secretID, sid, instanceNumber string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[5]q
+  sid                 = %[4]q
+
+  credential {
+    database_name = "SYSTEMDB"
+    secret_id     = %[3]q
+  }
+}
+`, rName, instanceID, secretID, sid, instanceNumber)
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
 )
 
 func TestAccSSOAdminAccountAssignment_Basic_group(t *testing.T) {
@@ -40,6 +42,7 @@ func TestAccSSOAdminAccountAssignment_Basic_group(t *testing.T) {
 				Config: testAccAccountAssignmentConfig_basicGroup(groupName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountAssignmentExists(ctx, resourceName),
+					testAccCheckAccountAssignmentPermissionSetProvisioned(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "target_type", "AWS_ACCOUNT"),
 					resource.TestCheckResourceAttr(resourceName, "principal_type", "GROUP"),
 					resource.TestMatchResourceAttr(resourceName, "principal_id", regexache.MustCompile("^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}")),
@@ -74,6 +77,7 @@ func TestAccSSOAdminAccountAssignment_Basic_user(t *testing.T) {
 				Config: testAccAccountAssignmentConfig_basicUser(userName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountAssignmentExists(ctx, resourceName),
+					testAccCheckAccountAssignmentPermissionSetProvisioned(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "target_type", "AWS_ACCOUNT"),
 					resource.TestCheckResourceAttr(resourceName, "principal_type", "USER"),
 					resource.TestMatchResourceAttr(resourceName, "principal_id", regexache.MustCompile("^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}")),
@@ -203,6 +207,44 @@ func testAccCheckAccountAssignmentExists(ctx context.Context, n string) resource
 	}
 }
 
+func testAccCheckAccountAssignmentPermissionSetProvisioned(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn(ctx)
+
+		input := &ssoadmin.ListPermissionSetsProvisionedToAccountInput{
+			AccountId:          aws.String(rs.Primary.Attributes["target_id"]),
+			InstanceArn:        aws.String(rs.Primary.Attributes["instance_arn"]),
+			ProvisioningStatus: aws.String(ssoadmin.ProvisioningStatusLatestPermissionSetProvisioned),
+		}
+		var permissionSetARNs []string
+
+		err := conn.ListPermissionSetsProvisionedToAccountPagesWithContext(ctx, input, func(page *ssoadmin.ListPermissionSetsProvisionedToAccountOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			permissionSetARNs = append(permissionSetARNs, aws.StringValueSlice(page.PermissionSets)...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if permissionSetARN := rs.Primary.Attributes["permission_set_arn"]; !slices.Contains(permissionSetARNs, permissionSetARN) {
+			return fmt.Errorf("SSO Permission Set (%s) latest version not provisioned to account (%s)", permissionSetARN, rs.Primary.Attributes["target_id"])
+		}
+
+		return nil
+	}
+}
+
 func testAccAccountAssignmentConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
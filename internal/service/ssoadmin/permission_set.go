@@ -288,25 +288,114 @@ func FindPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, permissionS
 }
 
 func provisionPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, permissionSetARN, instanceARN string, timeout time.Duration) error {
+	err := provisionPermissionSetToTarget(ctx, conn, permissionSetARN, instanceARN, ssoadmin.ProvisionTargetTypeAllProvisionedAccounts, "", timeout)
+
+	// A request targeting all provisioned accounts reports a single status, and accounts assigned while it is in progress
+	// can be left on the previous version of the permission set.
+	// Re-provision each account that isn't on the latest version individually so that any failure is reported per account.
+	// This requires the sso:ListAccountsForProvisionedPermissionSet permission and is skipped without it.
+	accountIDs, listErr := findAccountsForProvisionedPermissionSet(ctx, conn, permissionSetARN, instanceARN, ssoadmin.ProvisioningStatusLatestPermissionSetNotProvisioned)
+
+	if tfawserr.ErrCodeEquals(listErr, ssoadmin.ErrCodeAccessDeniedException) {
+		log.Printf("[WARN] Skipping SSO Permission Set (%s) per-account provisioning: %s", permissionSetARN, listErr)
+
+		return err
+	}
+
+	if listErr != nil {
+		return errors.Join(err, fmt.Errorf("listing SSO Permission Set (%s) accounts: %w", permissionSetARN, listErr))
+	}
+
+	if len(accountIDs) == 0 {
+		return err
+	}
+
+	var errs []error
+
+	for _, accountID := range accountIDs {
+		if err := provisionPermissionSetToTarget(ctx, conn, permissionSetARN, instanceARN, ssoadmin.ProvisionTargetTypeAwsAccount, accountID, timeout); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Only report per-account failures if any account is still not on the latest version of the permission set.
+	accountIDs, listErr = findAccountsForProvisionedPermissionSet(ctx, conn, permissionSetARN, instanceARN, ssoadmin.ProvisioningStatusLatestPermissionSetNotProvisioned)
+
+	if listErr != nil {
+		return errors.Join(append([]error{err, fmt.Errorf("listing SSO Permission Set (%s) accounts: %w", permissionSetARN, listErr)}, errs...)...)
+	}
+
+	if len(accountIDs) == 0 {
+		return err
+	}
+
+	return errors.Join(append([]error{err, fmt.Errorf("SSO Permission Set (%s) not provisioned to accounts: %s", permissionSetARN, strings.Join(accountIDs, ", "))}, errs...)...)
+}
+
+func provisionPermissionSetToTarget(ctx context.Context, conn *ssoadmin.SSOAdmin, permissionSetARN, instanceARN, targetType, targetID string, timeout time.Duration) error {
 	input := &ssoadmin.ProvisionPermissionSetInput{
 		InstanceArn:      aws.String(instanceARN),
 		PermissionSetArn: aws.String(permissionSetARN),
-		TargetType:       aws.String(ssoadmin.ProvisionTargetTypeAllProvisionedAccounts),
+		TargetType:       aws.String(targetType),
+	}
+
+	if targetID != "" {
+		input.TargetId = aws.String(targetID)
 	}
 
 	output, err := conn.ProvisionPermissionSetWithContext(ctx, input)
 
 	if err != nil {
+		if targetID != "" {
+			return fmt.Errorf("provisioning SSO Permission Set (%s) to account (%s): %w", permissionSetARN, targetID, err)
+		}
+
 		return fmt.Errorf("provisioning SSO Permission Set (%s): %w", permissionSetARN, err)
 	}
 
 	if _, err := waitPermissionSetProvisioned(ctx, conn, instanceARN, aws.StringValue(output.PermissionSetProvisioningStatus.RequestId), timeout); err != nil {
+		if targetID != "" {
+			return fmt.Errorf("waiting for SSO Permission Set (%s) provision to account (%s): %w", permissionSetARN, targetID, err)
+		}
+
 		return fmt.Errorf("waiting for SSO Permission Set (%s) provision: %w", permissionSetARN, err)
 	}
 
 	return nil
 }
 
+func findAccountsForProvisionedPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, permissionSetARN, instanceARN, provisioningStatus string) ([]string, error) {
+	input := &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:        aws.String(instanceARN),
+		PermissionSetArn:   aws.String(permissionSetARN),
+		ProvisioningStatus: aws.String(provisioningStatus),
+	}
+	var output []string
+
+	err := conn.ListAccountsForProvisionedPermissionSetPagesWithContext(ctx, input, func(page *ssoadmin.ListAccountsForProvisionedPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.AccountIds)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func findPermissionSetProvisioningStatus(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceARN, requestID string) (*ssoadmin.PermissionSetProvisioningStatus, error) {
 	input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
 		InstanceArn:                     aws.String(instanceARN),
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/taxsettings"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_taxsettings_registration", name="Registration")
+func ResourceRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRegistrationPut,
+		ReadWithoutTimeout:   resourceRegistrationRead,
+		UpdateWithoutTimeout: resourceRegistrationPut,
+		DeleteWithoutTimeout: resourceRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"certified_email_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"legal_address": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_line_1": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"address_line_2": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"address_line_3": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"country_code": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(2, 2),
+						},
+						"district_or_county": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"postal_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"state_or_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"legal_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"registration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"registration_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(taxsettings.TaxRegistrationType_Values(), false),
+			},
+			"sector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(taxsettings.Sector_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRegistrationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TaxSettingsConn(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &taxsettings.PutTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+		TaxRegistrationEntry: &taxsettings.TaxRegistrationEntry{
+			RegistrationId:   aws.String(d.Get("registration_id").(string)),
+			RegistrationType: aws.String(d.Get("registration_type").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("certified_email_id"); ok {
+		input.TaxRegistrationEntry.CertifiedEmailId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("legal_address"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TaxRegistrationEntry.LegalAddress = expandAddress(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("legal_name"); ok {
+		input.TaxRegistrationEntry.LegalName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sector"); ok {
+		input.TaxRegistrationEntry.Sector = aws.String(v.(string))
+	}
+
+	_, err := conn.PutTaxRegistrationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Tax Settings Registration (%s): %s", accountID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(accountID)
+	}
+
+	return append(diags, resourceRegistrationRead(ctx, d, meta)...)
+}
+
+func resourceRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TaxSettingsConn(ctx)
+
+	registration, err := FindRegistrationByAccountID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Tax Settings Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Tax Settings Registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("certified_email_id", registration.CertifiedEmailId)
+	if err := d.Set("legal_address", flattenAddress(registration.LegalAddress)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting legal_address: %s", err)
+	}
+	d.Set("legal_name", registration.LegalName)
+	d.Set("registration_id", registration.RegistrationId)
+	d.Set("registration_type", registration.RegistrationType)
+	d.Set("sector", registration.Sector)
+	d.Set("status", registration.Status)
+
+	return diags
+}
+
+func resourceRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TaxSettingsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Tax Settings Registration: %s", d.Id())
+	_, err := conn.DeleteTaxRegistrationWithContext(ctx, &taxsettings.DeleteTaxRegistrationInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, taxsettings.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Tax Settings Registration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRegistrationByAccountID(ctx context.Context, conn *taxsettings.TaxSettings, accountID string) (*taxsettings.TaxRegistration, error) {
+	input := &taxsettings.GetTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetTaxRegistrationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, taxsettings.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TaxRegistration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.TaxRegistration.Status); status == taxsettings.TaxRegistrationStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.TaxRegistration, nil
+}
+
+func expandAddress(tfMap map[string]interface{}) *taxsettings.Address {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &taxsettings.Address{}
+
+	if v, ok := tfMap["address_line_1"].(string); ok && v != "" {
+		apiObject.AddressLine1 = aws.String(v)
+	}
+
+	if v, ok := tfMap["address_line_2"].(string); ok && v != "" {
+		apiObject.AddressLine2 = aws.String(v)
+	}
+
+	if v, ok := tfMap["address_line_3"].(string); ok && v != "" {
+		apiObject.AddressLine3 = aws.String(v)
+	}
+
+	if v, ok := tfMap["city"].(string); ok && v != "" {
+		apiObject.City = aws.String(v)
+	}
+
+	if v, ok := tfMap["country_code"].(string); ok && v != "" {
+		apiObject.CountryCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["district_or_county"].(string); ok && v != "" {
+		apiObject.DistrictOrCounty = aws.String(v)
+	}
+
+	if v, ok := tfMap["postal_code"].(string); ok && v != "" {
+		apiObject.PostalCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["state_or_region"].(string); ok && v != "" {
+		apiObject.StateOrRegion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAddress(apiObject *taxsettings.Address) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address_line_1":     aws.StringValue(apiObject.AddressLine1),
+		"address_line_2":     aws.StringValue(apiObject.AddressLine2),
+		"address_line_3":     aws.StringValue(apiObject.AddressLine3),
+		"city":               aws.StringValue(apiObject.City),
+		"country_code":       aws.StringValue(apiObject.CountryCode),
+		"district_or_county": aws.StringValue(apiObject.DistrictOrCounty),
+		"postal_code":        aws.StringValue(apiObject.PostalCode),
+		"state_or_region":    aws.StringValue(apiObject.StateOrRegion),
+	}
+
+	return []interface{}{tfMap}
+}
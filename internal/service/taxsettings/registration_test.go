@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/taxsettings"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarRegistrationID             = "AWS_TAXSETTINGS_REGISTRATION_ID"
+	envVarRegistrationIDMessageError = "Environment variable AWS_TAXSETTINGS_REGISTRATION_ID is not set. " +
+		"Tax registrations are validated by AWS, so a valid VAT registration ID for the test account must be provided."
+)
+
+func TestAccTaxSettingsRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := envvar.SkipIfEmpty(t, envVarRegistrationID, envVarRegistrationIDMessageError)
+	resourceName := "aws_taxsettings_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, taxsettings.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, taxsettings.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistrationConfig_basic(registrationID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistrationExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.country_code", "DE"),
+					resource.TestCheckResourceAttr(resourceName, "registration_id", registrationID),
+					resource.TestCheckResourceAttr(resourceName, "registration_type", "VAT"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRegistrationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsConn(ctx)
+
+		_, err := tftaxsettings.FindRegistrationByAccountID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckRegistrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_registration" {
+				continue
+			}
+
+			_, err := tftaxsettings.FindRegistrationByAccountID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Tax Settings Registration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRegistrationConfig_basic(registrationID string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_registration" "test" {
+  registration_id   = %[1]q
+  registration_type = "VAT"
+  legal_name        = "Example GmbH"
+  sector            = "Business"
+
+  legal_address {
+    address_line_1 = "Unter den Linden 1"
+    city           = "Berlin"
+    country_code   = "DE"
+    postal_code    = "10117"
+  }
+}
+`, registrationID)
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_timestreaminfluxdb_db_parameter_group", name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func ResourceDBParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBParameterGroupCreate,
+		ReadWithoutTimeout:   resourceDBParameterGroupRead,
+		UpdateWithoutTimeout: resourceDBParameterGroupUpdate,
+		DeleteWithoutTimeout: resourceDBParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*(-[0-9A-Za-z]+)*$`), "must start with a letter, contain only alphanumeric characters and hyphens, and must not end with a hyphen or contain two consecutive hyphens"),
+				),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"influxdbv2": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"flux_log_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"log_level": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreaminfluxdb.LogLevel_Values(), false),
+									},
+									"metrics_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"no_tasks": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"query_concurrency": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"query_queue_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"tracing_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreaminfluxdb.TracingType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDBParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbParameterGroupInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Parameters = expandParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDbParameterGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Timestream for InfluxDB DB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceDBParameterGroupRead(ctx, d, meta)...)
+}
+
+func resourceDBParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn(ctx)
+
+	output, err := FindDBParameterGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Timestream for InfluxDB DB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	if output.Parameters != nil {
+		if err := d.Set("parameters", []interface{}{flattenParameters(output.Parameters)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+		}
+	} else {
+		d.Set("parameters", nil)
+	}
+
+	return diags
+}
+
+func resourceDBParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDBParameterGroupRead(ctx, d, meta)
+}
+
+func resourceDBParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The Timestream for InfluxDB API does not support deleting DB parameter groups.
+	log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func FindDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	input := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbParameterGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, timestreaminfluxdb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandParameters(tfMap map[string]interface{}) *timestreaminfluxdb.Parameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreaminfluxdb.Parameters{}
+
+	if v, ok := tfMap["influxdbv2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InfluxDBv2 = expandInfluxDBv2Parameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInfluxDBv2Parameters(tfMap map[string]interface{}) *timestreaminfluxdb.InfluxDBv2Parameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreaminfluxdb.InfluxDBv2Parameters{}
+
+	if v, ok := tfMap["flux_log_enabled"].(bool); ok {
+		apiObject.FluxLogEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["log_level"].(string); ok && v != "" {
+		apiObject.LogLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["metrics_disabled"].(bool); ok {
+		apiObject.MetricsDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["no_tasks"].(bool); ok {
+		apiObject.NoTasks = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["query_concurrency"].(int); ok && v != 0 {
+		apiObject.QueryConcurrency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["query_queue_size"].(int); ok && v != 0 {
+		apiObject.QueryQueueSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["tracing_type"].(string); ok && v != "" {
+		apiObject.TracingType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenParameters(apiObject *timestreaminfluxdb.Parameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InfluxDBv2; v != nil {
+		tfMap["influxdbv2"] = []interface{}{flattenInfluxDBv2Parameters(v)}
+	}
+
+	return tfMap
+}
+
+func flattenInfluxDBv2Parameters(apiObject *timestreaminfluxdb.InfluxDBv2Parameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FluxLogEnabled; v != nil {
+		tfMap["flux_log_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.LogLevel; v != nil {
+		tfMap["log_level"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MetricsDisabled; v != nil {
+		tfMap["metrics_disabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.NoTasks; v != nil {
+		tfMap["no_tasks"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.QueryConcurrency; v != nil {
+		tfMap["query_concurrency"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.QueryQueueSize; v != nil {
+		tfMap["query_queue_size"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TracingType; v != nil {
+		tfMap["tracing_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tnb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_tnb_network_instance", name="Network Instance")
+// @Tags(identifierAttribute="arn")
+func ResourceNetworkInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInstanceCreate,
+		ReadWithoutTimeout:   resourceNetworkInstanceRead,
+		UpdateWithoutTimeout: resourceNetworkInstanceUpdate,
+		DeleteWithoutTimeout: resourceNetworkInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instantiate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"network_package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nsd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceNetworkInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &tnb.CreateSolNetworkInstanceInput{
+		NsName:    aws.String(name),
+		NsdInfoId: aws.String(d.Get("network_package_id").(string)),
+		Tags:      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.NsDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSolNetworkInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating TNB Network Instance (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if d.Get("instantiate").(bool) {
+		if err := instantiateNetworkInstance(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceNetworkInstanceRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	output, err := FindNetworkInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] TNB Network Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Network Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.NsInstanceDescription)
+	d.Set("instantiate", aws.StringValue(output.NsState) != tnb.NsStateNotInstantiated)
+	d.Set("name", output.NsInstanceName)
+	d.Set("network_package_id", output.NsdInfoId)
+	d.Set("nsd_id", output.NsdId)
+	d.Set("state", output.NsState)
+
+	return diags
+}
+
+func resourceNetworkInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	if d.HasChange("instantiate") {
+		var err error
+
+		if d.Get("instantiate").(bool) {
+			err = instantiateNetworkInstance(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = terminateNetworkInstance(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceNetworkInstanceRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	// An instantiated network instance must be terminated before it can be deleted.
+	if state := d.Get("state").(string); state != "" && state != tnb.NsStateNotInstantiated {
+		err := terminateNetworkInstance(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+		if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting TNB Network Instance: %s", d.Id())
+	_, err := conn.DeleteSolNetworkInstanceWithContext(ctx, &tnb.DeleteSolNetworkInstanceInput{
+		NsInstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting TNB Network Instance (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func instantiateNetworkInstance(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) error {
+	output, err := conn.InstantiateSolNetworkInstanceWithContext(ctx, &tnb.InstantiateSolNetworkInstanceInput{
+		NsInstanceId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("instantiating TNB Network Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitNetworkOperationCompleted(ctx, conn, aws.StringValue(output.NsLcmOpOccId), timeout); err != nil {
+		return fmt.Errorf("waiting for TNB Network Instance (%s) instantiate: %w", id, err)
+	}
+
+	return nil
+}
+
+func terminateNetworkInstance(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) error {
+	output, err := conn.TerminateSolNetworkInstanceWithContext(ctx, &tnb.TerminateSolNetworkInstanceInput{
+		NsInstanceId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("terminating TNB Network Instance (%s): %w", id, err)
+	}
+
+	if v := aws.StringValue(output.NsLcmOpOccId); v != "" {
+		if _, err := waitNetworkOperationCompleted(ctx, conn, v, timeout); err != nil {
+			return fmt.Errorf("waiting for TNB Network Instance (%s) terminate: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func FindNetworkInstanceByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkInstanceOutput, error) {
+	input := &tnb.GetSolNetworkInstanceInput{
+		NsInstanceId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.NsState); state == tnb.NsStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findNetworkOperationByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkOperationOutput, error) {
+	input := &tnb.GetSolNetworkOperationInput{
+		NsLcmOpOccId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusNetworkOperation(ctx context.Context, conn *tnb.Tnb, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findNetworkOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.OperationState), nil
+	}
+}
+
+func waitNetworkOperationCompleted(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) (*tnb.GetSolNetworkOperationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tnb.NsLcmOperationStateProcessing, tnb.NsLcmOperationStateCancelling},
+		Target:  []string{tnb.NsLcmOperationStateCompleted},
+		Refresh: statusNetworkOperation(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*tnb.GetSolNetworkOperationOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Detail)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
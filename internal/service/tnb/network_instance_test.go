@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tnb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/tnb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tftnb "github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarNetworkPackageID             = "AWS_TNB_NETWORK_PACKAGE_ID"
+	envVarNetworkPackageIDMessageError = "Environment variable AWS_TNB_NETWORK_PACKAGE_ID is not set. " +
+		"To properly test network instances the ID of an onboarded and enabled network package must be provided."
+)
+
+func TestAccTNBNetworkInstance_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	packageID := envvar.SkipIfEmpty(t, envVarNetworkPackageID, envVarNetworkPackageIDMessageError)
+	var v tnb.GetSolNetworkInstanceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tnb_network_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, tnb.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInstanceConfig_basic(rName, packageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "instantiate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_package_id", packageID),
+					resource.TestCheckResourceAttrSet(resourceName, "nsd_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "NOT_INSTANTIATED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTNBNetworkInstance_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	packageID := envvar.SkipIfEmpty(t, envVarNetworkPackageID, envVarNetworkPackageIDMessageError)
+	var v tnb.GetSolNetworkInstanceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tnb_network_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, tnb.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInstanceConfig_basic(rName, packageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftnb.ResourceNetworkInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTNBNetworkInstance_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	packageID := envvar.SkipIfEmpty(t, envVarNetworkPackageID, envVarNetworkPackageIDMessageError)
+	var v tnb.GetSolNetworkInstanceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tnb_network_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, tnb.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInstanceConfig_tags1(rName, packageID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInstanceConfig_tags2(rName, packageID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkInstanceConfig_tags1(rName, packageID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInstanceExists(ctx context.Context, n string, v *tnb.GetSolNetworkInstanceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn(ctx)
+
+		output, err := tftnb.FindNetworkInstanceByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_tnb_network_instance" {
+				continue
+			}
+
+			_, err := tftnb.FindNetworkInstanceByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("TNB Network Instance %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNetworkInstanceConfig_basic(rName, packageID string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_network_instance" "test" {
+  name               = %[1]q
+  description        = "test"
+  network_package_id = %[2]q
+}
+`, rName, packageID)
+}
+
+func testAccNetworkInstanceConfig_tags1(rName, packageID, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_network_instance" "test" {
+  name               = %[1]q
+  network_package_id = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, packageID, tagKey1, tagValue1)
+}
+
+func testAccNetworkInstanceConfig_tags2(rName, packageID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_network_instance" "test" {
+  name               = %[1]q
+  network_package_id = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, packageID, tagKey1, tagValue1, tagKey2, tagValue2)
+}
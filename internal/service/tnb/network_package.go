@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tnb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_tnb_network_package", name="Network Package")
+// @Tags(identifierAttribute="arn")
+func ResourceNetworkPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkPackageCreate,
+		ReadWithoutTimeout:   resourceNetworkPackageRead,
+		UpdateWithoutTimeout: resourceNetworkPackageUpdate,
+		DeleteWithoutTimeout: resourceNetworkPackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"onboarding_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operational_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tnb.NsdOperationalState_Values(), false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_hash": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source"},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"usage_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnf_package_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	input := &tnb.CreateSolNetworkPackageInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateSolNetworkPackageWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating TNB Network Package: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if v, ok := d.GetOk("source"); ok {
+		file, err := readFileContents(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading TNB Network Package (%s) source: %s", d.Id(), err)
+		}
+
+		input := &tnb.PutSolNetworkPackageContentInput{
+			ContentType: aws.String(tnb.PackageContentTypeApplicationZip),
+			File:        file,
+			NsdInfoId:   aws.String(d.Id()),
+		}
+
+		_, err = conn.PutSolNetworkPackageContentWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading TNB Network Package (%s) content: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("operational_state"); ok && v.(string) == tnb.NsdOperationalStateDisabled {
+		if err := updateNetworkPackageOperationalState(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceNetworkPackageRead(ctx, d, meta)...)
+}
+
+func resourceNetworkPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	output, err := FindNetworkPackageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] TNB Network Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Network Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("nsd_id", output.NsdId)
+	d.Set("nsd_name", output.NsdName)
+	d.Set("nsd_version", output.NsdVersion)
+	d.Set("onboarding_state", output.NsdOnboardingState)
+	d.Set("operational_state", output.NsdOperationalState)
+	d.Set("usage_state", output.NsdUsageState)
+	d.Set("vnf_package_ids", aws.StringValueSlice(output.VnfPkgIds))
+
+	return diags
+}
+
+func resourceNetworkPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	if d.HasChange("operational_state") {
+		if err := updateNetworkPackageOperationalState(ctx, conn, d.Id(), d.Get("operational_state").(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceNetworkPackageRead(ctx, d, meta)...)
+}
+
+func resourceNetworkPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn(ctx)
+
+	// A network package must be disabled before it can be deleted.
+	if d.Get("operational_state").(string) == tnb.NsdOperationalStateEnabled {
+		err := updateNetworkPackageOperationalState(ctx, conn, d.Id(), tnb.NsdOperationalStateDisabled)
+
+		if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting TNB Network Package: %s", d.Id())
+	_, err := conn.DeleteSolNetworkPackageWithContext(ctx, &tnb.DeleteSolNetworkPackageInput{
+		NsdInfoId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting TNB Network Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateNetworkPackageOperationalState(ctx context.Context, conn *tnb.Tnb, id, state string) error {
+	input := &tnb.UpdateSolNetworkPackageInput{
+		NsdInfoId:           aws.String(id),
+		NsdOperationalState: aws.String(state),
+	}
+
+	_, err := conn.UpdateSolNetworkPackageWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating TNB Network Package (%s) operational state (%s): %w", id, state, err)
+	}
+
+	return nil
+}
+
+func FindNetworkPackageByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkPackageOutput, error) {
+	input := &tnb.GetSolNetworkPackageInput{
+		NsdInfoId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func readFileContents(v string) ([]byte, error) {
+	filename, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+	fileContent, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return fileContent, nil
+}
@@ -13,6 +13,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -26,6 +27,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_transfer_server", name="Server")
@@ -51,6 +53,7 @@ func ResourceServer() *schema.Resource {
 
 				return false
 			}),
+			resourceServerCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -430,6 +433,8 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	diags = append(diags, serverIdentityProviderWarnings(d)...)
+
 	return append(diags, resourceServerRead(ctx, d, meta)...)
 }
 
@@ -730,6 +735,10 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
+
+		if d.HasChanges("directory_id", "function", "invocation_role", "url") {
+			diags = append(diags, serverIdentityProviderWarnings(d)...)
+		}
 	}
 
 	return append(diags, resourceServerRead(ctx, d, meta)...)
@@ -798,6 +807,70 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// serverIdentityProviderAttributes are the attributes that configure each identity provider type.
+var serverIdentityProviderAttributes = map[string][]string{
+	transfer.IdentityProviderTypeApiGateway:          {"invocation_role", "url"},
+	transfer.IdentityProviderTypeAwsDirectoryService: {"directory_id"},
+	transfer.IdentityProviderTypeAwsLambda:           {"function"},
+	transfer.IdentityProviderTypeServiceManaged:      {},
+}
+
+func resourceServerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("identity_provider_type") {
+		return nil
+	}
+
+	// Catch missing attributes at plan time rather than when users fail to log in.
+	identityProviderType := diff.Get("identity_provider_type").(string)
+	attributes, ok := serverIdentityProviderAttributes[identityProviderType]
+
+	if !ok {
+		return nil
+	}
+
+	for _, k := range attributes {
+		if !diff.NewValueKnown(k) {
+			continue
+		}
+
+		if v := diff.Get(k).(string); v == "" {
+			return fmt.Errorf("%q must be set when identity_provider_type is %q", k, identityProviderType)
+		}
+	}
+
+	return nil
+}
+
+// serverIdentityProviderWarnings warns about attributes that are set but not used by the identity provider type.
+// The API accepts them, so they are not rejected at plan time.
+func serverIdentityProviderWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	identityProviderType := d.Get("identity_provider_type").(string)
+	attributes, ok := serverIdentityProviderAttributes[identityProviderType]
+
+	if !ok {
+		return diags
+	}
+
+	for _, k := range []string{"directory_id", "function", "invocation_role", "url"} {
+		if slices.Contains(attributes, k) {
+			continue
+		}
+
+		if v := d.Get(k).(string); v != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Unused identity provider argument",
+				Detail:        fmt.Sprintf("%q is not used when identity_provider_type is %q.", k, identityProviderType),
+				AttributePath: cty.GetAttrPath(k),
+			})
+		}
+	}
+
+	return diags
+}
+
 func expandEndpointDetails(tfMap map[string]interface{}) *transfer.EndpointDetails {
 	if tfMap == nil {
 		return nil
@@ -13,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -1126,6 +1128,101 @@ func testAccServer_lambdaFunction(t *testing.T) {
 	})
 }
 
+func TestServerIdentityProviderWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config       map[string]interface{}
+		wantWarnings []string
+	}{
+		"service managed": {
+			config: map[string]interface{}{
+				"identity_provider_type": transfer.IdentityProviderTypeServiceManaged,
+			},
+		},
+		"service managed with url": {
+			config: map[string]interface{}{
+				"identity_provider_type": transfer.IdentityProviderTypeServiceManaged,
+				"url":                    "https://example.com",
+			},
+			wantWarnings: []string{`"url" is not used when identity_provider_type is "SERVICE_MANAGED".`},
+		},
+		"api gateway": {
+			config: map[string]interface{}{
+				"identity_provider_type": transfer.IdentityProviderTypeApiGateway,
+				"invocation_role":        "arn:aws:iam::123456789012:role/test", // lintignore:AWSAT005
+				"url":                    "https://example.com",
+			},
+		},
+		"lambda with directory and url": {
+			config: map[string]interface{}{
+				"directory_id":           "d-1234567890",
+				"function":               "arn:aws:lambda:us-west-2:123456789012:function:test", // lintignore:AWSAT003,AWSAT005
+				"identity_provider_type": transfer.IdentityProviderTypeAwsLambda,
+				"url":                    "https://example.com",
+			},
+			wantWarnings: []string{
+				`"directory_id" is not used when identity_provider_type is "AWS_LAMBDA".`,
+				`"url" is not used when identity_provider_type is "AWS_LAMBDA".`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tftransfer.ResourceServer().Schema, testCase.config)
+
+			diags := tftransfer.ServerIdentityProviderWarnings(d)
+
+			if got, want := len(diags), len(testCase.wantWarnings); got != want {
+				t.Fatalf("got %d warnings, want %d: %v", got, want, diags)
+			}
+
+			for i, v := range diags {
+				if v.Severity != diag.Warning {
+					t.Errorf("got severity %v, want warning", v.Severity)
+				}
+
+				if got, want := v.Detail, testCase.wantWarnings[i]; got != want {
+					t.Errorf("got detail %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func testAccServer_identityProviderValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerConfig_identityProviderValidation(rName, "AWS_LAMBDA", "null"),
+				ExpectError: regexache.MustCompile(`"function" must be set when identity_provider_type is "AWS_LAMBDA"`),
+			},
+			{
+				Config:      testAccServerConfig_identityProviderValidation(rName, "API_GATEWAY", `"https://example.com"`),
+				ExpectError: regexache.MustCompile(`"invocation_role" must be set when identity_provider_type is "API_GATEWAY"`),
+			},
+			// Unused arguments are accepted by the API and only reported as warnings on apply, see TestServerIdentityProviderWarnings.
+			{
+				Config:             testAccServerConfig_identityProviderValidation(rName, "SERVICE_MANAGED", `"https://example.com"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccServer_authenticationLoginBanners(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf transfer.DescribedServer
@@ -2054,6 +2151,19 @@ resource "aws_transfer_server" "test" {
 `, rName, forceDestroy))
 }
 
+func testAccServerConfig_identityProviderValidation(rName, identityProviderType, url string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  identity_provider_type = %[2]q
+  url                    = %[3]s
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, identityProviderType, url)
+}
+
 func testAccServerConfig_workflow(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceServer,
 			TypeName: "aws_transfer_server",
 		},
+		{
+			Factory:  DataSourceTestIdentityProvider,
+			TypeName: "aws_transfer_test_identity_provider",
+		},
 	}
 }
 
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_transfer_test_identity_provider")
+func DataSourceTestIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTestIdentityProviderRead,
+
+		Schema: map[string]*schema.Schema{
+			"fail_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"server_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(transfer.Protocol_Values(), false),
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"status_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 100),
+			},
+			"user_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+		},
+	}
+}
+
+func dataSourceTestIdentityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+	input := &transfer.TestIdentityProviderInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("server_protocol"); ok {
+		input.ServerProtocol = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		input.SourceIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_password"); ok {
+		input.UserPassword = aws.String(v.(string))
+	}
+
+	output, err := conn.TestIdentityProviderWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Server (%s) identity provider: %s", serverID, err)
+	}
+
+	statusCode := aws.Int64Value(output.StatusCode)
+
+	if d.Get("fail_on_error").(bool) && statusCode != http.StatusOK {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Server (%s) identity provider for user (%s): status code %d: %s", serverID, userName, statusCode, aws.StringValue(output.Message))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serverID, userName))
+	d.Set("message", output.Message)
+	d.Set("response", output.Response)
+	d.Set("status_code", statusCode)
+	d.Set("url", output.Url)
+
+	return diags
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTestIdentityProviderDataSource_lambdaFunction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_transfer_test_identity_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestIdentityProviderDataSourceConfig_lambdaFunction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "status_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTestIdentityProviderDataSourceConfig_lambdaFunction(rName string) string {
+	return acctest.ConfigCompose(testAccServerConfig_lambdaFunctionIdentityProviderType(rName, false), `
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "transfer.amazonaws.com"
+  source_arn    = aws_transfer_server.test.arn
+}
+
+data "aws_transfer_test_identity_provider" "test" {
+  server_id       = aws_transfer_server.test.id
+  server_protocol = "SFTP"
+  source_ip       = "127.0.0.1"
+  user_name       = "test-user"
+  user_password   = "test-password"
+  fail_on_error   = false
+
+  depends_on = [aws_lambda_permission.test]
+}
+`)
+}
@@ -38,6 +38,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"Domain":                        testAccServer_domain,
 			"ForceDestroy":                  testAccServer_forceDestroy,
 			"HostKey":                       testAccServer_hostKey,
+			"IdentityProviderValidation":    testAccServer_identityProviderValidation,
 			"LambdaFunction":                testAccServer_lambdaFunction,
 			"Protocols":                     testAccServer_protocols,
 			"ProtocolDetails":               testAccServer_protocolDetails,
@@ -65,6 +66,9 @@ func TestAccTransfer_serial(t *testing.T) {
 			"Value":      testAccTag_value,
 			"System":     testAccTag_system,
 		},
+		"TestIdentityProvider": {
+			"DataSourceLambdaFunction": testAccTestIdentityProviderDataSource_lambdaFunction,
+		},
 		"User": {
 			"basic":                 testAccUser_basic,
 			"disappears":            testAccUser_disappears,
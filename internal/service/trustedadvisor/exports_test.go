@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+// Exports for use in tests only.
+var (
+	FindExcludedRecommendationResourceByTwoPartKey = findExcludedRecommendationResourceByTwoPartKey
+)
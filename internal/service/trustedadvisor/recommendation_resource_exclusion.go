@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/trustedadvisor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_trustedadvisor_recommendation_resource_exclusion", name="Recommendation Resource Exclusion")
+func ResourceRecommendationResourceExclusion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecommendationResourceExclusionCreate,
+		ReadWithoutTimeout:   resourceRecommendationResourceExclusionRead,
+		DeleteWithoutTimeout: resourceRecommendationResourceExclusionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommendation_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(20, 200),
+			},
+			"recommendation_resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"region_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	recommendationResourceExclusionResourceIDPartCount = 2
+)
+
+func resourceRecommendationResourceExclusionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+	recommendationID := d.Get("recommendation_identifier").(string)
+	resourceARN := d.Get("recommendation_resource_arn").(string)
+	id, err := flex.FlattenResourceId([]string{recommendationID, resourceARN}, recommendationResourceExclusionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := updateRecommendationResourceExclusion(ctx, conn, resourceARN, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Trusted Advisor Recommendation Resource Exclusion (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceRecommendationResourceExclusionRead(ctx, d, meta)...)
+}
+
+func resourceRecommendationResourceExclusionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), recommendationResourceExclusionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	recommendationID, resourceARN := parts[0], parts[1]
+	resource, err := findExcludedRecommendationResourceByTwoPartKey(ctx, conn, recommendationID, resourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Trusted Advisor Recommendation Resource Exclusion (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Trusted Advisor Recommendation Resource Exclusion (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_resource_id", resource.AwsResourceId)
+	d.Set("recommendation_identifier", recommendationID)
+	d.Set("recommendation_resource_arn", resource.Arn)
+	d.Set("region_code", resource.RegionCode)
+	d.Set("status", resource.Status)
+
+	return diags
+}
+
+func resourceRecommendationResourceExclusionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+	log.Printf("[INFO] Deleting Trusted Advisor Recommendation Resource Exclusion: %s", d.Id())
+	err := updateRecommendationResourceExclusion(ctx, conn, d.Get("recommendation_resource_arn").(string), false)
+
+	if tfawserr.ErrCodeEquals(err, trustedadvisor.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Trusted Advisor Recommendation Resource Exclusion (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateRecommendationResourceExclusion(ctx context.Context, conn *trustedadvisor.TrustedAdvisor, arn string, excluded bool) error {
+	input := &trustedadvisor.BatchUpdateRecommendationResourceExclusionInput{
+		RecommendationResourceExclusions: []*trustedadvisor.RecommendationResourceExclusion{{
+			Arn:        aws.String(arn),
+			IsExcluded: aws.Bool(excluded),
+		}},
+	}
+
+	output, err := conn.BatchUpdateRecommendationResourceExclusionWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, v := range output.BatchUpdateRecommendationResourceExclusionErrors {
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findRecommendationResources(ctx context.Context, conn *trustedadvisor.TrustedAdvisor, input *trustedadvisor.ListRecommendationResourcesInput) ([]*trustedadvisor.RecommendationResourceSummary, error) {
+	var output []*trustedadvisor.RecommendationResourceSummary
+
+	err := conn.ListRecommendationResourcesPagesWithContext(ctx, input, func(page *trustedadvisor.ListRecommendationResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RecommendationResourceSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, trustedadvisor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findExcludedRecommendationResourceByTwoPartKey(ctx context.Context, conn *trustedadvisor.TrustedAdvisor, recommendationID, resourceARN string) (*trustedadvisor.RecommendationResourceSummary, error) {
+	input := &trustedadvisor.ListRecommendationResourcesInput{
+		ExclusionStatus:          aws.String(trustedadvisor.ExclusionStatusExcluded),
+		RecommendationIdentifier: aws.String(recommendationID),
+	}
+
+	output, err := findRecommendationResources(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.Arn) == resourceARN {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/trustedadvisor"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftrustedadvisor "github.com/hashicorp/terraform-provider-aws/internal/service/trustedadvisor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	envVarRecommendationID             = "AWS_TRUSTEDADVISOR_RECOMMENDATION_ID"
+	envVarRecommendationIDMessageError = "Environment variable AWS_TRUSTEDADVISOR_RECOMMENDATION_ID is not set. " +
+		"It must be the ID of a Trusted Advisor recommendation with at least one flagged resource."
+	envVarRecommendationResourceARN             = "AWS_TRUSTEDADVISOR_RECOMMENDATION_RESOURCE_ARN"
+	envVarRecommendationResourceARNMessageError = "Environment variable AWS_TRUSTEDADVISOR_RECOMMENDATION_RESOURCE_ARN is not set. " +
+		"It must be the ARN of a resource flagged by the recommendation identified by AWS_TRUSTEDADVISOR_RECOMMENDATION_ID."
+)
+
+func TestAccTrustedAdvisorRecommendationResourceExclusion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	recommendationID := envvar.SkipIfEmpty(t, envVarRecommendationID, envVarRecommendationIDMessageError)
+	resourceARN := envvar.SkipIfEmpty(t, envVarRecommendationResourceARN, envVarRecommendationResourceARNMessageError)
+	resourceName := "aws_trustedadvisor_recommendation_resource_exclusion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, trustedadvisor.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, trustedadvisor.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationResourceExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationResourceExclusionConfig_basic(recommendationID, resourceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationResourceExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "aws_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "recommendation_identifier", recommendationID),
+					resource.TestCheckResourceAttr(resourceName, "recommendation_resource_arn", resourceARN),
+					resource.TestCheckResourceAttrSet(resourceName, "region_code"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTrustedAdvisorRecommendationResourceExclusion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	recommendationID := envvar.SkipIfEmpty(t, envVarRecommendationID, envVarRecommendationIDMessageError)
+	resourceARN := envvar.SkipIfEmpty(t, envVarRecommendationResourceARN, envVarRecommendationResourceARNMessageError)
+	resourceName := "aws_trustedadvisor_recommendation_resource_exclusion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, trustedadvisor.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, trustedadvisor.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationResourceExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationResourceExclusionConfig_basic(recommendationID, resourceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationResourceExclusionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftrustedadvisor.ResourceRecommendationResourceExclusion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationResourceExclusionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+		_, err = tftrustedadvisor.FindExcludedRecommendationResourceByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckRecommendationResourceExclusionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_trustedadvisor_recommendation_resource_exclusion" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tftrustedadvisor.FindExcludedRecommendationResourceByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Trusted Advisor Recommendation Resource Exclusion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecommendationResourceExclusionConfig_basic(recommendationID, resourceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_trustedadvisor_recommendation_resource_exclusion" "test" {
+  recommendation_identifier   = %[1]q
+  recommendation_resource_arn = %[2]q
+}
+`, recommendationID, resourceARN)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/trustedadvisor"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_trustedadvisor_recommendation_resources", name="Recommendation Resources")
+func DataSourceRecommendationResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecommendationResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclusion_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(trustedadvisor.ExclusionStatus_Values(), false),
+			},
+			"recommendation_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(20, 200),
+			},
+			"region_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exclusion_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"recommendation_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(trustedadvisor.ResourceStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceRecommendationResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+	recommendationID := d.Get("recommendation_identifier").(string)
+	input := &trustedadvisor.ListRecommendationResourcesInput{
+		RecommendationIdentifier: aws.String(recommendationID),
+	}
+
+	if v, ok := d.GetOk("exclusion_status"); ok {
+		input.ExclusionStatus = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("region_code"); ok {
+		input.RegionCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	resources, err := findRecommendationResources(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Trusted Advisor Recommendation (%s) Resources: %s", recommendationID, err)
+	}
+
+	d.SetId(recommendationID)
+
+	if err := d.Set("resources", flattenRecommendationResourceSummaries(resources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+	}
+
+	return diags
+}
+
+func flattenRecommendationResourceSummaries(apiObjects []*trustedadvisor.RecommendationResourceSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":                aws.StringValue(apiObject.Arn),
+			"aws_resource_id":    aws.StringValue(apiObject.AwsResourceId),
+			"exclusion_status":   aws.StringValue(apiObject.ExclusionStatus),
+			"id":                 aws.StringValue(apiObject.Id),
+			"metadata":           aws.StringValueMap(apiObject.Metadata),
+			"recommendation_arn": aws.StringValue(apiObject.RecommendationArn),
+			"region_code":        aws.StringValue(apiObject.RegionCode),
+			"status":             aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.LastUpdatedAt; v != nil {
+			tfMap["last_updated_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/trustedadvisor"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestAccTrustedAdvisorRecommendationResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	recommendationID := envvar.SkipIfEmpty(t, envVarRecommendationID, envVarRecommendationIDMessageError)
+	dataSourceName := "data.aws_trustedadvisor_recommendation_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, trustedadvisor.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, trustedadvisor.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationResourcesDataSourceConfig_basic(recommendationID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", recommendationID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.aws_resource_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationResourcesDataSourceConfig_basic(recommendationID string) string {
+	return fmt.Sprintf(`
+data "aws_trustedadvisor_recommendation_resources" "test" {
+  recommendation_identifier = %[1]q
+}
+`, recommendationID)
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/trustedadvisor"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_trustedadvisor_recommendations", name="Recommendations")
+func DataSourceRecommendations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecommendationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"aws_service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 30),
+			},
+			"check_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(20, 200),
+			},
+			"pillar": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(trustedadvisor.RecommendationPillar_Values(), false),
+			},
+			"recommendations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"check_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pillar_specific_aggregates": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cost_optimizing": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"estimated_monthly_savings": {
+													Type:     schema.TypeFloat,
+													Computed: true,
+												},
+												"estimated_percent_monthly_savings": {
+													Type:     schema.TypeFloat,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"pillars": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resources_aggregates": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"ok_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"warning_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(trustedadvisor.RecommendationSource_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(trustedadvisor.RecommendationStatus_Values(), false),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(trustedadvisor.RecommendationType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceRecommendationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorConn(ctx)
+
+	input := &trustedadvisor.ListRecommendationsInput{}
+
+	if v, ok := d.GetOk("aws_service"); ok {
+		input.AwsService = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("check_identifier"); ok {
+		input.CheckIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pillar"); ok {
+		input.Pillar = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		input.Source = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	recommendations, err := findRecommendations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Trusted Advisor Recommendations: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	if err := d.Set("recommendations", flattenRecommendationSummaries(recommendations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recommendations: %s", err)
+	}
+
+	return diags
+}
+
+func findRecommendations(ctx context.Context, conn *trustedadvisor.TrustedAdvisor, input *trustedadvisor.ListRecommendationsInput) ([]*trustedadvisor.RecommendationSummary, error) {
+	var output []*trustedadvisor.RecommendationSummary
+
+	err := conn.ListRecommendationsPagesWithContext(ctx, input, func(page *trustedadvisor.ListRecommendationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RecommendationSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenRecommendationSummaries(apiObjects []*trustedadvisor.RecommendationSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":             aws.StringValue(apiObject.Arn),
+			"aws_services":    aws.StringValueSlice(apiObject.AwsServices),
+			"check_arn":       aws.StringValue(apiObject.CheckArn),
+			"id":              aws.StringValue(apiObject.Id),
+			"lifecycle_stage": aws.StringValue(apiObject.LifecycleStage),
+			"name":            aws.StringValue(apiObject.Name),
+			"pillars":         aws.StringValueSlice(apiObject.Pillars),
+			"source":          aws.StringValue(apiObject.Source),
+			"status":          aws.StringValue(apiObject.Status),
+			"type":            aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.CreatedAt; v != nil {
+			tfMap["created_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastUpdatedAt; v != nil {
+			tfMap["last_updated_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.PillarSpecificAggregates; v != nil {
+			tfMap["pillar_specific_aggregates"] = flattenRecommendationPillarSpecificAggregates(v)
+		}
+
+		if v := apiObject.ResourcesAggregates; v != nil {
+			tfMap["resources_aggregates"] = flattenRecommendationResourcesAggregates(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenRecommendationPillarSpecificAggregates(apiObject *trustedadvisor.RecommendationPillarSpecificAggregates) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CostOptimizing; v != nil {
+		tfMap["cost_optimizing"] = []interface{}{map[string]interface{}{
+			"estimated_monthly_savings":         aws.Float64Value(v.EstimatedMonthlySavings),
+			"estimated_percent_monthly_savings": aws.Float64Value(v.EstimatedPercentMonthlySavings),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRecommendationResourcesAggregates(apiObject *trustedadvisor.RecommendationResourcesAggregates) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"error_count":   aws.Int64Value(apiObject.ErrorCount),
+		"ok_count":      aws.Int64Value(apiObject.OkCount),
+		"warning_count": aws.Int64Value(apiObject.WarningCount),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/trustedadvisor"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccTrustedAdvisorRecommendationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_trustedadvisor_recommendations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, trustedadvisor.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, trustedadvisor.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTrustedAdvisorRecommendationsDataSource_pillar(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_trustedadvisor_recommendations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, trustedadvisor.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, trustedadvisor.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_pillar,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "pillar", "cost_optimizing"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRecommendationsDataSourceConfig_basic = `
+data "aws_trustedadvisor_recommendations" "test" {}
+`
+
+const testAccRecommendationsDataSourceConfig_pillar = `
+data "aws_trustedadvisor_recommendations" "test" {
+  pillar = "cost_optimizing"
+}
+`
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	trustedadvisor_sdkv1 "github.com/aws/aws-sdk-go/service/trustedadvisor"
+)
+
+// NewConn returns a new AWS SDK for Go v1 client for this service package's AWS API.
+func (p *servicePackage) NewConn(ctx context.Context, m map[string]any) (*trustedadvisor_sdkv1.TrustedAdvisor, error) {
+	sess := m["session"].(*session_sdkv1.Session)
+	config := &aws_sdkv1.Config{Endpoint: aws_sdkv1.String(m["endpoint"].(string))}
+
+	// Force "global" services to correct Regions.
+	if m["partition"].(string) == endpoints_sdkv1.AwsPartitionID {
+		config.Region = aws_sdkv1.String(endpoints_sdkv1.UsEast1RegionID)
+	}
+
+	return trustedadvisor_sdkv1.New(sess.Copy(config)), nil
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_verifiedpermissions_policies", name="Policies")
+func ResourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePoliciesCreate,
+		ReadWithoutTimeout:   resourcePoliciesRead,
+		UpdateWithoutTimeout: resourcePoliciesUpdate,
+		DeleteWithoutTimeout: resourcePoliciesDelete,
+
+		CustomizeDiff: resourcePoliciesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"directory": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"statements": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourcePoliciesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(id.UniqueId())
+
+	return resourcePoliciesUpdate(ctx, d, meta)
+}
+
+func resourcePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	policyIDs := flex.ExpandStringValueMap(d.Get("policy_ids").(map[string]interface{}))
+	statements := make(map[string]string, len(policyIDs))
+
+	for file, policyID := range policyIDs {
+		output, err := FindPolicyByTwoPartKey(ctx, conn, policyID, policyStoreID)
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			// Dropping the file from state causes the policy to be recreated on the next apply.
+			log.Printf("[WARN] Verified Permissions Policy (%s) for %s not found, removing from state", policyID, file)
+			delete(policyIDs, file)
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Policy (%s) for %s: %s", policyID, file, err)
+		}
+
+		static, ok := output.Definition.(*awstypes.PolicyDefinitionDetailMemberStatic)
+
+		if !ok {
+			return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Policy (%s) for %s: unsupported policy type %s", policyID, file, output.PolicyType)
+		}
+
+		statements[file] = strings.TrimSpace(aws.ToString(static.Value.Statement))
+	}
+
+	d.Set("policy_ids", policyIDs)
+	d.Set("statements", statements)
+
+	return diags
+}
+
+func resourcePoliciesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	o, n := d.GetChange("statements")
+	oldStatements := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	newStatements := flex.ExpandStringValueMap(n.(map[string]interface{}))
+	policyIDs := flex.ExpandStringValueMap(d.Get("policy_ids").(map[string]interface{}))
+
+	// Record progress in state if any individual policy operation fails.
+	d.Partial(true)
+
+	for file, policyID := range policyIDs {
+		if _, ok := newStatements[file]; ok {
+			continue
+		}
+
+		if err := deletePolicy(ctx, conn, policyID, policyStoreID); err != nil {
+			d.Set("policy_ids", policyIDs)
+			return sdkdiag.AppendErrorf(diags, "deleting Verified Permissions Policy (%s) for %s: %s", policyID, file, err)
+		}
+
+		delete(policyIDs, file)
+	}
+
+	for file, statement := range newStatements {
+		policyID, ok := policyIDs[file]
+
+		if !ok {
+			output, err := conn.CreatePolicy(ctx, &verifiedpermissions.CreatePolicyInput{
+				Definition: &awstypes.PolicyDefinitionMemberStatic{
+					Value: awstypes.StaticPolicyDefinition{
+						Description: aws.String(file),
+						Statement:   aws.String(statement),
+					},
+				},
+				PolicyStoreId: aws.String(policyStoreID),
+			})
+
+			if err != nil {
+				d.Set("policy_ids", policyIDs)
+				return sdkdiag.AppendErrorf(diags, "creating Verified Permissions Policy (%s) for %s: %s", policyStoreID, file, err)
+			}
+
+			policyIDs[file] = aws.ToString(output.PolicyId)
+			continue
+		}
+
+		if old, ok := oldStatements[file]; ok && old == statement {
+			continue
+		}
+
+		_, err := conn.UpdatePolicy(ctx, &verifiedpermissions.UpdatePolicyInput{
+			Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+				Value: awstypes.UpdateStaticPolicyDefinition{
+					Description: aws.String(file),
+					Statement:   aws.String(statement),
+				},
+			},
+			PolicyId:      aws.String(policyID),
+			PolicyStoreId: aws.String(policyStoreID),
+		})
+
+		if err != nil {
+			d.Set("policy_ids", policyIDs)
+			return sdkdiag.AppendErrorf(diags, "updating Verified Permissions Policy (%s) for %s: %s", policyID, file, err)
+		}
+	}
+
+	d.Partial(false)
+	d.Set("policy_ids", policyIDs)
+
+	return append(diags, resourcePoliciesRead(ctx, d, meta)...)
+}
+
+func resourcePoliciesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	policyStoreID := d.Get("policy_store_id").(string)
+
+	for file, policyID := range flex.ExpandStringValueMap(d.Get("policy_ids").(map[string]interface{})) {
+		log.Printf("[DEBUG] Deleting Verified Permissions Policy (%s) for %s", policyID, file)
+		if err := deletePolicy(ctx, conn, policyID, policyStoreID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Verified Permissions Policy (%s) for %s: %s", policyID, file, err)
+		}
+	}
+
+	return diags
+}
+
+// resourcePoliciesCustomizeDiff loads and validates the policy files so that syntax errors
+// are reported at plan time and local or remote changes show up as per-file differences.
+func resourcePoliciesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("directory") {
+		return nil
+	}
+
+	statements, err := readPolicyDirectory(d.Get("directory").(string))
+
+	if err != nil {
+		return err
+	}
+
+	old := flex.ExpandStringValueMap(d.Get("statements").(map[string]interface{}))
+
+	if policyStatementsEqual(old, statements) {
+		return nil
+	}
+
+	if err := d.SetNew("statements", statements); err != nil {
+		return err
+	}
+
+	for file := range statements {
+		if _, ok := old[file]; !ok {
+			return d.SetNewComputed("policy_ids")
+		}
+	}
+
+	if len(old) != len(statements) {
+		return d.SetNewComputed("policy_ids")
+	}
+
+	return nil
+}
+
+// readPolicyDirectory returns the trimmed contents of each .cedar file in the directory, keyed by file name.
+// Each file must contain exactly one Cedar policy.
+func readPolicyDirectory(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading policy directory (%s): %w", dir, err)
+	}
+
+	statements := make(map[string]string)
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".cedar" {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+
+		if err != nil {
+			return nil, fmt.Errorf("reading policy file (%s): %w", entry.Name(), err)
+		}
+
+		policies, err := parsePolicies(string(b))
+
+		if err != nil {
+			return nil, fmt.Errorf("policy file (%s) is not a valid Cedar policy: %w", entry.Name(), err)
+		}
+
+		if n := len(policies); n != 1 {
+			return nil, fmt.Errorf("policy file (%s) must contain exactly one Cedar policy, got %d", entry.Name(), n)
+		}
+
+		statements[entry.Name()] = strings.TrimSpace(string(b))
+	}
+
+	return statements, nil
+}
+
+func policyStatementsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+
+	return true
+}
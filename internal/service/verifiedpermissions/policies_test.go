@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesConfig_basic("test-fixtures/policies"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.admin.cedar"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.read.cedar"),
+					resource.TestCheckResourceAttr(resourceName, "statements.%", "2"),
+				),
+			},
+			{
+				Config: testAccPoliciesConfig_basic("test-fixtures/policies-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.admin.cedar"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.deny.cedar"),
+					resource.TestCheckNoResourceAttr(resourceName, "policy_ids.read.cedar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicies_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesConfig_basic("test-fixtures/policies"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExist(ctx, resourceName),
+					testAccCheckPoliciesModifyStatement(ctx, resourceName, "read.cedar", `permit (principal, action, resource);`),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPoliciesConfig_basic("test-fixtures/policies"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statements.read.cedar", "permit (\n  principal,\n  action == Action::\"read\",\n  resource\n);"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicies_invalidFile(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPoliciesConfig_basic("test-fixtures/policies-invalid"),
+				ExpectError: regexache.MustCompile(`policy file \(bad.cedar\) is not a valid Cedar policy`),
+			},
+		},
+	})
+}
+
+func testAccCheckPoliciesExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, policyID := range testAccPoliciesIDs(rs) {
+			if _, err := tfverifiedpermissions.FindPolicyByTwoPartKey(ctx, conn, policyID, rs.Primary.Attributes["policy_store_id"]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPoliciesModifyStatement(ctx context.Context, n, file, statement string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		_, err := conn.UpdatePolicy(ctx, &verifiedpermissions.UpdatePolicyInput{
+			Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+				Value: awstypes.UpdateStaticPolicyDefinition{
+					Statement: aws.String(statement),
+				},
+			},
+			PolicyId:      aws.String(rs.Primary.Attributes["policy_ids."+file]),
+			PolicyStoreId: aws.String(rs.Primary.Attributes["policy_store_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckPoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policies" {
+				continue
+			}
+
+			for _, policyID := range testAccPoliciesIDs(rs) {
+				_, err := tfverifiedpermissions.FindPolicyByTwoPartKey(ctx, conn, policyID, rs.Primary.Attributes["policy_store_id"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Verified Permissions Policy %s still exists", policyID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPoliciesIDs(rs *terraform.ResourceState) []string {
+	var ids []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "policy_ids.") && k != "policy_ids.%" {
+			ids = append(ids, v)
+		}
+	}
+
+	return ids
+}
+
+func testAccPoliciesConfig_basic(directory string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  directory       = %[1]q
+}
+`, directory)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	policyResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_verifiedpermissions_policy", name="Policy")
+func ResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyCreate,
+		ReadWithoutTimeout:   resourcePolicyRead,
+		UpdateWithoutTimeout: resourcePolicyUpdate,
+		DeleteWithoutTimeout: resourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"static": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"statement": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validPolicyStatement,
+										DiffSuppressFunc: suppressEquivalentPolicyStatements,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	input := &verifiedpermissions.CreatePolicyInput{
+		Definition: &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Statement: aws.String(d.Get("definition.0.static.0.statement").(string)),
+			},
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	if v, ok := d.GetOk("definition.0.static.0.description"); ok {
+		input.Definition.(*awstypes.PolicyDefinitionMemberStatic).Value.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Verified Permissions Policy (%s): %s", policyStoreID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(output.PolicyId), policyStoreID}, policyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePolicyRead(ctx, d, meta)...)
+}
+
+func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), policyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	policyID, policyStoreID := parts[0], parts[1]
+	output, err := FindPolicyByTwoPartKey(ctx, conn, policyID, policyStoreID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Policy (%s): %s", d.Id(), err)
+	}
+
+	static, ok := output.Definition.(*awstypes.PolicyDefinitionDetailMemberStatic)
+
+	if !ok {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Policy (%s): unsupported policy type %s", d.Id(), output.PolicyType)
+	}
+
+	if err := d.Set("definition", []interface{}{map[string]interface{}{
+		"static": []interface{}{map[string]interface{}{
+			"description": aws.ToString(static.Value.Description),
+			"statement":   aws.ToString(static.Value.Statement),
+		}},
+	}}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
+	}
+	d.Set("policy_id", output.PolicyId)
+	d.Set("policy_store_id", output.PolicyStoreId)
+
+	return diags
+}
+
+func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), policyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &verifiedpermissions.UpdatePolicyInput{
+		Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+			Value: awstypes.UpdateStaticPolicyDefinition{
+				Statement: aws.String(d.Get("definition.0.static.0.statement").(string)),
+			},
+		},
+		PolicyId:      aws.String(parts[0]),
+		PolicyStoreId: aws.String(parts[1]),
+	}
+
+	if v, ok := d.GetOk("definition.0.static.0.description"); ok {
+		input.Definition.(*awstypes.UpdatePolicyDefinitionMemberStatic).Value.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdatePolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Verified Permissions Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePolicyRead(ctx, d, meta)...)
+}
+
+func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), policyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Policy: %s", d.Id())
+	if err := deletePolicy(ctx, conn, parts[0], parts[1]); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Verified Permissions Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func deletePolicy(ctx context.Context, conn *verifiedpermissions.Client, policyID, policyStoreID string) error {
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func FindPolicyByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyID, policyStoreID string) (*verifiedpermissions.GetPolicyOutput, error) {
+	input := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// suppressEquivalentPolicyStatements ignores differences in leading and trailing whitespace,
+// which the service trims from stored policy statements.
+func suppressEquivalentPolicyStatements(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
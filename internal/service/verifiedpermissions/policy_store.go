@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_verifiedpermissions_policy_store", name="Policy Store")
+func ResourcePolicyStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyStoreCreate,
+		ReadWithoutTimeout:   resourcePolicyStoreRead,
+		UpdateWithoutTimeout: resourcePolicyStoreUpdate,
+		DeleteWithoutTimeout: resourcePolicyStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validation_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ValidationMode](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePolicyStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreatePolicyStoreInput{
+		ValidationSettings: expandValidationSettings(d.Get("validation_settings").([]interface{})),
+	}
+
+	output, err := conn.CreatePolicyStore(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Verified Permissions Policy Store: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.PolicyStoreId))
+
+	return append(diags, resourcePolicyStoreRead(ctx, d, meta)...)
+}
+
+func resourcePolicyStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	output, err := FindPolicyStoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Policy Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Policy Store (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("created_date", aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	d.Set("last_updated_date", aws.ToTime(output.LastUpdatedDate).Format(time.RFC3339))
+	if err := d.Set("validation_settings", flattenValidationSettings(output.ValidationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting validation_settings: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePolicyStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.UpdatePolicyStoreInput{
+		PolicyStoreId:      aws.String(d.Id()),
+		ValidationSettings: expandValidationSettings(d.Get("validation_settings").([]interface{})),
+	}
+
+	_, err := conn.UpdatePolicyStore(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Verified Permissions Policy Store (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePolicyStoreRead(ctx, d, meta)...)
+}
+
+func resourcePolicyStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Policy Store: %s", d.Id())
+	_, err := conn.DeletePolicyStore(ctx, &verifiedpermissions.DeletePolicyStoreInput{
+		PolicyStoreId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Verified Permissions Policy Store (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPolicyStoreByID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetPolicyStoreOutput, error) {
+	input := &verifiedpermissions.GetPolicyStoreInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetPolicyStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandValidationSettings(tfList []interface{}) *awstypes.ValidationSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.ValidationSettings{
+		Mode: awstypes.ValidationMode(tfMap["mode"].(string)),
+	}
+}
+
+func flattenValidationSettings(apiObject *awstypes.ValidationSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"mode": apiObject.Mode,
+	}}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_verifiedpermissions_schema", name="Schema")
+func ResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaPut,
+		ReadWithoutTimeout:   resourceSchemaRead,
+		UpdateWithoutTimeout: resourceSchemaPut,
+		DeleteWithoutTimeout: resourceSchemaDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validSchemaDefinition,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			"namespaces": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: d.Get("definition.0.value").(string),
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	_, err := conn.PutSchema(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Verified Permissions Schema (%s): %s", policyStoreID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(policyStoreID)
+	}
+
+	return append(diags, resourceSchemaRead(ctx, d, meta)...)
+}
+
+func resourceSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	output, err := FindSchemaByPolicyStoreID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Schema (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Schema (%s): %s", d.Id(), err)
+	}
+
+	value, err := structure.NormalizeJsonString(aws.ToString(output.Schema))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := d.Set("definition", []interface{}{map[string]interface{}{"value": value}}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
+	}
+	d.Set("namespaces", output.Namespaces)
+	d.Set("policy_store_id", output.PolicyStoreId)
+
+	return diags
+}
+
+func resourceSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+	// There is no API to delete a schema, so replace it with an empty one.
+	log.Printf("[DEBUG] Deleting Verified Permissions Schema: %s", d.Id())
+	_, err := conn.PutSchema(ctx, &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: "{}",
+		},
+		PolicyStoreId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Verified Permissions Schema (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+type schemaOutput struct {
+	Namespaces    []string
+	PolicyStoreId *string
+	Schema        *string
+}
+
+func FindSchemaByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*schemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Schema == nil || aws.ToString(output.Schema) == "{}" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// GetSchema does not return the namespaces; they are derived from the schema's top-level keys.
+	return &schemaOutput{
+		Namespaces:    schemaNamespaces(aws.ToString(output.Schema)),
+		PolicyStoreId: output.PolicyStoreId,
+		Schema:        output.Schema,
+	}, nil
+}
+
+func schemaNamespaces(s string) []string {
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil
+	}
+
+	namespaces := make([]string, 0, len(m))
+	for k := range m {
+		namespaces = append(namespaces, k)
+	}
+
+	return namespaces
+}
@@ -27,7 +27,28 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourcePolicies,
+			TypeName: "aws_verifiedpermissions_policies",
+			Name:     "Policies",
+		},
+		{
+			Factory:  ResourcePolicy,
+			TypeName: "aws_verifiedpermissions_policy",
+			Name:     "Policy",
+		},
+		{
+			Factory:  ResourcePolicyStore,
+			TypeName: "aws_verifiedpermissions_policy_store",
+			Name:     "Policy Store",
+		},
+		{
+			Factory:  ResourceSchema,
+			TypeName: "aws_verifiedpermissions_schema",
+			Name:     "Schema",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// validPolicyStatement checks that the value is a single, syntactically well-formed Cedar policy.
+func validPolicyStatement(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	policies, err := parsePolicies(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Cedar policy: %w", k, err))
+		return
+	}
+
+	if n := len(policies); n != 1 {
+		errors = append(errors, fmt.Errorf("%q must contain exactly one Cedar policy, got %d", k, n))
+	}
+
+	return
+}
+
+// validSchemaDefinition checks that the value is a Cedar JSON schema: an object mapping
+// namespaces to objects with "entityTypes" and "actions" members.
+func validSchemaDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var namespaces map[string]map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(value), &namespaces); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Cedar JSON schema: %w", k, err))
+		return
+	}
+
+	for namespace, definition := range namespaces {
+		for _, member := range []string{"entityTypes", "actions"} {
+			raw, ok := definition[member]
+
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q: namespace %q is missing %q", k, namespace, member))
+				continue
+			}
+
+			var m map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &m); err != nil {
+				errors = append(errors, fmt.Errorf("%q: namespace %q %q must be an object", k, namespace, member))
+			}
+		}
+	}
+
+	return
+}
+
+type cedarToken struct {
+	value  string
+	offset int
+}
+
+// tokenizeCedar splits Cedar source into identifiers, string and number literals and punctuation.
+// Comments are dropped.
+func tokenizeCedar(s string) ([]cedarToken, error) {
+	var tokens []cedarToken
+
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.HasPrefix(s[i:], "//"):
+			if j := strings.IndexByte(s[i:], '\n'); j >= 0 {
+				i += j + 1
+			} else {
+				i = len(s)
+			}
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string literal at offset %d", i)
+			}
+			tokens = append(tokens, cedarToken{value: s[i : j+1], offset: i})
+			i = j + 1
+		case c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			j := i
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			tokens = append(tokens, cedarToken{value: s[i:j], offset: i})
+			i = j
+		default:
+			n := 1
+			for _, op := range []string{"::", "==", "!=", "<=", ">=", "&&", "||"} {
+				if strings.HasPrefix(s[i:], op) {
+					n = len(op)
+					break
+				}
+			}
+			tokens = append(tokens, cedarToken{value: s[i : i+n], offset: i})
+			i += n
+		}
+	}
+
+	return tokens, nil
+}
+
+// parsePolicies performs a structural parse of a Cedar policy set and returns the source of each policy.
+// It checks annotations, the effect, the principal/action/resource scope, when/unless conditions,
+// bracket balancing and statement termination. It does not type-check expressions.
+func parsePolicies(s string) ([]string, error) {
+	tokens, err := tokenizeCedar(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []string
+
+	for i := 0; i < len(tokens); {
+		start := tokens[i].offset
+
+		// Annotations, e.g. @id("policy1").
+		for i < len(tokens) && tokens[i].value == "@" {
+			if i+4 >= len(tokens) || tokens[i+2].value != "(" || !strings.HasPrefix(tokens[i+3].value, `"`) || tokens[i+4].value != ")" {
+				return nil, fmt.Errorf("malformed annotation at offset %d", tokens[i].offset)
+			}
+			i += 5
+		}
+
+		if i >= len(tokens) {
+			return nil, fmt.Errorf("expected \"permit\" or \"forbid\" at end of input")
+		}
+
+		if effect := tokens[i].value; effect != "permit" && effect != "forbid" {
+			return nil, fmt.Errorf("expected \"permit\" or \"forbid\" at offset %d, got %q", tokens[i].offset, effect)
+		}
+		i++
+
+		if i >= len(tokens) || tokens[i].value != "(" {
+			return nil, fmt.Errorf("expected \"(\" after policy effect")
+		}
+
+		end, err := matchCedarBracket(tokens, i)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkCedarScope(tokens[i+1 : end]); err != nil {
+			return nil, err
+		}
+		i = end + 1
+
+		for i < len(tokens) && (tokens[i].value == "when" || tokens[i].value == "unless") {
+			i++
+
+			if i >= len(tokens) || tokens[i].value != "{" {
+				return nil, fmt.Errorf("expected \"{\" after %q", tokens[i-1].value)
+			}
+
+			end, err := matchCedarBracket(tokens, i)
+			if err != nil {
+				return nil, err
+			}
+
+			if end == i+1 {
+				return nil, fmt.Errorf("empty condition at offset %d", tokens[i].offset)
+			}
+			i = end + 1
+		}
+
+		if i >= len(tokens) || tokens[i].value != ";" {
+			return nil, fmt.Errorf("expected \";\" at end of policy starting at offset %d", start)
+		}
+
+		policies = append(policies, strings.TrimSpace(s[start:tokens[i].offset+1]))
+		i++
+	}
+
+	return policies, nil
+}
+
+// matchCedarBracket returns the index of the token closing the bracket opened at tokens[open].
+func matchCedarBracket(tokens []cedarToken, open int) (int, error) {
+	pairs := map[string]string{"(": ")", "[": "]", "{": "}"}
+	var stack []string
+
+	for i := open; i < len(tokens); i++ {
+		v := tokens[i].value
+
+		if closing, ok := pairs[v]; ok {
+			stack = append(stack, closing)
+			continue
+		}
+
+		if v == ")" || v == "]" || v == "}" {
+			if len(stack) == 0 || stack[len(stack)-1] != v {
+				return 0, fmt.Errorf("unexpected %q at offset %d", v, tokens[i].offset)
+			}
+
+			stack = stack[:len(stack)-1]
+
+			if len(stack) == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unbalanced %q at offset %d", tokens[open].value, tokens[open].offset)
+}
+
+// checkCedarScope checks the policy scope is of the form "principal ..., action ..., resource ...".
+func checkCedarScope(tokens []cedarToken) error {
+	var parts [][]cedarToken
+	depth, from := 0, 0
+
+	for i, t := range tokens {
+		switch t.value {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case ",":
+			if depth == 0 {
+				parts = append(parts, tokens[from:i])
+				from = i + 1
+			}
+		}
+	}
+	parts = append(parts, tokens[from:])
+
+	if len(parts) != 3 {
+		return fmt.Errorf("policy scope must contain principal, action and resource")
+	}
+
+	for i, want := range []string{"principal", "action", "resource"} {
+		if len(parts[i]) == 0 || parts[i][0].value != want {
+			return fmt.Errorf("policy scope element %d must start with %q", i+1, want)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"testing"
+)
+
+func TestValidPolicyStatement(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		`permit (principal, action, resource);`,
+		`forbid (principal, action, resource) when { context.mfa == false };`,
+		`@id("read") permit (principal == User::"alice", action in [Action::"read", Action::"list"], resource in Folder::"docs");`,
+		`// Allow admins.
+permit (
+  principal in Group::"admins",
+  action,
+  resource
+)
+when { resource.owner == principal }
+unless { resource.locked };`,
+		`permit (principal, action, resource) when { context.note == "a;b}" };`,
+	}
+
+	for _, s := range validValues {
+		_, errors := validPolicyStatement(s, "statement")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cedar policy: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		``,
+		`permit (principal, action, resource)`,
+		`allow (principal, action, resource);`,
+		`permit (principal, resource);`,
+		`permit (action, principal, resource);`,
+		`permit (principal, action, resource) when { context.mfa == false ;`,
+		`permit (principal, action, resource) when {};`,
+		`permit (principal == User::"alice, action, resource);`,
+		`permit (principal, action, resource); forbid (principal, action, resource);`,
+		`@id permit (principal, action, resource);`,
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validPolicyStatement(s, "statement")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cedar policy", s)
+		}
+	}
+}
+
+func TestParsePolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := parsePolicies(`
+permit (principal, action, resource);
+
+// second
+forbid (principal, action, resource) unless { principal.active };
+`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(policies), 2; got != want {
+		t.Fatalf("got %d policies, want %d", got, want)
+	}
+
+	if got, want := policies[1], `forbid (principal, action, resource) unless { principal.active };`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidSchemaDefinition(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		`{}`,
+		`{"PhotoApp": {"entityTypes": {}, "actions": {}}}`,
+		`{"": {"entityTypes": {"User": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"]}}}}}`,
+	}
+
+	for _, s := range validValues {
+		_, errors := validSchemaDefinition(s, "value")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cedar schema: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		``,
+		`[]`,
+		`{"PhotoApp": {"entityTypes": {}}}`,
+		`{"PhotoApp": {"entityTypes": [], "actions": {}}}`,
+		`{"PhotoApp": "x"}`,
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validSchemaDefinition(s, "value")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cedar schema", s)
+		}
+	}
+}
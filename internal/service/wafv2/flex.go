@@ -4,9 +4,15 @@
 package wafv2
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
@@ -48,6 +54,22 @@ func expandRule(m map[string]interface{}) *wafv2.Rule {
 	return rule
 }
 
+func expandRulesJSON(rawRules string) ([]*wafv2.Rule, error) {
+	var rules []*wafv2.Rule
+
+	if err := json.Unmarshal([]byte(rawRules), &rules); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	for i, rule := range rules {
+		if rule == nil {
+			return nil, fmt.Errorf("invalid rule supplied at index (%d)", i)
+		}
+	}
+
+	return rules, nil
+}
+
 func expandCaptchaConfig(l []interface{}) *wafv2.CaptchaConfig {
 	configuration := &wafv2.CaptchaConfig{}
 
@@ -74,6 +96,15 @@ func expandCaptchaConfig(l []interface{}) *wafv2.CaptchaConfig {
 	return configuration
 }
 
+// requestBodyAssociatedResourceTypes maps the association_config.request_body attribute names to their resource types.
+var requestBodyAssociatedResourceTypes = map[string]string{
+	"api_gateway":              wafv2.AssociatedResourceTypeApiGateway,
+	"app_runner_service":       wafv2.AssociatedResourceTypeAppRunnerService,
+	"cloudfront":               wafv2.AssociatedResourceTypeCloudfront,
+	"cognito_user_pool":        wafv2.AssociatedResourceTypeCognitoUserPool,
+	"verified_access_instance": wafv2.AssociatedResourceTypeVerifiedAccessInstance,
+}
+
 func expandAssociationConfig(l []interface{}) *wafv2.AssociationConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -93,9 +124,12 @@ func expandAssociationConfig(l []interface{}) *wafv2.AssociationConfig {
 			configuration.RequestBody = make(map[string]*wafv2.RequestBodyAssociatedResourceTypeConfig)
 		}
 
-		if v, ok := m["cloudfront"]; ok {
-			inner = v.([]interface{})
-			configuration.RequestBody[wafv2.AssociatedResourceTypeCloudfront] = expandRequestBodyConfigItem(inner)
+		for k, resourceType := range requestBodyAssociatedResourceTypes {
+			if v, ok := m[k]; ok {
+				if inner := v.([]interface{}); len(inner) > 0 && inner[0] != nil {
+					configuration.RequestBody[resourceType] = expandRequestBodyConfigItem(inner)
+				}
+			}
 		}
 	}
 
@@ -509,6 +543,10 @@ func expandFieldToMatch(l []interface{}) *wafv2.FieldToMatch {
 		f.Headers = expandHeaders(m["headers"].([]interface{}))
 	}
 
+	if v, ok := m["ja3_fingerprint"]; ok && len(v.([]interface{})) > 0 {
+		f.JA3Fingerprint = expandJA3Fingerprint(v.([]interface{}))
+	}
+
 	if v, ok := m["json_body"]; ok && len(v.([]interface{})) > 0 {
 		f.JsonBody = expandJSONBody(v.([]interface{}))
 	}
@@ -536,6 +574,18 @@ func expandFieldToMatch(l []interface{}) *wafv2.FieldToMatch {
 	return f
 }
 
+func expandJA3Fingerprint(l []interface{}) *wafv2.JA3Fingerprint {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &wafv2.JA3Fingerprint{
+		FallbackBehavior: aws.String(m["fallback_behavior"].(string)),
+	}
+}
+
 func expandForwardedIPConfig(l []interface{}) *wafv2.ForwardedIPConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1386,6 +1436,31 @@ func flattenRules(r []*wafv2.Rule) interface{} {
 	return out
 }
 
+func flattenRulesJSON(rules []*wafv2.Rule) (string, error) {
+	// Rule evaluation order is determined by priority, not by position in the document.
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+	})
+
+	b, err := jsonutil.BuildJSON(rules)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+// normalizeRulesJSON returns the canonical form of a rules JSON document
+// so that formatting, key order, rule order and unset fields don't cause diffs.
+func normalizeRulesJSON(rawRules string) (string, error) {
+	rules, err := expandRulesJSON(rawRules)
+	if err != nil {
+		return rawRules, err
+	}
+
+	return flattenRulesJSON(rules)
+}
+
 func flattenRuleAction(a *wafv2.RuleAction) interface{} {
 	if a == nil {
 		return []interface{}{}
@@ -1483,14 +1558,18 @@ func flattenAssociationConfig(config *wafv2.AssociationConfig) interface{} {
 		return associationConfig
 	}
 
-	cloudfrontRequestBodyConfig := config.RequestBody[wafv2.AssociatedResourceTypeCloudfront]
-	if cloudfrontRequestBodyConfig != nil {
+	requestBody := map[string]interface{}{}
+	for k, resourceType := range requestBodyAssociatedResourceTypes {
+		if v := config.RequestBody[resourceType]; v != nil {
+			requestBody[k] = []map[string]interface{}{{
+				"default_size_inspection_limit": aws.StringValue(v.DefaultSizeInspectionLimit),
+			}}
+		}
+	}
+
+	if len(requestBody) > 0 {
 		associationConfig = append(associationConfig, map[string]interface{}{
-			"request_body": []map[string]interface{}{{
-				"cloudfront": []map[string]interface{}{{
-					"default_size_inspection_limit": aws.StringValue(cloudfrontRequestBodyConfig.DefaultSizeInspectionLimit),
-				}},
-			}},
+			"request_body": []map[string]interface{}{requestBody},
 		})
 	}
 
@@ -1738,6 +1817,10 @@ func flattenFieldToMatch(f *wafv2.FieldToMatch) interface{} {
 		m["headers"] = flattenHeaders(f.Headers)
 	}
 
+	if f.JA3Fingerprint != nil {
+		m["ja3_fingerprint"] = flattenJA3Fingerprint(f.JA3Fingerprint)
+	}
+
 	if f.JsonBody != nil {
 		m["json_body"] = flattenJSONBody(f.JsonBody)
 	}
@@ -1765,6 +1848,18 @@ func flattenFieldToMatch(f *wafv2.FieldToMatch) interface{} {
 	return []interface{}{m}
 }
 
+func flattenJA3Fingerprint(apiObject *wafv2.JA3Fingerprint) interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"fallback_behavior": aws.StringValue(apiObject.FallbackBehavior),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenForwardedIPConfig(f *wafv2.ForwardedIPConfig) interface{} {
 	if f == nil {
 		return []interface{}{}
@@ -137,6 +137,7 @@ func ResourceRuleGroup() *schema.Resource {
 						},
 					},
 				},
+				"rule_json": ruleJSONSchema(),
 				"scope": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -157,10 +158,15 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	conn := meta.(*conns.AWSClient).WAFV2Conn(ctx)
 
 	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	rules, err := expandRuleGroupRules(d)
+	if err != nil {
+		return diag.Errorf("creating WAFv2 RuleGroup (%s): %s", name, err)
+	}
+
 	input := &wafv2.CreateRuleGroupInput{
 		Capacity:         aws.Int64(int64(d.Get("capacity").(int))),
 		Name:             aws.String(name),
-		Rules:            expandRules(d.Get("rule").(*schema.Set).List()),
+		Rules:            rules,
 		Scope:            aws.String(d.Get("scope").(string)),
 		Tags:             getTagsIn(ctx),
 		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
@@ -213,8 +219,18 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", ruleGroup.Name)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(ruleGroup.Name)))
-	if err := d.Set("rule", flattenRules(ruleGroup.Rules)); err != nil {
-		return diag.Errorf("setting rule: %s", err)
+	if _, ok := d.GetOk("rule_json"); ok {
+		rulesJSON, err := flattenRulesJSON(ruleGroup.Rules)
+		if err != nil {
+			return diag.Errorf("setting rule_json: %s", err)
+		}
+		d.Set("rule", nil)
+		d.Set("rule_json", rulesJSON)
+	} else {
+		if err := d.Set("rule", flattenRules(ruleGroup.Rules)); err != nil {
+			return diag.Errorf("setting rule: %s", err)
+		}
+		d.Set("rule_json", nil)
 	}
 	if err := d.Set("visibility_config", flattenVisibilityConfig(ruleGroup.VisibilityConfig)); err != nil {
 		return diag.Errorf("setting visibility_config: %s", err)
@@ -227,11 +243,16 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	conn := meta.(*conns.AWSClient).WAFV2Conn(ctx)
 
 	if d.HasChangesExcept("tags", "tags_all") {
+		rules, err := expandRuleGroupRules(d)
+		if err != nil {
+			return diag.Errorf("updating WAFv2 RuleGroup (%s): %s", d.Id(), err)
+		}
+
 		input := &wafv2.UpdateRuleGroupInput{
 			Id:               aws.String(d.Id()),
 			LockToken:        aws.String(d.Get("lock_token").(string)),
 			Name:             aws.String(d.Get("name").(string)),
-			Rules:            expandRules(d.Get("rule").(*schema.Set).List()),
+			Rules:            rules,
 			Scope:            aws.String(d.Get("scope").(string)),
 			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
@@ -244,7 +265,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.Description = aws.String(v.(string))
 		}
 
-		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, ruleGroupUpdateTimeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, ruleGroupUpdateTimeout, func() (interface{}, error) {
 			return conn.UpdateRuleGroupWithContext(ctx, input)
 		}, wafv2.ErrCodeWAFUnavailableEntityException)
 
@@ -308,3 +329,17 @@ func FindRuleGroupByThreePartKey(ctx context.Context, conn *wafv2.WAFV2, id, nam
 
 	return output, nil
 }
+
+// expandRuleGroupRules returns the rule group's rules from either the rule_json document or the rule blocks.
+func expandRuleGroupRules(d *schema.ResourceData) ([]*wafv2.Rule, error) {
+	if v, ok := d.GetOk("rule_json"); ok {
+		rules, err := expandRulesJSON(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding rule_json: %w", err)
+		}
+
+		return rules, nil
+	}
+
+	return expandRules(d.Get("rule").(*schema.Set).List()), nil
+}
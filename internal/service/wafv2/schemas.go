@@ -26,6 +26,22 @@ func emptySchema() *schema.Schema {
 	return listOfEmptyObjectSchema
 }
 
+// ruleJSONSchema returns the schema for rules given as the AWS-native JSON document,
+// i.e. the Rules list as returned by the console or `aws wafv2 get-web-acl`/`get-rule-group`.
+func ruleJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"rule"},
+		StateFunc: func(v interface{}) string {
+			json, _ := normalizeRulesJSON(v.(string))
+			return json
+		},
+		DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		ValidateFunc:     validation.StringIsJSON,
+	}
+}
+
 func ruleLabelsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -343,9 +359,23 @@ func fieldToMatchBaseSchema() *schema.Resource {
 			"body":                bodySchema(),
 			"cookies":             cookiesSchema(),
 			"headers":             headersSchema(),
-			"json_body":           jsonBodySchema(),
-			"method":              emptySchema(),
-			"query_string":        emptySchema(),
+			"ja3_fingerprint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fallback_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(wafv2.FallbackBehavior_Values(), false),
+						},
+					},
+				},
+			},
+			"json_body":    jsonBodySchema(),
+			"method":       emptySchema(),
+			"query_string": emptySchema(),
 			"single_header": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -530,24 +560,32 @@ func associationConfigSchema() *schema.Schema {
 }
 
 func requestBodySchema() *schema.Schema {
+	requestBodyAssociatedResourceTypeConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default_size_inspection_limit": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(wafv2.SizeInspectionLimit_Values(), false),
+					},
+				},
+			},
+		}
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"cloudfront": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"default_size_inspection_limit": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringInSlice(wafv2.SizeInspectionLimit_Values(), false),
-							},
-						},
-					},
-				},
+				"api_gateway":              requestBodyAssociatedResourceTypeConfigSchema(),
+				"app_runner_service":       requestBodyAssociatedResourceTypeConfigSchema(),
+				"cloudfront":               requestBodyAssociatedResourceTypeConfigSchema(),
+				"cognito_user_pool":        requestBodyAssociatedResourceTypeConfigSchema(),
+				"verified_access_instance": requestBodyAssociatedResourceTypeConfigSchema(),
 			},
 		},
 	}
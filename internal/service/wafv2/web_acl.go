@@ -145,6 +145,7 @@ func ResourceWebACL() *schema.Resource {
 						},
 					},
 				},
+				"rule_json": ruleJSONSchema(),
 				"scope": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -176,12 +177,17 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).WAFV2Conn(ctx)
 
 	name := d.Get("name").(string)
+	rules, err := expandWebACLRulesFromConfig(d)
+	if err != nil {
+		return diag.Errorf("creating WAFv2 WebACL (%s): %s", name, err)
+	}
+
 	input := &wafv2.CreateWebACLInput{
 		AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
 		CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
 		DefaultAction:     expandDefaultAction(d.Get("default_action").([]interface{})),
 		Name:              aws.String(name),
-		Rules:             expandWebACLRules(d.Get("rule").(*schema.Set).List()),
+		Rules:             rules,
 		Scope:             aws.String(d.Get("scope").(string)),
 		Tags:              getTagsIn(ctx),
 		VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
@@ -248,9 +254,20 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("description", webACL.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", webACL.Name)
-	rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get("rule").(*schema.Set).List()))
-	if err := d.Set("rule", flattenWebACLRules(rules)); err != nil {
-		return diag.Errorf("setting rule: %s", err)
+	if v, ok := d.GetOk("rule_json"); ok {
+		configRules, _ := expandRulesJSON(v.(string))
+		rulesJSON, err := flattenRulesJSON(filterWebACLRules(webACL.Rules, configRules))
+		if err != nil {
+			return diag.Errorf("setting rule_json: %s", err)
+		}
+		d.Set("rule", nil)
+		d.Set("rule_json", rulesJSON)
+	} else {
+		rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get("rule").(*schema.Set).List()))
+		if err := d.Set("rule", flattenWebACLRules(rules)); err != nil {
+			return diag.Errorf("setting rule: %s", err)
+		}
+		d.Set("rule_json", nil)
 	}
 	d.Set("token_domains", aws.StringValueSlice(webACL.TokenDomains))
 	if err := d.Set("visibility_config", flattenVisibilityConfig(webACL.VisibilityConfig)); err != nil {
@@ -266,7 +283,10 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	if d.HasChangesExcept("tags", "tags_all") {
 		// Find the AWS managed ShieldMitigationRuleGroup group rule if existent and add it into the set of rules to update
 		// so that the provider will not remove the Shield rule when changes are applied to the WebACL.
-		rules := expandWebACLRules(d.Get("rule").(*schema.Set).List())
+		rules, err := expandWebACLRulesFromConfig(d)
+		if err != nil {
+			return diag.Errorf("updating WAFv2 WebACL (%s): %s", d.Id(), err)
+		}
 		if sr := findShieldRule(rules); len(sr) == 0 {
 			output, err := FindWebACLByThreePartKey(ctx, conn, d.Id(), d.Get("name").(string), d.Get("scope").(string))
 			if err != nil {
@@ -299,7 +319,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.TokenDomains = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
-		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, webACLUpdateTimeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, webACLUpdateTimeout, func() (interface{}, error) {
 			return conn.UpdateWebACLWithContext(ctx, input)
 		}, wafv2.ErrCodeWAFUnavailableEntityException)
 
@@ -368,6 +388,20 @@ func FindWebACLByThreePartKey(ctx context.Context, conn *wafv2.WAFV2, id, name,
 	return output, nil
 }
 
+// expandWebACLRulesFromConfig returns the Web ACL's rules from either the rule_json document or the rule blocks.
+func expandWebACLRulesFromConfig(d *schema.ResourceData) ([]*wafv2.Rule, error) {
+	if v, ok := d.GetOk("rule_json"); ok {
+		rules, err := expandRulesJSON(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding rule_json: %w", err)
+		}
+
+		return rules, nil
+	}
+
+	return expandWebACLRules(d.Get("rule").(*schema.Set).List()), nil
+}
+
 // filterWebACLRules removes the AWS-added Shield Advanced auto mitigation rule here
 // so that the provider will not report diff and/or attempt to remove the rule as it is
 // owned and managed by AWS.
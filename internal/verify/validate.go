@@ -13,9 +13,12 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
@@ -124,6 +127,32 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ARNPartitionWarnings returns a warning for each of the specified ARN arguments whose partition
+// is not that of the resource's provider, e.g. arn:aws: values used with an aws-cn provider.
+// Schema validators run without the provider's configuration, so resources call this from Create or Update.
+func ARNPartitionWarnings(d *schema.ResourceData, meta interface{}, keys ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	partition := meta.(*conns.AWSClient).Partition
+
+	for _, key := range keys {
+		v, ok := d.Get(key).(string)
+		if !ok || !arn.IsARN(v) {
+			continue
+		}
+
+		if parsedARN, err := arn.Parse(v); err == nil && parsedARN.Partition != partition {
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(
+				cty.GetAttrPath(key),
+				"ARN partition mismatch",
+				fmt.Sprintf("%s is in partition %q, but the provider is configured for partition %q", v, parsedARN.Partition, partition),
+			))
+		}
+	}
+
+	return diags
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
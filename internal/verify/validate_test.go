@@ -4,6 +4,7 @@
 package verify
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -224,6 +226,81 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestARNPartitionWarnings(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policy_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config       map[string]interface{}
+		partition    string
+		wantWarnings int
+	}{
+		"same partition": {
+			config: map[string]interface{}{
+				"policy_arn": "arn:aws-cn:iam::aws:policy/ReadOnlyAccess", // lintignore:AWSAT005
+			},
+			partition: "aws-cn",
+		},
+		"different partition": {
+			config: map[string]interface{}{
+				"policy_arn": "arn:aws:iam::aws:policy/ReadOnlyAccess", // lintignore:AWSAT005
+			},
+			partition:    "aws-cn",
+			wantWarnings: 1,
+		},
+		"not an ARN": {
+			config: map[string]interface{}{
+				"name":       "arn:aws:iam::aws:policy/ReadOnlyAccess", // lintignore:AWSAT005
+				"policy_arn": "ReadOnlyAccess",
+			},
+			partition: "aws-cn",
+		},
+		"not set": {
+			config:    map[string]interface{}{},
+			partition: "aws-cn",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, r.Schema, testCase.config)
+			meta := &conns.AWSClient{Partition: testCase.partition}
+
+			diags := ARNPartitionWarnings(d, meta, "policy_arn")
+
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			if got, want := len(diags), testCase.wantWarnings; got != want {
+				t.Fatalf("got %d warnings, want %d: %v", got, want, diags)
+			}
+
+			for _, diag := range diags {
+				if !strings.Contains(diag.Detail, fmt.Sprintf("provider is configured for partition %q", testCase.partition)) {
+					t.Errorf("unexpected warning detail: %s", diag.Detail)
+				}
+			}
+		})
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 
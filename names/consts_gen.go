@@ -12,6 +12,7 @@ const (
 	Amplify                      = "amplify"
 	AppAutoScaling               = "appautoscaling"
 	AppConfig                    = "appconfig"
+	AppFabric                    = "appfabric"
 	AppFlow                      = "appflow"
 	AppIntegrations              = "appintegrations"
 	AppMesh                      = "appmesh"
@@ -19,12 +20,16 @@ const (
 	AppStream                    = "appstream"
 	AppSync                      = "appsync"
 	ApplicationInsights          = "applicationinsights"
+	Artifact                     = "artifact"
 	Athena                       = "athena"
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
 	AutoScalingPlans             = "autoscalingplans"
+	B2BI                         = "b2bi"
 	Backup                       = "backup"
 	Batch                        = "batch"
+	Bedrock                      = "bedrock"
+	BedrockAgent                 = "bedrockagent"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -62,6 +67,7 @@ const (
 	DataExchange                 = "dataexchange"
 	DataPipeline                 = "datapipeline"
 	DataSync                     = "datasync"
+	Deadline                     = "deadline"
 	Deploy                       = "deploy"
 	Detective                    = "detective"
 	DeviceFarm                   = "devicefarm"
@@ -84,6 +90,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -97,6 +104,7 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
 	HealthLake                   = "healthlake"
 	IAM                          = "iam"
@@ -121,6 +129,7 @@ const (
 	KinesisVideo                 = "kinesisvideo"
 	LakeFormation                = "lakeformation"
 	Lambda                       = "lambda"
+	LaunchWizard                 = "launchwizard"
 	LexModels                    = "lexmodels"
 	LexV2Models                  = "lexv2models"
 	LicenseManager               = "licensemanager"
@@ -130,6 +139,7 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	ManagedBlockchain            = "managedblockchain"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -140,20 +150,26 @@ const (
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	ObservabilityAccessManager   = "oam"
+	Omics                        = "omics"
 	OpenSearch                   = "opensearch"
 	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
 	Organizations                = "organizations"
 	Outposts                     = "outposts"
+	PCAConnectorAD               = "pcaconnectorad"
+	PCAConnectorSCEP             = "pcaconnectorscep"
+	PaymentCryptography          = "paymentcryptography"
 	Pinpoint                     = "pinpoint"
 	Pipes                        = "pipes"
 	Pricing                      = "pricing"
+	QBusiness                    = "qbusiness"
 	QLDB                         = "qldb"
 	QuickSight                   = "quicksight"
 	RAM                          = "ram"
 	RBin                         = "rbin"
 	RDS                          = "rds"
 	RUM                          = "rum"
+	RePostSpace                  = "repostspace"
 	Redshift                     = "redshift"
 	RedshiftData                 = "redshiftdata"
 	RedshiftServerless           = "redshiftserverless"
@@ -177,6 +193,7 @@ const (
 	SSM                          = "ssm"
 	SSMContacts                  = "ssmcontacts"
 	SSMIncidents                 = "ssmincidents"
+	SSMSAP                       = "ssmsap"
 	SSOAdmin                     = "ssoadmin"
 	STS                          = "sts"
 	SWF                          = "swf"
@@ -195,9 +212,13 @@ const (
 	SimpleDB                     = "simpledb"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
+	TNB                          = "tnb"
+	TaxSettings                  = "taxsettings"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
+	TrustedAdvisor               = "trustedadvisor"
 	VPCLattice                   = "vpclattice"
 	VerifiedPermissions          = "verifiedpermissions"
 	WAF                          = "waf"
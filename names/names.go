@@ -38,6 +38,7 @@ const (
 	DSEndpointID                         = "ds"
 	EMRServerlessEndpointID              = "emrserverless"
 	GlacierEndpointID                    = "glacier"
+	HealthLakeEndpointID                 = "healthlake"
 	IdentityStoreEndpointID              = "identitystore"
 	Inspector2EndpointID                 = "inspector2"
 	InternetMonitorEndpointID            = "internetmonitor"
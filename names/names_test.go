@@ -111,7 +111,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"frauddetector",
 		"gluedatabrew",
 		"greengrassv2",
-		"groundstation",
 		"health",
 		"honeycode",
 		"iot1clickdevices",